@@ -37,6 +37,18 @@ const (
 	InitiatorLocalAddressHeader  = 1112
 	InitiatorRemoteAddressHeader = 1113
 
+	// AffinityKeyHeader may be set in the circuit request peer data, to provide a key used by terminator strategies
+	// which consistently map callers to the same terminator
+	AffinityKeyHeader = 1114
+
+	// DatagramModeHeader may be set in the circuit request peer data to request a datagram circuit. It's returned in
+	// the circuit success message if the controller granted the request
+	DatagramModeHeader = 1115
+
+	// HalfCloseHeader is set in the circuit success message if every router on the path supports half close, so the
+	// end of the initiating peer's input is sent to the other end of the circuit, rather than closing it
+	HalfCloseHeader = 1116
+
 	ErrorTypeGeneric                 = 0
 	ErrorTypeInvalidTerminator       = 1
 	ErrorTypeMisconfiguredTerminator = 2
@@ -43,6 +43,12 @@ type XgressDetail struct {
 	Goroutines            []string                `json:"goroutines"`
 	Sequence              int32                   `json:"sequence"`
 	Flags                 string                  `json:"flags"`
+	RateLimit             *XgressRateLimitDetail  `json:"rateLimit,omitempty"`
+}
+
+type XgressRateLimitDetail struct {
+	BytesPerSecond uint64 `json:"bytesPerSecond"`
+	BurstBytes     uint64 `json:"burstBytes"`
 }
 
 type XgressSendBufferDetail struct {
@@ -61,6 +67,9 @@ type XgressSendBufferDetail struct {
 	TimeSinceLastRetx     string  `json:"timeSinceLastRetx"`
 	CloseWhenEmpty        bool    `json:"closeWhenEmpty"`
 	AcquiredSafely        bool    `json:"acquiredSafely"`
+	CongestionControl     string  `json:"congestionControl"`
+	SlowStartThreshold    uint32  `json:"slowStartThreshold,omitempty"`
+	WindowReductions      uint32  `json:"windowReductions,omitempty"`
 }
 
 type XgressRecvBufferDetail struct {
@@ -99,3 +99,19 @@ func (request *UpdateCtrlAddresses) GetContentType() int32 {
 func (request *PeerStateChanges) GetContentType() int32 {
 	return int32(ContentType_PeerStateChangeRequestType)
 }
+
+func (request *TerminatorHealthCheckRequest) GetContentType() int32 {
+	return int32(ContentType_TerminatorHealthCheckRequestType)
+}
+
+func (response *TerminatorHealthCheckResponse) GetContentType() int32 {
+	return int32(ContentType_TerminatorHealthCheckResponseType)
+}
+
+func (request *CircuitFailover) GetContentType() int32 {
+	return int32(ContentType_CircuitFailoverType)
+}
+
+func (status *RouterMaintenanceStatus) GetContentType() int32 {
+	return int32(ContentType_RouterMaintenanceStatusType)
+}
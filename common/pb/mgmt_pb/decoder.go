@@ -47,6 +47,31 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			return nil, true
 		}
 		return data, true
+	case int32(ContentType_StreamJournalRequestType):
+		meta := channel.NewTraceMessageDecode(DECODER, "Stream Journal Request")
+		meta["request"] = string(msg.Body)
+		data, err := meta.MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
+	case int32(ContentType_StreamJournalEventType):
+		meta := channel.NewTraceMessageDecode(DECODER, "Stream Journal Event")
+		meta["event"] = string(msg.Body)
+		data, err := meta.MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
+	case int32(ContentType_StreamJournalGapType):
+		data, err := channel.NewTraceMessageDecode(DECODER, "Stream Journal Gap").MarshalTraceMessageDecode()
+		if err != nil {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+		return data, true
 	case int32(ContentType_StreamTracesRequestType):
 		data, err := channel.NewTraceMessageDecode(DECODER, "Stream Traces Request").MarshalTraceMessageDecode()
 		if err != nil {
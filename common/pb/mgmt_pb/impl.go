@@ -11,3 +11,7 @@ func (request *InspectResponse) GetContentType() int32 {
 func (request *RaftMemberListResponse) GetContentType() int32 {
 	return int32(ContentType_RaftListMembersResponseType)
 }
+
+func (request *TerminatorDrainRequest) GetContentType() int32 {
+	return int32(ContentType_TerminatorDrainRequestType)
+}
@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/json"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rest_model"
+	"github.com/openziti/fabric/controller/rest_server/operations"
+	"github.com/openziti/fabric/controller/rest_server/operations/journal"
+	"github.com/pkg/errors"
+	"net/http"
+)
+
+const (
+	defaultJournalReadLimit = 100
+	maxJournalReadLimit     = 1000
+)
+
+func init() {
+	r := NewJournalRouter()
+	AddRouter(r)
+}
+
+type JournalRouter struct {
+}
+
+func NewJournalRouter() *JournalRouter {
+	return &JournalRouter{}
+}
+
+func (r *JournalRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.JournalListJournalEventsHandler = journal.ListJournalEventsHandlerFunc(func(params journal.ListJournalEventsParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.ListEvents(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *JournalRouter) ListEvents(n *network.Network, rc api.RequestContext, params journal.ListJournalEventsParams) {
+	eventJournal := event.GetEventJournal(n.GetEventDispatcher())
+	if eventJournal == nil {
+		rc.RespondWithNotFoundWithCause(errors.New("event journal is not enabled"))
+		return
+	}
+
+	fromSequence := uint64(0)
+	if params.FromSequence != nil && *params.FromSequence > 0 {
+		fromSequence = uint64(*params.FromSequence)
+	}
+
+	limit := defaultJournalReadLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = int(*params.Limit)
+		if limit > maxJournalReadLimit {
+			limit = maxJournalReadLimit
+		}
+	}
+
+	entries, nextSequence, err := eventJournal.Read(fromSequence, limit, params.EventTypes...)
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	firstSequence := int64(eventJournal.GetFirstSequence())
+	next := int64(nextSequence)
+	journalId := eventJournal.GetJournalId()
+	result := &rest_model.JournalEventList{
+		JournalID:     &journalId,
+		FirstSequence: &firstSequence,
+		NextSequence:  &next,
+		Events:        []*rest_model.JournalEvent{},
+	}
+
+	for _, entry := range entries {
+		sequence := int64(entry.Sequence)
+		timestamp := strfmt.DateTime(entry.Timestamp)
+		eventType := entry.EventType
+		result.Events = append(result.Events, &rest_model.JournalEvent{
+			Sequence:  &sequence,
+			Timestamp: &timestamp,
+			EventType: &eventType,
+			Event:     json.RawMessage(entry.Event),
+		})
+	}
+
+	rc.Respond(&rest_model.ListJournalEventsEnvelope{
+		Data: result,
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
@@ -50,6 +50,7 @@ func MapLinkToRestModel(_ *network.Network, _ api.RequestContext, link *network.
 	}
 
 	down := link.IsDown()
+	qualityCost := link.GetQualityCost()
 
 	ret := &rest_model.LinkDetail{
 		Cost:          &link.Cost,
@@ -62,6 +63,8 @@ func MapLinkToRestModel(_ *network.Network, _ api.RequestContext, link *network.
 		State:         &linkStateStr,
 		StaticCost:    &staticCost,
 		Protocol:      &link.Protocol,
+		CostTags:      link.GetCostTags(),
+		QualityCost:   qualityCost,
 	}
 	return ret, nil
 }
@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rest_model"
+	"github.com/openziti/foundation/v2/stringz"
+)
+
+const EntityNameLinkCostTag = "link-cost-tags"
+
+var LinkCostTagLinkFactory = NewBasicLinkFactory(EntityNameLinkCostTag)
+
+func MapCreateLinkCostTagToModel(linkCostTag *rest_model.LinkCostTagCreate) *network.LinkCostTag {
+	return &network.LinkCostTag{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(linkCostTag.Tags),
+		},
+		Name:           stringz.OrEmpty(linkCostTag.Name),
+		AdditiveCost:   linkCostTag.AdditiveCost,
+		CostMultiplier: linkCostTag.CostMultiplier,
+	}
+}
+
+func MapUpdateLinkCostTagToModel(id string, linkCostTag *rest_model.LinkCostTagUpdate) *network.LinkCostTag {
+	return &network.LinkCostTag{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(linkCostTag.Tags),
+			Id:   id,
+		},
+		Name:           stringz.OrEmpty(linkCostTag.Name),
+		AdditiveCost:   linkCostTag.AdditiveCost,
+		CostMultiplier: linkCostTag.CostMultiplier,
+	}
+}
+
+func MapPatchLinkCostTagToModel(id string, linkCostTag *rest_model.LinkCostTagPatch) *network.LinkCostTag {
+	return &network.LinkCostTag{
+		BaseEntity: models.BaseEntity{
+			Tags: TagsOrDefault(linkCostTag.Tags),
+			Id:   id,
+		},
+		Name:           linkCostTag.Name,
+		AdditiveCost:   linkCostTag.AdditiveCost,
+		CostMultiplier: linkCostTag.CostMultiplier,
+	}
+}
+
+type LinkCostTagModelMapper struct{}
+
+func (LinkCostTagModelMapper) ToApi(_ *network.Network, _ api.RequestContext, linkCostTag *network.LinkCostTag) (interface{}, error) {
+	return &rest_model.LinkCostTagDetail{
+		BaseEntity:     BaseEntityToRestModel(linkCostTag, LinkCostTagLinkFactory),
+		Name:           &linkCostTag.Name,
+		AdditiveCost:   &linkCostTag.AdditiveCost,
+		CostMultiplier: &linkCostTag.CostMultiplier,
+	}, nil
+}
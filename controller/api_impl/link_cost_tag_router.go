@@ -0,0 +1,102 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rest_server/operations"
+	"github.com/openziti/fabric/controller/rest_server/operations/link"
+)
+
+func init() {
+	r := NewLinkCostTagRouter()
+	AddRouter(r)
+}
+
+type LinkCostTagRouter struct {
+	BasePath string
+}
+
+func NewLinkCostTagRouter() *LinkCostTagRouter {
+	return &LinkCostTagRouter{
+		BasePath: "/" + EntityNameLinkCostTag,
+	}
+}
+
+func (r *LinkCostTagRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.LinkDeleteLinkCostTagHandler = link.DeleteLinkCostTagHandlerFunc(func(params link.DeleteLinkCostTagParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Delete, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkDetailLinkCostTagHandler = link.DetailLinkCostTagHandlerFunc(func(params link.DetailLinkCostTagParams) middleware.Responder {
+		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkListLinkCostTagsHandler = link.ListLinkCostTagsHandlerFunc(func(params link.ListLinkCostTagsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListLinkCostTags, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.LinkUpdateLinkCostTagHandler = link.UpdateLinkCostTagHandlerFunc(func(params link.UpdateLinkCostTagParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Update(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.LinkCreateLinkCostTagHandler = link.CreateLinkCostTagHandlerFunc(func(params link.CreateLinkCostTagParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Create(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.LinkPatchLinkCostTagHandler = link.PatchLinkCostTagHandlerFunc(func(params link.PatchLinkCostTagParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *LinkCostTagRouter) ListLinkCostTags(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*network.LinkCostTag](n, rc, n.Managers.LinkCostTags, LinkCostTagModelMapper{})
+}
+
+func (r *LinkCostTagRouter) Detail(n *network.Network, rc api.RequestContext) {
+	DetailWithHandler[*network.LinkCostTag](n, rc, n.Managers.LinkCostTags, LinkCostTagModelMapper{})
+}
+
+func (r *LinkCostTagRouter) Create(n *network.Network, rc api.RequestContext, params link.CreateLinkCostTagParams) {
+	Create(rc, LinkCostTagLinkFactory, func() (string, error) {
+		linkCostTag := MapCreateLinkCostTagToModel(params.LinkCostTag)
+		err := n.Managers.LinkCostTags.Create(linkCostTag, rc.NewChangeContext())
+		if err != nil {
+			return "", err
+		}
+		return linkCostTag.Id, nil
+	})
+}
+
+func (r *LinkCostTagRouter) Delete(n *network.Network, rc api.RequestContext) {
+	DeleteWithHandler(rc, n.Managers.LinkCostTags)
+}
+
+func (r *LinkCostTagRouter) Update(n *network.Network, rc api.RequestContext, params link.UpdateLinkCostTagParams) {
+	Update(rc, func(id string) error {
+		return n.Managers.LinkCostTags.Update(MapUpdateLinkCostTagToModel(params.ID, params.LinkCostTag), nil, rc.NewChangeContext())
+	})
+}
+
+func (r *LinkCostTagRouter) Patch(n *network.Network, rc api.RequestContext, params link.PatchLinkCostTagParams) {
+	Patch(rc, func(id string, fields fields.UpdatedFields) error {
+		return n.Managers.LinkCostTags.Update(MapPatchLinkCostTagToModel(params.ID, params.LinkCostTag), fields.FilterMaps("tags"), rc.NewChangeContext())
+	})
+}
@@ -0,0 +1,50 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+func MapRateLimitToModel(rateLimit *rest_model.RateLimit) *db.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	ret := &db.RateLimit{}
+	if rateLimit.BytesPerSecond != nil {
+		ret.BytesPerSecond = *rateLimit.BytesPerSecond
+	}
+	if rateLimit.BurstBytes != nil {
+		ret.BurstBytes = *rateLimit.BurstBytes
+	}
+	return ret
+}
+
+func MapRateLimitToRestModel(rateLimit *db.RateLimit) *rest_model.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	bytesPerSecond := rateLimit.BytesPerSecond
+	burstBytes := rateLimit.BurstBytes
+	return &rest_model.RateLimit{
+		BytesPerSecond: &bytesPerSecond,
+		BurstBytes:     &burstBytes,
+	}
+}
@@ -57,6 +57,9 @@ func MapCreateRouterToModel(router *rest_model.RouterCreate) *network.Router {
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		Region:      router.Region,
+		Zone:        router.Zone,
+		Provider:    router.Provider,
 	}
 
 	return ret
@@ -73,6 +76,9 @@ func MapUpdateRouterToModel(id string, router *rest_model.RouterUpdate) *network
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		Region:      router.Region,
+		Zone:        router.Zone,
+		Provider:    router.Provider,
 	}
 
 	return ret
@@ -89,6 +95,9 @@ func MapPatchRouterToModel(id string, router *rest_model.RouterPatch) *network.R
 		Cost:        uint16(Int64OrDefault(router.Cost)),
 		NoTraversal: BoolOrDefault(router.NoTraversal),
 		Disabled:    BoolOrDefault(router.Disabled),
+		Region:      router.Region,
+		Zone:        router.Zone,
+		Provider:    router.Provider,
 	}
 
 	return ret
@@ -121,6 +130,10 @@ func (RouterModelMapper) ToApi(n *network.Network, _ api.RequestContext, router
 		Cost:        &cost,
 		NoTraversal: &router.NoTraversal,
 		Disabled:    &router.Disabled,
+		Region:      router.GetRegion(),
+		Zone:        router.GetZone(),
+		Provider:    router.GetProvider(),
+		Maintenance: router.Maintenance,
 	}
 
 	if connected != nil {
@@ -68,6 +68,14 @@ func (r *RouterRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Req
 	fabricApi.RouterListRouterTerminatorsHandler = router.ListRouterTerminatorsHandlerFunc(func(params router.ListRouterTerminatorsParams) middleware.Responder {
 		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.RouterStartRouterMaintenanceHandler = router.StartRouterMaintenanceHandlerFunc(func(params router.StartRouterMaintenanceParams) middleware.Responder {
+		return wrapper.WrapRequest(r.StartMaintenance, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RouterEndRouterMaintenanceHandler = router.EndRouterMaintenanceHandlerFunc(func(params router.EndRouterMaintenanceParams) middleware.Responder {
+		return wrapper.WrapRequest(r.EndMaintenance, params.HTTPRequest, params.ID, "")
+	})
 }
 
 func (r *RouterRouter) ListRouters(n *network.Network, rc api.RequestContext) {
@@ -105,6 +113,18 @@ func (r *RouterRouter) Patch(n *network.Network, rc api.RequestContext, params r
 	})
 }
 
+func (r *RouterRouter) StartMaintenance(n *network.Network, rc api.RequestContext) {
+	UpdateAllowEmptyBody(rc, func(id string) error {
+		return n.Routers.StartMaintenance(id, rc.NewChangeContext())
+	})
+}
+
+func (r *RouterRouter) EndMaintenance(n *network.Network, rc api.RequestContext) {
+	UpdateAllowEmptyBody(rc, func(id string) error {
+		return n.Routers.EndMaintenance(id, rc.NewChangeContext())
+	})
+}
+
 func (r *RouterRouter) listManagementTerminators(n *network.Network, rc api.RequestContext) {
 	ListAssociationWithHandler[*network.Router, *network.Terminator](n, rc, n.Managers.Routers, n.Managers.Terminators, TerminatorModelMapper{})
 }
@@ -0,0 +1,44 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+func MapRoutingPolicyToModel(policy *rest_model.RoutingPolicy) *db.RoutingPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &db.RoutingPolicy{
+		AllowedRouters: policy.AllowedRouters,
+		DeniedRouters:  policy.DeniedRouters,
+	}
+}
+
+func MapRoutingPolicyToRestModel(policy *db.RoutingPolicy) *rest_model.RoutingPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &rest_model.RoutingPolicy{
+		AllowedRouters: policy.AllowedRouters,
+		DeniedRouters:  policy.DeniedRouters,
+	}
+}
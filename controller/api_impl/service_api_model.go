@@ -54,6 +54,10 @@ func MapCreateServiceToModel(service *rest_model.ServiceCreate) *network.Service
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		MultipathMode:      string(service.MultipathMode),
+		QosClass:           string(service.QosClass),
+		RateLimit:          MapRateLimitToModel(service.RateLimit),
+		RoutingPolicy:      MapRoutingPolicyToModel(service.RoutingPolicy),
 	}
 
 	if ret.Id == "" {
@@ -75,6 +79,10 @@ func MapUpdateServiceToModel(id string, service *rest_model.ServiceUpdate) *netw
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		MultipathMode:      string(service.MultipathMode),
+		QosClass:           string(service.QosClass),
+		RateLimit:          MapRateLimitToModel(service.RateLimit),
+		RoutingPolicy:      MapRoutingPolicyToModel(service.RoutingPolicy),
 	}
 
 	return ret
@@ -88,6 +96,10 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *networ
 		},
 		Name:               service.Name,
 		TerminatorStrategy: service.TerminatorStrategy,
+		MultipathMode:      string(service.MultipathMode),
+		QosClass:           string(service.QosClass),
+		RateLimit:          MapRateLimitToModel(service.RateLimit),
+		RoutingPolicy:      MapRoutingPolicyToModel(service.RoutingPolicy),
 	}
 
 	return ret
@@ -100,5 +112,9 @@ func (ServiceModelMapper) ToApi(_ *network.Network, _ api.RequestContext, servic
 		BaseEntity:         BaseEntityToRestModel(service, ServiceLinkFactory),
 		Name:               &service.Name,
 		TerminatorStrategy: &service.TerminatorStrategy,
+		MultipathMode:      rest_model.MultipathMode(service.MultipathMode),
+		QosClass:           rest_model.QosClass(service.QosClass),
+		RateLimit:          MapRateLimitToRestModel(service.RateLimit),
+		RoutingPolicy:      MapRoutingPolicyToRestModel(service.RoutingPolicy),
 	}, nil
 }
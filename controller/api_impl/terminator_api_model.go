@@ -18,8 +18,10 @@ package api_impl
 
 import (
 	"fmt"
+	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/fabric/controller/xt"
@@ -50,6 +52,9 @@ func MapCreateTerminatorToModel(terminator *rest_model.TerminatorCreate) *networ
 		ret.Cost = uint16(*terminator.Cost)
 	}
 
+	ret.HealthCheck = MapTerminatorHealthCheckToModel(terminator.HealthCheck)
+	ret.RateLimit = MapRateLimitToModel(terminator.RateLimit)
+
 	return ret
 }
 
@@ -71,6 +76,9 @@ func MapUpdateTerminatorToModel(id string, terminator *rest_model.TerminatorUpda
 		ret.Cost = uint16(*terminator.Cost)
 	}
 
+	ret.HealthCheck = MapTerminatorHealthCheckToModel(terminator.HealthCheck)
+	ret.RateLimit = MapRateLimitToModel(terminator.RateLimit)
+
 	return ret
 }
 
@@ -92,9 +100,27 @@ func MapPatchTerminatorToModel(id string, terminator *rest_model.TerminatorPatch
 		ret.Cost = uint16(*terminator.Cost)
 	}
 
+	ret.HealthCheck = MapTerminatorHealthCheckToModel(terminator.HealthCheck)
+	ret.RateLimit = MapRateLimitToModel(terminator.RateLimit)
+
 	return ret
 }
 
+func MapTerminatorHealthCheckToModel(healthCheck *rest_model.TerminatorHealthCheck) *db.TerminatorHealthCheck {
+	if healthCheck == nil {
+		return nil
+	}
+
+	return &db.TerminatorHealthCheck{
+		Type:             stringz.OrEmpty(healthCheck.Type),
+		Address:          healthCheck.Address,
+		Interval:         int32(healthCheck.Interval),
+		Timeout:          int32(healthCheck.Timeout),
+		FailureThreshold: int32(healthCheck.FailureThreshold),
+		SuccessThreshold: int32(healthCheck.SuccessThreshold),
+	}
+}
+
 type TerminatorModelMapper struct{}
 
 func (TerminatorModelMapper) ToApi(n *network.Network, _ api.RequestContext, terminator *network.Terminator) (interface{}, error) {
@@ -137,7 +163,7 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 		HostID:      &terminator.HostId,
 	}
 
-	precedence := terminator.Precedence
+	precedence := terminator.GetPrecedence()
 
 	resultPrecedence := rest_model.TerminatorPrecedenceDefault
 
@@ -149,5 +175,25 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 
 	ret.Precedence = &resultPrecedence
 
+	if healthCheck := terminator.HealthCheck; healthCheck != nil {
+		healthCheckType := healthCheck.Type
+		ret.HealthCheck = &rest_model.TerminatorHealthCheck{
+			Type:             &healthCheckType,
+			Address:          healthCheck.Address,
+			Interval:         int64(healthCheck.Interval),
+			Timeout:          int64(healthCheck.Timeout),
+			FailureThreshold: int64(healthCheck.FailureThreshold),
+			SuccessThreshold: int64(healthCheck.SuccessThreshold),
+		}
+	}
+
+	ret.RateLimit = MapRateLimitToRestModel(terminator.RateLimit)
+
+	ret.Draining = terminator.Draining
+	if terminator.DrainDeadline != nil {
+		ret.DrainDeadline = strfmt.DateTime(*terminator.DrainDeadline)
+	}
+	ret.HealthFailed = terminator.HealthFailed
+
 	return ret, nil
 }
@@ -17,6 +17,8 @@
 package api_impl
 
 import (
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/fields"
@@ -64,6 +66,14 @@ func (r *TerminatorRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper
 	fabricApi.TerminatorPatchTerminatorHandler = terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Patch(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.TerminatorDrainTerminatorHandler = terminator.DrainTerminatorHandlerFunc(func(params terminator.DrainTerminatorParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Drain(n, rc, params) }, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.TerminatorCancelTerminatorDrainHandler = terminator.CancelTerminatorDrainHandlerFunc(func(params terminator.CancelTerminatorDrainParams) middleware.Responder {
+		return wrapper.WrapRequest(r.CancelDrain, params.HTTPRequest, params.ID, "")
+	})
 }
 
 func (r *TerminatorRouter) List(n *network.Network, rc api.RequestContext) {
@@ -100,3 +110,19 @@ func (r *TerminatorRouter) Patch(n *network.Network, rc api.RequestContext, para
 		return n.Managers.Terminators.Update(MapPatchTerminatorToModel(params.ID, params.Terminator), fields.FilterMaps("tags"), rc.NewChangeContext())
 	})
 }
+
+func (r *TerminatorRouter) Drain(n *network.Network, rc api.RequestContext, params terminator.DrainTerminatorParams) {
+	UpdateAllowEmptyBody(rc, func(id string) error {
+		var forceCloseAfter time.Duration
+		if params.Drain != nil && params.Drain.ForceCloseAfter != nil {
+			forceCloseAfter = time.Duration(*params.Drain.ForceCloseAfter) * time.Second
+		}
+		return n.Terminators.DrainTerminator(id, forceCloseAfter, rc.NewChangeContext())
+	})
+}
+
+func (r *TerminatorRouter) CancelDrain(n *network.Network, rc api.RequestContext) {
+	UpdateAllowEmptyBody(rc, func(id string) error {
+		return n.Terminators.CancelDrain(id, rc.NewChangeContext())
+	})
+}
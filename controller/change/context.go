@@ -50,6 +50,7 @@ const (
 
 const (
 	SourceTypeControlChannel = "ctrl.channel"
+	SourceTypeMgmtChannel    = "mgmt.channel"
 	SourceTypeRest           = "rest"
 	SourceTypeXt             = "xt"
 )
@@ -30,6 +30,7 @@ import (
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/identity"
 	"github.com/openziti/storage/boltz"
@@ -78,6 +79,11 @@ type Config struct {
 			InitialDelay time.Duration
 		}
 	}
+	TerminatorStrategies struct {
+		Latency struct {
+			PathConstraints xt.PathConstraints
+		}
+	}
 	src map[interface{}]interface{}
 }
 
@@ -459,6 +465,35 @@ func LoadConfig(path string) (*Config, error) {
 		}
 	}
 
+	if value, found := cfgmap["terminatorStrategies"]; found {
+		if strategiesMap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := strategiesMap["latency"]; found {
+				if latencyMap, ok := value.(map[interface{}]interface{}); ok {
+					constraints := &controllerConfig.TerminatorStrategies.Latency.PathConstraints
+					if value, found := latencyMap["maxLinkLatency"]; found {
+						if val, err := time.ParseDuration(fmt.Sprintf("%v", value)); err == nil {
+							constraints.MaxLinkLatency = val
+						} else {
+							return nil, errors.Wrapf(err, "failed to parse terminatorStrategies.latency.maxLinkLatency value '%v", value)
+						}
+					}
+
+					if value, found := latencyMap["maxHops"]; found {
+						if val, ok := value.(int); ok && val >= 0 {
+							constraints.MaxHops = val
+						} else {
+							return nil, errors.Errorf("invalid terminatorStrategies.latency.maxHops value '%v'", value)
+						}
+					}
+				} else {
+					pfxlog.Logger().Warn("invalid [terminatorStrategies.latency] stanza")
+				}
+			}
+		} else {
+			pfxlog.Logger().Warn("invalid [terminatorStrategies] stanza")
+		}
+	}
+
 	return controllerConfig, nil
 }
 
@@ -47,13 +47,17 @@ import (
 	"github.com/openziti/fabric/controller/command"
 	"github.com/openziti/fabric/controller/handler_ctrl"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/prometheus"
 	"github.com/openziti/fabric/controller/raft"
 	"github.com/openziti/fabric/controller/raft/mesh"
 	"github.com/openziti/fabric/controller/xctrl"
 	"github.com/openziti/fabric/controller/xmgmt"
 	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_latency"
+	"github.com/openziti/fabric/controller/xt_leastactive"
 	"github.com/openziti/fabric/controller/xt_random"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
+	"github.com/openziti/fabric/controller/xt_sticky"
 	"github.com/openziti/fabric/controller/xt_weighted"
 	"github.com/openziti/foundation/v2/versions"
 	"github.com/openziti/identity"
@@ -233,6 +237,10 @@ func (c *Controller) initWeb() {
 		logrus.WithError(err).Fatalf("failed to create metrics api factory")
 	}
 
+	if err := c.xweb.GetRegistry().Add(prometheus.NewScrapeApiFactory(c.network)); err != nil {
+		logrus.WithError(err).Fatalf("failed to create prometheus scrape api factory")
+	}
+
 }
 
 func (c *Controller) Run() error {
@@ -382,6 +390,9 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_smartrouting.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_latency.NewFactoryWithPathConstraints(&c.config.TerminatorStrategies.Latency.PathConstraints))
+	xt.GlobalRegistry().RegisterFactory(xt_sticky.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_leastactive.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
@@ -432,6 +443,10 @@ func (c *Controller) GetEventDispatcher() event.Dispatcher {
 }
 
 func (c *Controller) routerDispatchCallback(evt *event.ClusterEvent) {
+	if evt.EventType == event.ClusterLeadershipGained || evt.EventType == event.ClusterLeadershipLost {
+		c.network.ReconcileTerminatorCircuitCounts()
+	}
+
 	if evt.EventType == event.ClusterMembersChanged {
 		var endpoints []string
 		for _, peer := range evt.Peers {
@@ -0,0 +1,137 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	EntityTypeLinkCostTags           = "linkCostTags"
+	FieldLinkCostTagAdditiveCost     = "additiveCost"
+	FieldLinkCostTagCostMultiplier   = "costMultiplier"
+	DefaultLinkCostTagCostMultiplier = 1.0
+	MaxLinkCostTagCostMultiplier     = 1000.0
+	MaxLinkCostTagAdditiveCost       = 1_000_000
+)
+
+// LinkCostTag defines how the cost of links which have the named cost tag is adjusted. Links get their cost tags
+// from the link listener they were dialed on. The link cost is first multiplied by the cost multipliers of all its
+// tags, then the additive costs of all its tags are added.
+type LinkCostTag struct {
+	boltz.BaseExtEntity
+	Name           string  `json:"name"`
+	AdditiveCost   int64   `json:"additiveCost"`
+	CostMultiplier float64 `json:"costMultiplier"`
+}
+
+func (entity *LinkCostTag) GetEntityType() string {
+	return EntityTypeLinkCostTags
+}
+
+func (entity *LinkCostTag) GetName() string {
+	return entity.Name
+}
+
+type LinkCostTagStore interface {
+	boltz.EntityStore[*LinkCostTag]
+	boltz.EntityStrategy[*LinkCostTag]
+	GetNameIndex() boltz.ReadIndex
+	FindByName(tx *bbolt.Tx, name string) (*LinkCostTag, error)
+}
+
+func newLinkCostTagStore(stores *stores) *linkCostTagStoreImpl {
+	store := &linkCostTagStoreImpl{}
+	store.baseStore = baseStore[*LinkCostTag]{
+		stores:    stores,
+		BaseStore: boltz.NewBaseStore(NewStoreDefinition[*LinkCostTag](store)),
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type linkCostTagStoreImpl struct {
+	baseStore[*LinkCostTag]
+	indexName boltz.ReadIndex
+}
+
+func (store *linkCostTagStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+
+	symbolName := store.AddSymbol(FieldName, ast.NodeTypeString)
+	store.indexName = store.AddUniqueIndex(symbolName)
+
+	store.AddSymbol(FieldLinkCostTagAdditiveCost, ast.NodeTypeInt64)
+	store.AddSymbol(FieldLinkCostTagCostMultiplier, ast.NodeTypeFloat64)
+}
+
+func (store *linkCostTagStoreImpl) initializeLinked() {
+}
+
+func (store *linkCostTagStoreImpl) NewEntity() *LinkCostTag {
+	return &LinkCostTag{}
+}
+
+func (store *linkCostTagStoreImpl) FillEntity(entity *LinkCostTag, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Name = bucket.GetStringOrError(FieldName)
+	entity.AdditiveCost = bucket.GetInt64WithDefault(FieldLinkCostTagAdditiveCost, 0)
+	entity.CostMultiplier = DefaultLinkCostTagCostMultiplier
+	if multiplier := bucket.GetFloat64(FieldLinkCostTagCostMultiplier); multiplier != nil {
+		entity.CostMultiplier = *multiplier
+	}
+}
+
+func (store *linkCostTagStoreImpl) PersistEntity(entity *LinkCostTag, ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetString(FieldName, entity.Name)
+
+	if entity.AdditiveCost < 0 || entity.AdditiveCost > MaxLinkCostTagAdditiveCost {
+		ctx.Bucket.SetError(errorz.NewFieldError("additive cost must be between 0 and 1000000",
+			FieldLinkCostTagAdditiveCost, entity.AdditiveCost))
+		return
+	}
+	ctx.SetInt64(FieldLinkCostTagAdditiveCost, entity.AdditiveCost)
+
+	if ctx.ProceedWithSet(FieldLinkCostTagCostMultiplier) {
+		if entity.CostMultiplier == 0 {
+			entity.CostMultiplier = DefaultLinkCostTagCostMultiplier
+		}
+		if entity.CostMultiplier < 0 || entity.CostMultiplier > MaxLinkCostTagCostMultiplier {
+			ctx.Bucket.SetError(errorz.NewFieldError("cost multiplier must be greater than 0 and at most 1000",
+				FieldLinkCostTagCostMultiplier, entity.CostMultiplier))
+			return
+		}
+		ctx.Bucket.SetFloat64(FieldLinkCostTagCostMultiplier, entity.CostMultiplier, ctx.FieldChecker)
+	}
+}
+
+func (store *linkCostTagStoreImpl) GetNameIndex() boltz.ReadIndex {
+	return store.indexName
+}
+
+func (store *linkCostTagStoreImpl) FindByName(tx *bbolt.Tx, name string) (*LinkCostTag, error) {
+	id := store.indexName.Read(tx, []byte(name))
+	if id != nil {
+		entity, _, err := store.FindById(tx, string(id))
+		return entity, err
+	}
+	return nil, nil
+}
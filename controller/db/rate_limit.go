@@ -0,0 +1,94 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldRateLimit               = "rateLimit"
+	FieldRateLimitBytesPerSecond = "bytesPerSecond"
+	FieldRateLimitBurstBytes     = "burstBytes"
+)
+
+// RateLimit configures a per-circuit token bucket throughput limit. The limit applies to each direction of a
+// circuit separately. If BurstBytes is zero, the burst size defaults to one second worth of data.
+type RateLimit struct {
+	BytesPerSecond int64 `json:"bytesPerSecond"`
+	BurstBytes     int64 `json:"burstBytes"`
+}
+
+// Min returns the more restrictive of the two limits. Either limit may be nil, in which case the other is returned.
+func (entity *RateLimit) Min(other *RateLimit) *RateLimit {
+	if entity == nil || entity.BytesPerSecond <= 0 {
+		return other
+	}
+	if other == nil || other.BytesPerSecond <= 0 {
+		return entity
+	}
+
+	result := *entity
+	if other.BytesPerSecond < result.BytesPerSecond {
+		result.BytesPerSecond = other.BytesPerSecond
+	}
+	if result.BurstBytes == 0 || (other.BurstBytes > 0 && other.BurstBytes < result.BurstBytes) {
+		result.BurstBytes = other.BurstBytes
+	}
+	return &result
+}
+
+func (entity *RateLimit) validate() error {
+	if entity.BytesPerSecond <= 0 {
+		return errorz.NewFieldError("rate limit bytes per second must be greater than zero",
+			FieldRateLimit+"."+FieldRateLimitBytesPerSecond, entity.BytesPerSecond)
+	}
+	if entity.BurstBytes < 0 {
+		return errorz.NewFieldError("rate limit burst bytes may not be negative",
+			FieldRateLimit+"."+FieldRateLimitBurstBytes, entity.BurstBytes)
+	}
+	return nil
+}
+
+func loadRateLimit(bucket *boltz.TypedBucket) *RateLimit {
+	rateLimitBucket := bucket.GetBucket(FieldRateLimit)
+	if rateLimitBucket == nil {
+		return nil
+	}
+	return &RateLimit{
+		BytesPerSecond: rateLimitBucket.GetInt64WithDefault(FieldRateLimitBytesPerSecond, 0),
+		BurstBytes:     rateLimitBucket.GetInt64WithDefault(FieldRateLimitBurstBytes, 0),
+	}
+}
+
+func persistRateLimit(ctx *boltz.PersistContext, rateLimit *RateLimit) {
+	if !ctx.ProceedWithSet(FieldRateLimit) {
+		return
+	}
+
+	_ = ctx.Bucket.DeleteBucket([]byte(FieldRateLimit))
+	if rateLimit != nil {
+		if err := rateLimit.validate(); err != nil {
+			ctx.Bucket.SetError(err)
+			return
+		}
+		rateLimitBucket := ctx.Bucket.GetOrCreateBucket(FieldRateLimit)
+		rateLimitBucket.SetInt64(FieldRateLimitBytesPerSecond, rateLimit.BytesPerSecond, nil)
+		rateLimitBucket.SetInt64(FieldRateLimitBurstBytes, rateLimit.BurstBytes, nil)
+	}
+}
@@ -28,6 +28,10 @@ const (
 	FieldRouterCost        = "cost"
 	FieldRouterNoTraversal = "noTraversal"
 	FieldRouterDisabled    = "disabled"
+	FieldRouterRegion      = "region"
+	FieldRouterZone        = "zone"
+	FieldRouterProvider    = "provider"
+	FieldRouterMaintenance = "maintenance"
 )
 
 type Router struct {
@@ -37,6 +41,12 @@ type Router struct {
 	Cost        uint16  `json:"cost"`
 	NoTraversal bool    `json:"noTraversal"`
 	Disabled    bool    `json:"disabled"`
+	Region      string  `json:"region"`
+	Zone        string  `json:"zone"`
+	Provider    string  `json:"provider"`
+
+	// Routers in maintenance aren't used as transit for new paths, and circuits transiting them are rerouted
+	Maintenance bool `json:"maintenance"`
 }
 
 func (entity *Router) GetEntityType() string {
@@ -77,6 +87,10 @@ func (store *routerStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldRouterCost, ast.NodeTypeInt64)
 	store.AddSymbol(FieldRouterNoTraversal, ast.NodeTypeBool)
 	store.AddSymbol(FieldRouterDisabled, ast.NodeTypeBool)
+	store.AddSymbol(FieldRouterRegion, ast.NodeTypeString)
+	store.AddSymbol(FieldRouterZone, ast.NodeTypeString)
+	store.AddSymbol(FieldRouterProvider, ast.NodeTypeString)
+	store.AddSymbol(FieldRouterMaintenance, ast.NodeTypeBool)
 }
 
 func (store *routerStoreImpl) initializeLinked() {
@@ -93,6 +107,10 @@ func (self *routerStoreImpl) FillEntity(entity *Router, bucket *boltz.TypedBucke
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldRouterCost, 0))
 	entity.NoTraversal = bucket.GetBoolWithDefault(FieldRouterNoTraversal, false)
 	entity.Disabled = bucket.GetBoolWithDefault(FieldRouterDisabled, false)
+	entity.Region = bucket.GetStringWithDefault(FieldRouterRegion, "")
+	entity.Zone = bucket.GetStringWithDefault(FieldRouterZone, "")
+	entity.Provider = bucket.GetStringWithDefault(FieldRouterProvider, "")
+	entity.Maintenance = bucket.GetBoolWithDefault(FieldRouterMaintenance, false)
 }
 
 func (self *routerStoreImpl) PersistEntity(entity *Router, ctx *boltz.PersistContext) {
@@ -102,6 +120,14 @@ func (self *routerStoreImpl) PersistEntity(entity *Router, ctx *boltz.PersistCon
 	ctx.SetInt32(FieldRouterCost, int32(entity.Cost))
 	ctx.SetBool(FieldRouterNoTraversal, entity.NoTraversal)
 	ctx.SetBool(FieldRouterDisabled, entity.Disabled)
+	ctx.SetString(FieldRouterRegion, entity.Region)
+	ctx.SetString(FieldRouterZone, entity.Zone)
+	ctx.SetString(FieldRouterProvider, entity.Provider)
+
+	// maintenance is only changed by explicitly updating it, so that replacing a router doesn't end maintenance
+	if ctx.FieldChecker != nil {
+		ctx.SetBool(FieldRouterMaintenance, entity.Maintenance)
+	}
 }
 
 func (store *routerStoreImpl) GetNameIndex() boltz.ReadIndex {
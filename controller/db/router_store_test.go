@@ -68,9 +68,24 @@ func (ctx *TestContext) testCreateRouters(t *testing.T) {
 		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
 		Name:          uuid.New().String(),
 		Cost:          2,
+		Region:        "us-east",
+		Zone:          "us-east-1a",
+		Provider:      "aws",
 	}
 	boltztest.RequireCreate(ctx, router)
 	boltztest.ValidateBaseline(ctx, router)
+
+	err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+		ids, _, err := ctx.stores.Router.QueryIds(tx, `region = "us-east" and zone = "us-east-1a" and provider = "aws"`)
+		ctx.NoError(err)
+		ctx.Equal([]string{router.Id}, ids)
+
+		ids, _, err = ctx.stores.Router.QueryIds(tx, `region = "us-west"`)
+		ctx.NoError(err)
+		ctx.Empty(ids)
+		return nil
+	})
+	ctx.NoError(err)
 }
 
 type routerTestEntities struct {
@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	FieldRoutingPolicy               = "routingPolicy"
+	FieldRoutingPolicyAllowedRouters = "allowedRouters"
+	FieldRoutingPolicyDeniedRouters  = "deniedRouters"
+)
+
+// RoutingPolicy restricts which routers circuits for a service may traverse. Each entry is either a router id or a
+// router selector, in the same format as path constraints. If any allowed routers are given, every router on a
+// circuit path must match at least one of them. Routers matching any denied entry are never used. The policy
+// applies to the whole path, including the ingress and egress routers, so that traffic never passes through a
+// router outside the allowed set.
+type RoutingPolicy struct {
+	AllowedRouters []string `json:"allowedRouters"`
+	DeniedRouters  []string `json:"deniedRouters"`
+}
+
+func (entity *RoutingPolicy) IsEmpty() bool {
+	return entity == nil || (len(entity.AllowedRouters) == 0 && len(entity.DeniedRouters) == 0)
+}
+
+func (entity *RoutingPolicy) validate() error {
+	for _, selector := range entity.AllowedRouters {
+		if selector == "" {
+			return errorz.NewFieldError("routing policy allowed routers may not contain empty entries",
+				FieldRoutingPolicy+"."+FieldRoutingPolicyAllowedRouters, entity.AllowedRouters)
+		}
+	}
+	for _, selector := range entity.DeniedRouters {
+		if selector == "" {
+			return errorz.NewFieldError("routing policy denied routers may not contain empty entries",
+				FieldRoutingPolicy+"."+FieldRoutingPolicyDeniedRouters, entity.DeniedRouters)
+		}
+	}
+	return nil
+}
+
+func loadRoutingPolicy(bucket *boltz.TypedBucket) *RoutingPolicy {
+	policyBucket := bucket.GetBucket(FieldRoutingPolicy)
+	if policyBucket == nil {
+		return nil
+	}
+	return &RoutingPolicy{
+		AllowedRouters: policyBucket.GetStringList(FieldRoutingPolicyAllowedRouters),
+		DeniedRouters:  policyBucket.GetStringList(FieldRoutingPolicyDeniedRouters),
+	}
+}
+
+func persistRoutingPolicy(ctx *boltz.PersistContext, policy *RoutingPolicy) {
+	if !ctx.ProceedWithSet(FieldRoutingPolicy) {
+		return
+	}
+
+	_ = ctx.Bucket.DeleteBucket([]byte(FieldRoutingPolicy))
+	if !policy.IsEmpty() {
+		if err := policy.validate(); err != nil {
+			ctx.Bucket.SetError(err)
+			return
+		}
+		policyBucket := ctx.Bucket.GetOrCreateBucket(FieldRoutingPolicy)
+		policyBucket.SetStringList(FieldRoutingPolicyAllowedRouters, policy.AllowedRouters, nil)
+		policyBucket.SetStringList(FieldRoutingPolicyDeniedRouters, policy.DeniedRouters, nil)
+	}
+}
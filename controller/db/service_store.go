@@ -19,6 +19,7 @@ package db
 import (
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
@@ -27,12 +28,40 @@ import (
 const (
 	EntityTypeServices             = "services"
 	FieldServiceTerminatorStrategy = "terminatorStrategy"
+	FieldServiceMultipathMode      = "multipathMode"
+	FieldServiceQosClass           = "qosClass"
+
+	MultipathModeNone      = "none"
+	MultipathModeStripe    = "stripe"
+	MultipathModeDuplicate = "duplicate"
+
+	QosClassInteractive = "interactive"
+	QosClassDefault     = "default"
+	QosClassBulk        = "bulk"
 )
 
 type Service struct {
 	boltz.BaseExtEntity
-	Name               string `json:"name"`
-	TerminatorStrategy string `json:"terminatorStrategy"`
+	Name               string         `json:"name"`
+	TerminatorStrategy string         `json:"terminatorStrategy"`
+	MultipathMode      string         `json:"multipathMode"`
+	QosClass           string         `json:"qosClass"`
+	RateLimit          *RateLimit     `json:"rateLimit"`
+	RoutingPolicy      *RoutingPolicy `json:"routingPolicy"`
+}
+
+// IsMultipath returns true if circuits for this service should be established over both a primary and a
+// link-disjoint secondary path
+func (entity *Service) IsMultipath() bool {
+	return IsMultipathMode(entity.MultipathMode)
+}
+
+func IsMultipathMode(mode string) bool {
+	return mode == MultipathModeStripe || mode == MultipathModeDuplicate
+}
+
+func IsQosClass(class string) bool {
+	return class == QosClassInteractive || class == QosClassDefault || class == QosClassBulk
 }
 
 func (entity *Service) GetEntityType() string {
@@ -73,6 +102,8 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMultipathMode, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceQosClass, ast.NodeTypeString)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -91,12 +122,46 @@ func (store *serviceStoreImpl) FillEntity(entity *Service, bucket *boltz.TypedBu
 	entity.LoadBaseValues(bucket)
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
+	entity.MultipathMode = bucket.GetStringWithDefault(FieldServiceMultipathMode, MultipathModeNone)
+	entity.QosClass = bucket.GetStringWithDefault(FieldServiceQosClass, QosClassDefault)
+	entity.RateLimit = loadRateLimit(bucket)
+	entity.RoutingPolicy = loadRoutingPolicy(bucket)
 }
 
 func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.PersistContext) {
 	entity.SetBaseValues(ctx)
 	ctx.SetString(FieldName, entity.Name)
 
+	if entity.MultipathMode == "" {
+		entity.MultipathMode = MultipathModeNone
+	}
+	if entity.MultipathMode != MultipathModeNone && !IsMultipathMode(entity.MultipathMode) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid multipath mode, must be one of none, stripe or duplicate",
+			FieldServiceMultipathMode, entity.MultipathMode))
+		return
+	}
+	ctx.SetString(FieldServiceMultipathMode, entity.MultipathMode)
+
+	if entity.QosClass == "" {
+		entity.QosClass = QosClassDefault
+	}
+	if !IsQosClass(entity.QosClass) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid qos class, must be one of interactive, default or bulk",
+			FieldServiceQosClass, entity.QosClass))
+		return
+	}
+	ctx.SetString(FieldServiceQosClass, entity.QosClass)
+
+	persistRateLimit(ctx, entity.RateLimit)
+	if ctx.Bucket.HasError() {
+		return
+	}
+
+	persistRoutingPolicy(ctx, entity.RoutingPolicy)
+	if ctx.Bucket.HasError() {
+		return
+	}
+
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
 	}
@@ -38,12 +38,13 @@ func NewStoreDefinition[E boltz.ExtEntity](strategy boltz.EntityStrategy[E]) bol
 }
 
 type Stores struct {
-	Terminator TerminatorStore
-	Router     RouterStore
-	Service    ServiceStore
-	storeMap   map[string]boltz.Store
-	lock       sync.Mutex
-	checkables []boltz.Checkable
+	Terminator  TerminatorStore
+	Router      RouterStore
+	Service     ServiceStore
+	LinkCostTag LinkCostTagStore
+	storeMap    map[string]boltz.Store
+	lock        sync.Mutex
+	checkables  []boltz.Checkable
 }
 
 func (store *Stores) AddCheckable(checkable boltz.Checkable) {
@@ -114,9 +115,10 @@ func (stores *Stores) CheckIntegrityInTx(db boltz.Db, ctx boltz.MutateContext, f
 }
 
 type stores struct {
-	terminator *terminatorStoreImpl
-	router     *routerStoreImpl
-	service    *serviceStoreImpl
+	terminator  *terminatorStoreImpl
+	router      *routerStoreImpl
+	service     *serviceStoreImpl
+	linkCostTag *linkCostTagStoreImpl
 }
 
 func InitStores(db boltz.Db) (*Stores, error) {
@@ -125,11 +127,13 @@ func InitStores(db boltz.Db) (*Stores, error) {
 	internalStores.terminator = newTerminatorStore(internalStores)
 	internalStores.router = newRouterStore(internalStores)
 	internalStores.service = newServiceStore(internalStores)
+	internalStores.linkCostTag = newLinkCostTagStore(internalStores)
 
 	stores := &Stores{
-		Terminator: internalStores.terminator,
-		Router:     internalStores.router,
-		Service:    internalStores.service,
+		Terminator:  internalStores.terminator,
+		Router:      internalStores.router,
+		Service:     internalStores.service,
+		LinkCostTag: internalStores.linkCostTag,
 	}
 
 	stores.buildStoreMap()
@@ -137,10 +141,12 @@ func InitStores(db boltz.Db) (*Stores, error) {
 	internalStores.terminator.initializeLocal()
 	internalStores.router.initializeLocal()
 	internalStores.service.initializeLocal()
+	internalStores.linkCostTag.initializeLocal()
 
 	internalStores.terminator.initializeLinked()
 	internalStores.router.initializeLinked()
 	internalStores.service.initializeLinked()
+	internalStores.linkCostTag.initializeLinked()
 
 	mm := boltz.NewMigratorManager(db)
 	if err := mm.Migrate("fabric", CurrentDbVersion, internalStores.migrate); err != nil {
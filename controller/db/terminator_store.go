@@ -20,10 +20,12 @@ import (
 	"encoding/binary"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/sequence"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
@@ -39,8 +41,83 @@ const (
 	FieldServerPeerData            = "peerData"
 	FieldTerminatorHostId          = "hostId"
 	FieldTerminatorSavedPrecedence = "savedPrecedence"
+	FieldTerminatorHealthCheck     = "healthCheck"
+	FieldTerminatorDraining        = "draining"
+	FieldTerminatorHealthFailed    = "healthFailed"
+	FieldTerminatorDrainDeadline   = "drainDeadline"
+
+	FieldTerminatorHealthCheckType             = "type"
+	FieldTerminatorHealthCheckAddress          = "address"
+	FieldTerminatorHealthCheckInterval         = "interval"
+	FieldTerminatorHealthCheckTimeout          = "timeout"
+	FieldTerminatorHealthCheckFailureThreshold = "failureThreshold"
+	FieldTerminatorHealthCheckSuccessThreshold = "successThreshold"
+
+	TerminatorHealthCheckTypeTcp    = "tcp"
+	TerminatorHealthCheckTypeHttp   = "http"
+	TerminatorHealthCheckTypeRouter = "router"
+
+	DefaultTerminatorHealthCheckInterval         = 30
+	DefaultTerminatorHealthCheckTimeout          = 5
+	DefaultTerminatorHealthCheckFailureThreshold = 3
+	DefaultTerminatorHealthCheckSuccessThreshold = 1
 )
 
+// TerminatorHealthCheck configures an active health check for a terminator. Intervals and timeouts are in seconds.
+type TerminatorHealthCheck struct {
+	Type             string `json:"type"`
+	Address          string `json:"address"`
+	Interval         int32  `json:"interval"`
+	Timeout          int32  `json:"timeout"`
+	FailureThreshold int32  `json:"failureThreshold"`
+	SuccessThreshold int32  `json:"successThreshold"`
+}
+
+func (entity *TerminatorHealthCheck) fill(bucket *boltz.TypedBucket) {
+	entity.Type = bucket.GetStringWithDefault(FieldTerminatorHealthCheckType, "")
+	entity.Address = bucket.GetStringWithDefault(FieldTerminatorHealthCheckAddress, "")
+	entity.Interval = bucket.GetInt32WithDefault(FieldTerminatorHealthCheckInterval, DefaultTerminatorHealthCheckInterval)
+	entity.Timeout = bucket.GetInt32WithDefault(FieldTerminatorHealthCheckTimeout, DefaultTerminatorHealthCheckTimeout)
+	entity.FailureThreshold = bucket.GetInt32WithDefault(FieldTerminatorHealthCheckFailureThreshold, DefaultTerminatorHealthCheckFailureThreshold)
+	entity.SuccessThreshold = bucket.GetInt32WithDefault(FieldTerminatorHealthCheckSuccessThreshold, DefaultTerminatorHealthCheckSuccessThreshold)
+}
+
+func (entity *TerminatorHealthCheck) applyDefaults() {
+	if entity.Interval <= 0 {
+		entity.Interval = DefaultTerminatorHealthCheckInterval
+	}
+	if entity.Timeout <= 0 {
+		entity.Timeout = DefaultTerminatorHealthCheckTimeout
+	}
+	if entity.FailureThreshold <= 0 {
+		entity.FailureThreshold = DefaultTerminatorHealthCheckFailureThreshold
+	}
+	if entity.SuccessThreshold <= 0 {
+		entity.SuccessThreshold = DefaultTerminatorHealthCheckSuccessThreshold
+	}
+}
+
+func (entity *TerminatorHealthCheck) validate() error {
+	switch entity.Type {
+	case TerminatorHealthCheckTypeTcp, TerminatorHealthCheckTypeHttp, TerminatorHealthCheckTypeRouter:
+	default:
+		return errorz.NewFieldError("invalid health check type, must be one of tcp, http or router",
+			FieldTerminatorHealthCheck+"."+FieldTerminatorHealthCheckType, entity.Type)
+	}
+
+	if entity.Type == TerminatorHealthCheckTypeHttp && entity.Address == "" {
+		return errorz.NewFieldError("address is required for http health checks",
+			FieldTerminatorHealthCheck+"."+FieldTerminatorHealthCheckAddress, entity.Address)
+	}
+
+	if entity.Timeout > entity.Interval {
+		return errorz.NewFieldError("health check timeout may not be greater than the interval",
+			FieldTerminatorHealthCheck+"."+FieldTerminatorHealthCheckTimeout, entity.Timeout)
+	}
+
+	return nil
+}
+
 type Terminator struct {
 	boltz.BaseExtEntity
 	Service         string      `json:"service"`
@@ -54,13 +131,30 @@ type Terminator struct {
 	PeerData        xt.PeerData `json:"peerData"`
 	HostId          string      `json:"hostId"`
 	SavedPrecedence *string     `json:"savedPrecedence"`
+
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck"`
+	RateLimit   *RateLimit             `json:"rateLimit"`
+
+	// Draining terminators don't receive new circuits. If DrainDeadline is set, circuits still using the terminator
+	// when it passes are closed.
+	Draining      bool       `json:"draining"`
+	DrainDeadline *time.Time `json:"drainDeadline"`
+
+	// HealthFailed is set while the terminator is failing its health check. It's stored separately from the
+	// precedence, so that it doesn't interfere with precedence changes made by operators or by quiescing the router,
+	// but the precedence is reported as failed while it's set.
+	HealthFailed bool `json:"healthFailed"`
 }
 
 func (entity *Terminator) GetCost() uint16 {
 	return entity.Cost
 }
 
+// GetPrecedence returns the effective precedence, which is failed while the terminator is failing its health check
 func (entity *Terminator) GetPrecedence() xt.Precedence {
+	if entity.HealthFailed {
+		return xt.Precedences.Failed
+	}
 	return xt.GetPrecedenceForName(entity.Precedence)
 }
 
@@ -133,6 +227,8 @@ func (store *terminatorStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldTerminatorAddress, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorInstanceId, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorHostId, ast.NodeTypeString)
+	store.AddSymbol(FieldTerminatorDraining, ast.NodeTypeBool)
+	store.AddSymbol(FieldTerminatorHealthFailed, ast.NodeTypeBool)
 
 	store.serviceSymbol = store.AddFkSymbol(FieldTerminatorService, store.stores.service)
 	store.routerSymbol = store.AddFkSymbol(FieldTerminatorRouter, store.stores.router)
@@ -165,6 +261,15 @@ func (store *terminatorStoreImpl) FillEntity(entity *Terminator, bucket *boltz.T
 	entity.HostId = bucket.GetStringWithDefault(FieldTerminatorHostId, "")
 	entity.SavedPrecedence = bucket.GetString(FieldTerminatorSavedPrecedence)
 
+	if healthCheckBucket := bucket.GetBucket(FieldTerminatorHealthCheck); healthCheckBucket != nil {
+		entity.HealthCheck = &TerminatorHealthCheck{}
+		entity.HealthCheck.fill(healthCheckBucket)
+	}
+	entity.RateLimit = loadRateLimit(bucket)
+	entity.Draining = bucket.GetBoolWithDefault(FieldTerminatorDraining, false)
+	entity.DrainDeadline = bucket.GetTime(FieldTerminatorDrainDeadline)
+	entity.HealthFailed = bucket.GetBoolWithDefault(FieldTerminatorHealthFailed, false)
+
 	data := bucket.GetBucket(FieldServerPeerData)
 	if data != nil {
 		entity.PeerData = make(map[uint32][]byte)
@@ -214,6 +319,34 @@ func (store *terminatorStoreImpl) PersistEntity(entity *Terminator, ctx *boltz.P
 		}
 	}
 
+	if ctx.ProceedWithSet(FieldTerminatorHealthCheck) {
+		_ = ctx.Bucket.DeleteBucket([]byte(FieldTerminatorHealthCheck))
+		if entity.HealthCheck != nil {
+			entity.HealthCheck.applyDefaults()
+			if err := entity.HealthCheck.validate(); err != nil {
+				ctx.Bucket.SetError(err)
+				return
+			}
+			healthCheckBucket := ctx.Bucket.GetOrCreateBucket(FieldTerminatorHealthCheck)
+			healthCheckBucket.SetString(FieldTerminatorHealthCheckType, entity.HealthCheck.Type, nil)
+			healthCheckBucket.SetString(FieldTerminatorHealthCheckAddress, entity.HealthCheck.Address, nil)
+			healthCheckBucket.SetInt32(FieldTerminatorHealthCheckInterval, entity.HealthCheck.Interval, nil)
+			healthCheckBucket.SetInt32(FieldTerminatorHealthCheckTimeout, entity.HealthCheck.Timeout, nil)
+			healthCheckBucket.SetInt32(FieldTerminatorHealthCheckFailureThreshold, entity.HealthCheck.FailureThreshold, nil)
+			healthCheckBucket.SetInt32(FieldTerminatorHealthCheckSuccessThreshold, entity.HealthCheck.SuccessThreshold, nil)
+		}
+	}
+
+	persistRateLimit(ctx, entity.RateLimit)
+
+	// drain and health state are only changed by explicitly updating them, so that replacing a terminator doesn't end
+	// a drain or clear a failed health check
+	if ctx.FieldChecker != nil {
+		ctx.SetBool(FieldTerminatorDraining, entity.Draining)
+		ctx.SetTimeP(FieldTerminatorDrainDeadline, entity.DrainDeadline)
+		ctx.SetBool(FieldTerminatorHealthFailed, entity.HealthFailed)
+	}
+
 	if ctx.Bucket.HasError() {
 		return
 	}
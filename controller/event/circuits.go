@@ -72,6 +72,7 @@ type CircuitEvent struct {
 	InstanceId       string            `json:"instance_id"`
 	CreationTimespan *time.Duration    `json:"creation_timespan,omitempty"`
 	Path             CircuitPath       `json:"path"`
+	SecondaryPath    *CircuitPath      `json:"secondary_path,omitempty"`
 	LinkCount        int               `json:"link_count"`
 	Cost             *uint32           `json:"path_cost,omitempty"`
 	FailureCause     *string           `json:"failure_cause,omitempty"`
@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import "time"
+
+// A JournalEntry is a formatted event read from an EventJournal, along with its position in the journal
+type JournalEntry struct {
+	Sequence  uint64
+	Timestamp time.Time
+	EventType string
+	Event     []byte
+}
+
+// An EventJournal durably records formatted events, so that clients which were disconnected can resume
+// reading from the sequence number following the last entry they received
+type EventJournal interface {
+	// GetJournalId returns the id of the journal. Sequence numbers are only meaningful within a journal, so a
+	// client which sees the id change has to restart reading from the beginning.
+	GetJournalId() string
+
+	// Read returns up to limit entries whose sequence number is at least fromSequence and whose event type
+	// is one of eventTypes, or any event type if none are given. If entries starting at fromSequence have
+	// already been removed by retention, reading starts at the oldest retained entry. The sequence number
+	// to continue reading from is also returned.
+	Read(fromSequence uint64, limit int, eventTypes ...string) ([]*JournalEntry, uint64, error)
+
+	// GetFirstSequence returns the sequence number of the oldest retained entry
+	GetFirstSequence() uint64
+
+	// GetNextSequence returns the sequence number which will be assigned to the next entry
+	GetNextSequence() uint64
+
+	// GetAppendNotify returns a channel which will be closed the next time entries are appended
+	GetAppendNotify() <-chan struct{}
+}
+
+// A JournalProvider is a Dispatcher which may be configured with an EventJournal
+type JournalProvider interface {
+	// GetEventJournal returns the event journal, or nil if the controller isn't configured with one
+	GetEventJournal() EventJournal
+}
+
+// GetEventJournal returns the event journal of the dispatcher, or nil if the dispatcher doesn't support an event
+// journal or isn't configured with one
+func GetEventJournal(dispatcher Dispatcher) EventJournal {
+	if provider, ok := dispatcher.(JournalProvider); ok {
+		return provider.GetEventJournal()
+	}
+	return nil
+}
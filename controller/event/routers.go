@@ -26,16 +26,23 @@ type RouterEventType string
 const (
 	RouterEventsNs = "fabric.routers"
 
-	RouterOnline  RouterEventType = "router-online"
-	RouterOffline RouterEventType = "router-offline"
+	RouterOnline              RouterEventType = "router-online"
+	RouterOffline             RouterEventType = "router-offline"
+	RouterMaintenanceProgress RouterEventType = "router-maintenance-progress"
+	RouterMaintenanceComplete RouterEventType = "router-maintenance-complete"
 )
 
+var RouterEventTypes = []RouterEventType{RouterOnline, RouterOffline, RouterMaintenanceProgress, RouterMaintenanceComplete}
+
 type RouterEvent struct {
 	Namespace    string          `json:"namespace"`
 	EventType    RouterEventType `json:"event_type"`
 	Timestamp    time.Time       `json:"timestamp"`
 	RouterId     string          `json:"router_id"`
 	RouterOnline bool            `json:"router_online"`
+
+	// TransitCircuits is set on maintenance events, to the number of circuits still transiting the router
+	TransitCircuits *int `json:"transit_circuits,omitempty"`
 }
 
 func (event *RouterEvent) String() string {
@@ -46,3 +53,8 @@ func (event *RouterEvent) String() string {
 type RouterEventHandler interface {
 	AcceptRouterEvent(event *RouterEvent)
 }
+
+type RouterEventHandlerWrapper interface {
+	RouterEventHandler
+	IsWrapping(value RouterEventHandler) bool
+}
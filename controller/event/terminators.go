@@ -31,8 +31,19 @@ const (
 	TerminatorDeleted       TerminatorEventType = "deleted"
 	TerminatorRouterOnline  TerminatorEventType = "router-online"
 	TerminatorRouterOffline TerminatorEventType = "router-offline"
+
+	TerminatorHealthCheckPassed TerminatorEventType = "health-check-passed"
+	TerminatorHealthCheckFailed TerminatorEventType = "health-check-failed"
+
+	TerminatorDrainProgress TerminatorEventType = "drain-progress"
+	TerminatorDrained       TerminatorEventType = "drained"
+	TerminatorDrainForced   TerminatorEventType = "drain-forced"
 )
 
+var TerminatorEventTypes = []TerminatorEventType{TerminatorCreated, TerminatorUpdated, TerminatorDeleted,
+	TerminatorRouterOnline, TerminatorRouterOffline, TerminatorHealthCheckPassed, TerminatorHealthCheckFailed,
+	TerminatorDrainProgress, TerminatorDrained, TerminatorDrainForced}
+
 type TerminatorEvent struct {
 	Namespace                 string              `json:"namespace"`
 	EventType                 TerminatorEventType `json:"event_type"`
@@ -48,6 +59,8 @@ type TerminatorEvent struct {
 	TotalTerminators          int                 `json:"total_terminators"`
 	UsableDefaultTerminators  int                 `json:"usable_default_terminators"`
 	UsableRequiredTerminators int                 `json:"usable_required_terminators"`
+	HealthCheckError          string              `json:"health_check_error,omitempty"`
+	ActiveCircuits            *int                `json:"active_circuits,omitempty"`
 	PropagateIndicator        bool                `json:"-"`
 }
 
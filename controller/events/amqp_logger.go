@@ -27,10 +27,12 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-type AMQPEventLoggerFactory struct{}
+type AMQPEventLoggerFactory struct {
+	Formatters FormatterFactoryRegistry
+}
 
-func (AMQPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewAMQPEventLogger(fabricFormatterFactory{}, config)
+func (self AMQPEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewAMQPEventLogger(fabricFormatterFactory{formatters: self.Formatters}, config)
 }
 
 type amqpWriteCloser struct {
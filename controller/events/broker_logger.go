@@ -0,0 +1,501 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	DeliveryAtMostOnce  = "at-most-once"
+	DeliveryAtLeastOnce = "at-least-once"
+)
+
+// BrokerMessage is a formatted event, addressed to a broker topic
+type BrokerMessage struct {
+	EventType string
+	Topic     string
+	Value     []byte
+}
+
+// An EventBroker publishes batches of messages to a message broker. If Publish returns an error, the
+// whole batch is considered undelivered.
+type EventBroker interface {
+	Publish(messages []*BrokerMessage) error
+	Close() error
+}
+
+// An EventBrokerFactory connects to a broker using the handler configuration. It will be called again
+// after a failure, so it should return an error if the broker can't be reached.
+type EventBrokerFactory func(config map[interface{}]interface{}) (EventBroker, error)
+
+// BrokerEventLoggerFactory creates event handlers which publish formatted events to a message broker.
+/**
+Example configuration:
+events:
+  kafkaLogger:
+    subscriptions:
+      - type: fabric.circuits
+      - type: metrics
+    handler:
+      type: kafka
+      format: json
+      brokers:
+        - kafka1:9092
+      topic: ziti-events
+      topics:
+        circuit: ziti-circuits
+      batchSize: 100
+      batchTimeout: 1s
+      delivery: at-least-once
+      spoolDir: /var/lib/ziti/kafka-spool
+*/
+type BrokerEventLoggerFactory struct {
+	BrokerType    string
+	BrokerFactory EventBrokerFactory
+	Registry      metrics.Registry
+	CloseNotify   <-chan struct{}
+	Formatters    FormatterFactoryRegistry
+}
+
+func (self *BrokerEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	sink, err := NewBrokerEventSink(self.BrokerType, self.BrokerFactory, self.Registry, self.CloseNotify, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			return fabricFormatterFactory{formatters: self.Formatters}.NewFormattingHandler(format, bufferSize, sink)
+		}
+		return nil, errors.Errorf("invalid 'format' for event %v handler", self.BrokerType)
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
+
+type brokerSinkConfig struct {
+	name          string
+	defaultTopic  string
+	topics        map[string]string
+	queueSize     int
+	batchSize     int
+	batchTimeout  time.Duration
+	retryInterval time.Duration
+	delivery      string
+	spoolDir      string
+	spoolMaxSize  int64
+}
+
+func parseBrokerSinkConfig(brokerType string, config map[interface{}]interface{}) (*brokerSinkConfig, error) {
+	result := &brokerSinkConfig{
+		name:          brokerType,
+		topics:        map[string]string{},
+		queueSize:     1000,
+		batchSize:     100,
+		batchTimeout:  time.Second,
+		retryInterval: 5 * time.Second,
+		delivery:      DeliveryAtMostOnce,
+		spoolMaxSize:  100 * 1024 * 1024,
+	}
+
+	var err error
+	if value, found := config["name"]; found {
+		if result.name, err = getStringValue("name", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["topic"]; found {
+		if result.defaultTopic, err = getStringValue("topic", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["topics"]; found {
+		topicMap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("'topics' must be a map of event type to topic")
+		}
+		for k, v := range topicMap {
+			if result.topics[fmt.Sprintf("%v", k)], err = getStringValue("topics", v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if result.defaultTopic == "" && len(result.topics) == 0 {
+		return nil, errors.New("either 'topic' or 'topics' must be specified")
+	}
+
+	if value, found := config["queueSize"]; found {
+		if result.queueSize, err = getPositiveIntValue("queueSize", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["batchSize"]; found {
+		if result.batchSize, err = getPositiveIntValue("batchSize", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["batchTimeout"]; found {
+		if result.batchTimeout, err = getDurationValue("batchTimeout", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["retryInterval"]; found {
+		if result.retryInterval, err = getDurationValue("retryInterval", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["delivery"]; found {
+		if result.delivery, err = getStringValue("delivery", value); err != nil {
+			return nil, err
+		}
+		if result.delivery != DeliveryAtMostOnce && result.delivery != DeliveryAtLeastOnce {
+			return nil, errors.Errorf("invalid 'delivery' value '%v', must be one of %v or %v",
+				result.delivery, DeliveryAtMostOnce, DeliveryAtLeastOnce)
+		}
+	}
+
+	if value, found := config["spoolDir"]; found {
+		if result.spoolDir, err = getStringValue("spoolDir", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.delivery == DeliveryAtLeastOnce && result.spoolDir == "" {
+		return nil, errors.Errorf("'spoolDir' must be specified for %v delivery", DeliveryAtLeastOnce)
+	}
+
+	if value, found := config["spoolMaxSizeMb"]; found {
+		maxSizeMb, err := getPositiveIntValue("spoolMaxSizeMb", value)
+		if err != nil {
+			return nil, err
+		}
+		result.spoolMaxSize = int64(maxSizeMb) * 1024 * 1024
+	}
+
+	return result, nil
+}
+
+func getStringValue(name string, value interface{}) (string, error) {
+	if result, ok := value.(string); ok {
+		return result, nil
+	}
+	return "", errors.Errorf("invalid '%v' value '%v', must be a string", name, value)
+}
+
+func getPositiveIntValue(name string, value interface{}) (int, error) {
+	if result, ok := value.(int); ok && result > 0 {
+		return result, nil
+	}
+	return 0, errors.Errorf("invalid '%v' value '%v', must be a positive integer", name, value)
+}
+
+func getDurationValue(name string, value interface{}) (time.Duration, error) {
+	if str, ok := value.(string); ok {
+		if result, err := time.ParseDuration(str); err == nil && result > 0 {
+			return result, nil
+		}
+	}
+	return 0, errors.Errorf("invalid '%v' value '%v', must be a positive duration", name, value)
+}
+
+// BrokerEventSink batches formatted events and publishes them to a message broker. With at-least-once
+// delivery, events which can't be published, or which don't fit in the queue, are written to a disk
+// spool and replayed once the broker is reachable again. Replayed events may be delivered more than once.
+type BrokerEventSink struct {
+	config        *brokerSinkConfig
+	brokerConfig  map[interface{}]interface{}
+	brokerFactory EventBrokerFactory
+	broker        EventBroker
+	lastConnect   time.Time
+	spool         *brokerSpool
+	queue         chan *BrokerMessage
+	closeNotify   <-chan struct{}
+
+	sentMeter    metrics.Meter
+	spooledMeter metrics.Meter
+	droppedMeter metrics.Meter
+	errorsMeter  metrics.Meter
+	queueGauge   metrics.Gauge
+	spoolGauge   metrics.Gauge
+}
+
+func NewBrokerEventSink(brokerType string, brokerFactory EventBrokerFactory, registry metrics.Registry,
+	closeNotify <-chan struct{}, config map[interface{}]interface{}) (*BrokerEventSink, error) {
+
+	sinkConfig, err := parseBrokerSinkConfig(brokerType, config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse %v event handler config", brokerType)
+	}
+
+	result := &BrokerEventSink{
+		config:        sinkConfig,
+		brokerConfig:  config,
+		brokerFactory: brokerFactory,
+		queue:         make(chan *BrokerMessage, sinkConfig.queueSize),
+		closeNotify:   closeNotify,
+	}
+
+	if sinkConfig.delivery == DeliveryAtLeastOnce {
+		if result.spool, err = newBrokerSpool(sinkConfig.spoolDir, sinkConfig.spoolMaxSize); err != nil {
+			return nil, err
+		}
+	}
+
+	prefix := "events." + sinkConfig.name + "."
+	result.sentMeter = registry.Meter(prefix + "sent")
+	result.spooledMeter = registry.Meter(prefix + "spooled")
+	result.droppedMeter = registry.Meter(prefix + "dropped")
+	result.errorsMeter = registry.Meter(prefix + "send_errors")
+	result.queueGauge = registry.FuncGauge(prefix+"queue_size", func() int64 {
+		return int64(len(result.queue))
+	})
+	result.spoolGauge = registry.FuncGauge(prefix+"spool_size", func() int64 {
+		if result.spool == nil {
+			return 0
+		}
+		return result.spool.Size()
+	})
+
+	go result.run()
+
+	return result, nil
+}
+
+func (self *BrokerEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	topic, found := self.config.topics[eventType]
+	if !found {
+		topic = self.config.defaultTopic
+	}
+	if topic == "" {
+		return
+	}
+
+	msg := &BrokerMessage{
+		EventType: eventType,
+		Topic:     topic,
+		Value:     formattedEvent,
+	}
+
+	select {
+	case self.queue <- msg:
+	default:
+		// the queue is full, which means the broker isn't keeping up. This runs on the formatter goroutine, so the
+		// spool is synced later by the sender goroutine, rather than waiting on the disk here.
+		self.spoolUndelivered([]*BrokerMessage{msg}, false)
+	}
+}
+
+func (self *BrokerEventSink) run() {
+	log := pfxlog.Logger().WithField("handler", self.config.name)
+
+	ticker := time.NewTicker(self.config.batchTimeout)
+	defer ticker.Stop()
+
+	var batch []*BrokerMessage
+
+	defer func() {
+		self.drainQueue(batch)
+		if self.broker != nil {
+			if err := self.broker.Close(); err != nil {
+				log.WithError(err).Error("error closing event broker")
+			}
+		}
+		if self.spool != nil {
+			self.spool.Close()
+		}
+		self.sentMeter.Dispose()
+		self.spooledMeter.Dispose()
+		self.droppedMeter.Dispose()
+		self.errorsMeter.Dispose()
+		self.queueGauge.Dispose()
+		self.spoolGauge.Dispose()
+	}()
+
+	for {
+		select {
+		case msg := <-self.queue:
+			batch = append(batch, msg)
+			if len(batch) >= self.config.batchSize {
+				self.publish(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			self.syncSpool()
+			if len(batch) > 0 {
+				self.publish(batch)
+				batch = nil
+			} else {
+				self.replaySpool()
+			}
+		case <-self.closeNotify:
+			return
+		}
+	}
+}
+
+// drainQueue spools anything still waiting to be published when the controller shuts down
+func (self *BrokerEventSink) drainQueue(batch []*BrokerMessage) {
+	for {
+		select {
+		case msg := <-self.queue:
+			batch = append(batch, msg)
+		default:
+			if len(batch) > 0 {
+				self.handleUndelivered(batch)
+			}
+			return
+		}
+	}
+}
+
+func (self *BrokerEventSink) publish(batch []*BrokerMessage) {
+	// spooled events go first, so events are published in roughly the order they occurred
+	if !self.replaySpool() {
+		self.handleUndelivered(batch)
+		return
+	}
+
+	if err := self.send(batch); err != nil {
+		self.handleUndelivered(batch)
+	}
+}
+
+// replaySpool publishes spooled events, oldest segment first. It returns true if the spool was emptied.
+func (self *BrokerEventSink) replaySpool() bool {
+	if self.spool == nil {
+		return true
+	}
+
+	log := pfxlog.Logger().WithField("handler", self.config.name)
+
+	for !self.spool.IsEmpty() {
+		seq, messages, err := self.spool.Oldest()
+		if err != nil {
+			log.WithError(err).Error("unable to read event spool")
+			return false
+		}
+
+		for len(messages) > 0 {
+			count := self.config.batchSize
+			if count > len(messages) {
+				count = len(messages)
+			}
+			if err = self.send(messages[:count]); err != nil {
+				return false
+			}
+			messages = messages[count:]
+		}
+
+		if err = self.spool.Remove(seq); err != nil {
+			log.WithError(err).Error("unable to remove event spool segment")
+			return false
+		}
+	}
+
+	return true
+}
+
+func (self *BrokerEventSink) send(messages []*BrokerMessage) error {
+	log := pfxlog.Logger().WithField("handler", self.config.name)
+
+	if self.broker == nil {
+		if time.Since(self.lastConnect) < self.config.retryInterval {
+			return errors.New("waiting to reconnect to event broker")
+		}
+		self.lastConnect = time.Now()
+
+		broker, err := self.brokerFactory(self.brokerConfig)
+		if err != nil {
+			log.WithError(err).Error("unable to connect to event broker")
+			self.errorsMeter.Mark(1)
+			return err
+		}
+		self.broker = broker
+	}
+
+	if err := self.broker.Publish(messages); err != nil {
+		log.WithError(err).Errorf("unable to publish %v events", len(messages))
+		self.errorsMeter.Mark(1)
+
+		// reconnect on the next attempt, in case the connection is what failed
+		if closeErr := self.broker.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("error closing event broker")
+		}
+		self.broker = nil
+		return err
+	}
+
+	self.sentMeter.Mark(int64(len(messages)))
+	return nil
+}
+
+// handleUndelivered spools messages which couldn't be published, if at-least-once delivery is configured. It's only
+// called from the sender goroutine, so it syncs the spool before returning.
+func (self *BrokerEventSink) handleUndelivered(messages []*BrokerMessage) {
+	self.spoolUndelivered(messages, true)
+}
+
+func (self *BrokerEventSink) spoolUndelivered(messages []*BrokerMessage, sync bool) {
+	if self.spool == nil {
+		self.droppedMeter.Mark(int64(len(messages)))
+		return
+	}
+
+	if err := self.spool.Append(messages); err != nil {
+		pfxlog.Logger().WithField("handler", self.config.name).WithError(err).
+			Errorf("unable to spool %v events, dropping them", len(messages))
+		self.droppedMeter.Mark(int64(len(messages)))
+		return
+	}
+	self.spooledMeter.Mark(int64(len(messages)))
+
+	if sync {
+		self.syncSpool()
+	}
+}
+
+// syncSpool flushes spooled messages to disk, including those spooled by the formatter goroutine when the queue was
+// full, which are left for the sender goroutine to sync
+func (self *BrokerEventSink) syncSpool() {
+	if self.spool == nil {
+		return
+	}
+	if err := self.spool.Sync(); err != nil {
+		pfxlog.Logger().WithField("handler", self.config.name).WithError(err).Error("unable to sync event spool")
+	}
+}
+
+var _ event.FormattedEventSink = (*BrokerEventSink)(nil)
@@ -0,0 +1,338 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testEventBroker is an in-process stand-in for a message broker
+type testEventBroker struct {
+	lock      sync.Mutex
+	available bool
+	block     chan struct{}
+	published []*BrokerMessage
+	batches   []int
+}
+
+func (self *testEventBroker) factory(map[interface{}]interface{}) (EventBroker, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if !self.available {
+		return nil, errors.New("broker unavailable")
+	}
+	return self, nil
+}
+
+func (self *testEventBroker) Publish(messages []*BrokerMessage) error {
+	if self.block != nil {
+		<-self.block
+	}
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if !self.available {
+		return errors.New("broker unavailable")
+	}
+	self.published = append(self.published, messages...)
+	self.batches = append(self.batches, len(messages))
+	return nil
+}
+
+func (self *testEventBroker) Close() error {
+	return nil
+}
+
+func (self *testEventBroker) setAvailable(available bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.available = available
+}
+
+func (self *testEventBroker) getPublished() ([]*BrokerMessage, []int) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return append([]*BrokerMessage(nil), self.published...), append([]int(nil), self.batches...)
+}
+
+func newTestBrokerSink(t *testing.T, broker *testEventBroker, config map[interface{}]interface{}) (*BrokerEventSink, metrics.Registry) {
+	closeNotify := make(chan struct{})
+	t.Cleanup(func() { close(closeNotify) })
+
+	registry := metrics.NewRegistry("test", nil)
+	sink, err := NewBrokerEventSink("test", broker.factory, registry, closeNotify, config)
+	require.NoError(t, err)
+	return sink, registry
+}
+
+func getMeterCount(registry metrics.Registry, name string) int64 {
+	if meter, found := registry.Poll().Meters[name]; found {
+		return meter.Count
+	}
+	return 0
+}
+
+func getGaugeValue(registry metrics.Registry, name string) int64 {
+	return registry.Poll().IntValues[name]
+}
+
+func TestBrokerSinkTopicsAndBatching(t *testing.T) {
+	req := require.New(t)
+
+	broker := &testEventBroker{available: true}
+	sink, registry := newTestBrokerSink(t, broker, map[interface{}]interface{}{
+		"topic":        "events",
+		"topics":       map[interface{}]interface{}{"circuit": "circuits"},
+		"batchSize":    2,
+		"batchTimeout": "50ms",
+	})
+
+	sink.AcceptFormattedEvent("circuit", []byte("1"))
+	sink.AcceptFormattedEvent("link", []byte("2"))
+	sink.AcceptFormattedEvent("metrics", []byte("3"))
+
+	req.Eventually(func() bool {
+		published, _ := broker.getPublished()
+		return len(published) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+
+	published, batches := broker.getPublished()
+	req.Equal("circuits", published[0].Topic)
+	req.Equal("circuit", published[0].EventType)
+	req.Equal("events", published[1].Topic)
+	req.Equal("events", published[2].Topic)
+	req.Equal([]int{2, 1}, batches)
+
+	req.Equal(int64(3), getMeterCount(registry, "events.test.sent"))
+}
+
+func TestBrokerSinkSpoolsWhileBrokerUnavailable(t *testing.T) {
+	req := require.New(t)
+
+	spoolDir := t.TempDir()
+	broker := &testEventBroker{}
+	sink, registry := newTestBrokerSink(t, broker, map[interface{}]interface{}{
+		"topic":         "events",
+		"batchSize":     10,
+		"batchTimeout":  "20ms",
+		"retryInterval": "20ms",
+		"delivery":      DeliveryAtLeastOnce,
+		"spoolDir":      spoolDir,
+	})
+
+	for _, val := range []string{"1", "2", "3"} {
+		sink.AcceptFormattedEvent("circuit", []byte(val))
+	}
+
+	req.Eventually(func() bool {
+		return getMeterCount(registry, "events.test.spooled") == 3
+	}, 2*time.Second, 10*time.Millisecond)
+
+	entries, err := os.ReadDir(spoolDir)
+	req.NoError(err)
+	req.Len(entries, 1)
+
+	sink.AcceptFormattedEvent("circuit", []byte("4"))
+	broker.setAvailable(true)
+
+	req.Eventually(func() bool {
+		published, _ := broker.getPublished()
+		return len(published) == 4
+	}, 2*time.Second, 10*time.Millisecond)
+
+	published, _ := broker.getPublished()
+	for i, val := range []string{"1", "2", "3", "4"} {
+		req.Equal(val, string(published[i].Value))
+	}
+
+	req.Equal(int64(0), getGaugeValue(registry, "events.test.spool_size"))
+	entries, err = os.ReadDir(spoolDir)
+	req.NoError(err)
+	req.Len(entries, 0)
+	req.Equal(int64(0), getMeterCount(registry, "events.test.dropped"))
+}
+
+func TestBrokerSinkDropsWhenQueueFull(t *testing.T) {
+	req := require.New(t)
+
+	broker := &testEventBroker{available: true, block: make(chan struct{})}
+	sink, registry := newTestBrokerSink(t, broker, map[interface{}]interface{}{
+		"topic":     "events",
+		"batchSize": 1,
+		"queueSize": 1,
+	})
+
+	// the first event is taken off the queue and blocks in publish, the second fills the queue
+	sink.AcceptFormattedEvent("circuit", []byte("1"))
+	req.Eventually(func() bool {
+		return len(sink.queue) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	sink.AcceptFormattedEvent("circuit", []byte("2"))
+	sink.AcceptFormattedEvent("circuit", []byte("3"))
+
+	req.Equal(int64(1), getMeterCount(registry, "events.test.dropped"))
+	req.Equal(int64(1), getGaugeValue(registry, "events.test.queue_size"))
+
+	close(broker.block)
+	req.Eventually(func() bool {
+		published, _ := broker.getPublished()
+		return len(published) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestBrokerSinkSpoolsOverflowWithoutSyncing(t *testing.T) {
+	req := require.New(t)
+
+	broker := &testEventBroker{available: true, block: make(chan struct{})}
+	sink, registry := newTestBrokerSink(t, broker, map[interface{}]interface{}{
+		"topic":        "events",
+		"batchSize":    1,
+		"queueSize":    1,
+		"batchTimeout": "20ms",
+		"delivery":     DeliveryAtLeastOnce,
+		"spoolDir":     t.TempDir(),
+	})
+
+	sink.AcceptFormattedEvent("circuit", []byte("1"))
+	req.Eventually(func() bool {
+		return len(sink.queue) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	sink.AcceptFormattedEvent("circuit", []byte("2"))
+	sink.AcceptFormattedEvent("circuit", []byte("3"))
+
+	// the overflow is spooled, but the sync is left to the sender, which is still blocked publishing
+	req.Equal(int64(1), getMeterCount(registry, "events.test.spooled"))
+	sink.spool.lock.Lock()
+	req.True(sink.spool.unsynced)
+	sink.spool.lock.Unlock()
+
+	close(broker.block)
+	req.Eventually(func() bool {
+		published, _ := broker.getPublished()
+		return len(published) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+	req.Equal(int64(0), getMeterCount(registry, "events.test.dropped"))
+}
+
+func TestBrokerSinkConfigValidation(t *testing.T) {
+	req := require.New(t)
+
+	_, err := parseBrokerSinkConfig("test", map[interface{}]interface{}{})
+	req.Error(err)
+
+	_, err = parseBrokerSinkConfig("test", map[interface{}]interface{}{
+		"topic":    "events",
+		"delivery": DeliveryAtLeastOnce,
+	})
+	req.Error(err)
+
+	_, err = parseBrokerSinkConfig("test", map[interface{}]interface{}{
+		"topic":    "events",
+		"delivery": "exactly-once",
+	})
+	req.Error(err)
+}
+
+func TestBrokerSpoolRecovery(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	spool, err := newBrokerSpool(dir, 1024*1024)
+	req.NoError(err)
+
+	req.NoError(spool.Append([]*BrokerMessage{
+		{EventType: "circuit", Topic: "circuits", Value: []byte("1")},
+		{EventType: "link", Topic: "links", Value: []byte("2")},
+	}))
+	req.True(spool.unsynced)
+	req.NoError(spool.Sync())
+	req.False(spool.unsynced)
+	spool.Close()
+
+	// simulate a record which was only partially written when the controller stopped
+	entries, err := os.ReadDir(dir)
+	req.NoError(err)
+	req.Len(entries, 1)
+	f, err := os.OpenFile(filepath.Join(dir, entries[0].Name()), os.O_WRONLY|os.O_APPEND, 0600)
+	req.NoError(err)
+	_, err = f.Write([]byte{0, 0, 0, 7, 'c', 'i'})
+	req.NoError(err)
+	req.NoError(f.Close())
+
+	spool, err = newBrokerSpool(dir, 1024*1024)
+	req.NoError(err)
+	req.False(spool.IsEmpty())
+
+	seq, messages, err := spool.Oldest()
+	req.NoError(err)
+	req.Len(messages, 2)
+	req.Equal("circuits", messages[0].Topic)
+	req.Equal("link", messages[1].EventType)
+	req.Equal("2", string(messages[1].Value))
+
+	req.NoError(spool.Remove(seq))
+	req.True(spool.IsEmpty())
+	req.Equal(int64(0), spool.Size())
+
+	spool, err = newBrokerSpool(dir, 10)
+	req.NoError(err)
+	req.ErrorIs(spool.Append([]*BrokerMessage{{EventType: "circuit", Topic: "circuits", Value: []byte("too large")}}), errSpoolFull)
+}
+
+func TestKafkaEventBroker(t *testing.T) {
+	req := require.New(t)
+
+	mockBroker := sarama.NewMockBroker(t, 1)
+	defer mockBroker.Close()
+
+	mockBroker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(mockBroker.Addr(), mockBroker.BrokerID()).
+			SetLeader("circuits", 0, mockBroker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t),
+	})
+
+	broker, err := NewKafkaEventBroker(map[interface{}]interface{}{
+		"brokers":      []interface{}{mockBroker.Addr()},
+		"requiredAcks": "local",
+	})
+	req.NoError(err)
+	defer func() { req.NoError(broker.Close()) }()
+
+	req.NoError(broker.Publish([]*BrokerMessage{
+		{EventType: "circuit", Topic: "circuits", Value: []byte("1")},
+		{EventType: "circuit", Topic: "circuits", Value: []byte("2")},
+	}))
+
+	produced := false
+	for _, rr := range mockBroker.History() {
+		if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+			produced = true
+		}
+	}
+	req.True(produced)
+
+	_, err = NewKafkaEventBroker(map[interface{}]interface{}{})
+	req.Error(err)
+}
@@ -0,0 +1,283 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	spoolSegmentSuffix  = ".spool"
+	spoolMaxSegmentSize = 4 * 1024 * 1024
+)
+
+var errSpoolFull = errors.New("event spool is full")
+
+// brokerSpool stores broker messages on disk while the broker can't be reached. Messages are appended
+// to numbered segment files, which are replayed oldest first and removed once they've been published.
+type brokerSpool struct {
+	dir     string
+	maxSize int64
+	size    atomic.Int64
+
+	lock     sync.Mutex
+	segments []uint64
+	current  *os.File
+	currSeq  uint64
+	currSize int64
+	unsynced bool
+}
+
+func newBrokerSpool(dir string, maxSize int64) (*brokerSpool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "unable to create event spool directory %v", dir)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read event spool directory %v", dir)
+	}
+
+	result := &brokerSpool{
+		dir:     dir,
+		maxSize: maxSize,
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), spoolSegmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), spoolSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to stat event spool segment %v", entry.Name())
+		}
+		result.segments = append(result.segments, seq)
+		result.size.Add(info.Size())
+	}
+
+	sort.Slice(result.segments, func(i, j int) bool {
+		return result.segments[i] < result.segments[j]
+	})
+
+	return result, nil
+}
+
+func (self *brokerSpool) segmentPath(seq uint64) string {
+	return filepath.Join(self.dir, fmt.Sprintf("%020d%s", seq, spoolSegmentSuffix))
+}
+
+func (self *brokerSpool) Size() int64 {
+	return self.size.Load()
+}
+
+func (self *brokerSpool) IsEmpty() bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.segments) == 0
+}
+
+// Append writes the given messages to the current segment. They're not synced to disk until Sync is called, or the
+// segment is closed, so callers which can't wait on the disk can leave the sync to a background goroutine.
+func (self *brokerSpool) Append(messages []*BrokerMessage) error {
+	var buf []byte
+	for _, msg := range messages {
+		buf = appendSpoolRecord(buf, msg)
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.size.Load()+int64(len(buf)) > self.maxSize {
+		return errSpoolFull
+	}
+
+	if self.current == nil || self.currSize >= spoolMaxSegmentSize {
+		if err := self.rollSegment(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := self.current.Write(buf); err != nil {
+		return errors.Wrapf(err, "unable to write to event spool segment %v", self.current.Name())
+	}
+
+	self.unsynced = true
+	self.currSize += int64(len(buf))
+	self.size.Add(int64(len(buf)))
+	return nil
+}
+
+// Sync flushes messages appended since the last sync to disk
+func (self *brokerSpool) Sync() error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.syncCurrent()
+}
+
+func (self *brokerSpool) syncCurrent() error {
+	if self.current == nil || !self.unsynced {
+		return nil
+	}
+	if err := self.current.Sync(); err != nil {
+		return errors.Wrapf(err, "unable to sync event spool segment %v", self.current.Name())
+	}
+	self.unsynced = false
+	return nil
+}
+
+func (self *brokerSpool) rollSegment() error {
+	self.closeCurrent()
+
+	seq := uint64(1)
+	if len(self.segments) > 0 {
+		seq = self.segments[len(self.segments)-1] + 1
+	}
+
+	f, err := os.OpenFile(self.segmentPath(seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create event spool segment")
+	}
+
+	self.segments = append(self.segments, seq)
+	self.current = f
+	self.currSeq = seq
+	self.currSize = 0
+	return nil
+}
+
+func (self *brokerSpool) closeCurrent() {
+	if self.current != nil {
+		if err := self.syncCurrent(); err != nil {
+			pfxlog.Logger().WithError(err).Error("error syncing event spool segment")
+		}
+		if err := self.current.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("error closing event spool segment %v", self.current.Name())
+		}
+		self.current = nil
+	}
+}
+
+// Oldest returns the sequence number and messages of the oldest segment. If that segment is still being
+// appended to, it's closed first, so that further messages go into a new segment.
+func (self *brokerSpool) Oldest() (uint64, []*BrokerMessage, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if len(self.segments) == 0 {
+		return 0, nil, nil
+	}
+
+	seq := self.segments[0]
+	if self.current != nil && self.currSeq == seq {
+		self.closeCurrent()
+	}
+
+	f, err := os.Open(self.segmentPath(seq))
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "unable to open event spool segment %v", seq)
+	}
+	defer func() { _ = f.Close() }()
+
+	messages, err := readSpoolRecords(bufio.NewReader(f))
+	if err != nil {
+		// a record may have been partially written if the controller stopped mid-write. Keep what was read.
+		pfxlog.Logger().WithError(err).Warnf("event spool segment %v is truncated, %v messages recovered", seq, len(messages))
+	}
+	return seq, messages, nil
+}
+
+// Remove deletes a segment, once its messages have been published
+func (self *brokerSpool) Remove(seq uint64) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if len(self.segments) == 0 || self.segments[0] != seq {
+		return errors.Errorf("event spool segment %v is not the oldest segment", seq)
+	}
+
+	path := self.segmentPath(seq)
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to stat event spool segment %v", seq)
+	}
+	if err = os.Remove(path); err != nil {
+		return errors.Wrapf(err, "unable to remove event spool segment %v", seq)
+	}
+
+	self.segments = self.segments[1:]
+	self.size.Add(-info.Size())
+	return nil
+}
+
+func (self *brokerSpool) Close() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.closeCurrent()
+}
+
+// spool records are the event type, topic and value, each prefixed with its length
+func appendSpoolRecord(buf []byte, msg *BrokerMessage) []byte {
+	for _, field := range [][]byte{[]byte(msg.EventType), []byte(msg.Topic), msg.Value} {
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(field)))
+		buf = append(buf, field...)
+	}
+	return buf
+}
+
+func readSpoolRecords(r io.Reader) ([]*BrokerMessage, error) {
+	var result []*BrokerMessage
+	for {
+		var fields [3][]byte
+		for i := range fields {
+			var fieldLen uint32
+			if err := binary.Read(r, binary.BigEndian, &fieldLen); err != nil {
+				if i == 0 && err == io.EOF {
+					return result, nil
+				}
+				return result, err
+			}
+			if fieldLen > spoolMaxSegmentSize*4 {
+				return result, errors.Errorf("invalid event spool record field length %v", fieldLen)
+			}
+			fields[i] = make([]byte, fieldLen)
+			if _, err := io.ReadFull(r, fields[i]); err != nil {
+				return result, err
+			}
+		}
+		result = append(result, &BrokerMessage{
+			EventType: string(fields[0]),
+			Topic:     string(fields[1]),
+			Value:     fields[2],
+		})
+	}
+}
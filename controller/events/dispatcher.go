@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"github.com/openziti/fabric/controller/event"
 	"io"
+	"reflect"
 	"strings"
+	"sync/atomic"
 
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/controller/network"
@@ -61,13 +63,22 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 	result.RegisterEventTypeFunctions(event.UsageEventsNs, result.registerUsageEventHandler, result.unregisterUsageEventHandler)
 	result.RegisterEventTypeFunctions(event.ClusterEventsNs, result.registerClusterEventHandler, result.unregisterClusterEventHandler)
 
-	result.RegisterFormatterFactory("json", event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+	result.RegisterFormatterFactory(FormatJson, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
 		return NewJsonFormatter(16, sink)
 	}))
 
-	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
-	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
-	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
+	for format, marshaller := range eventMarshallers {
+		marshaller := marshaller
+		result.RegisterFormatterFactory(format, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+			return NewMarshallingFormatter(16, sink, marshaller)
+		}))
+	}
+
+	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{Formatters: result})
+	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{Formatters: result})
+	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{Formatters: result})
+	result.RegisterEventHandlerFactory("webhook", &WebhookEventLoggerFactory{CloseNotify: closeNotify, Formatters: result})
+	result.RegisterEventHandlerFactory("journal", &JournalEventLoggerFactory{Dispatcher: result})
 
 	return result
 }
@@ -95,6 +106,7 @@ type Dispatcher struct {
 
 	entityChangeEventsDispatcher entityChangeEventDispatcher
 	entityTypes                  []string
+	journal                      atomic.Pointer[EventJournal]
 	closeNotify                  <-chan struct{}
 }
 
@@ -108,6 +120,15 @@ func (self *Dispatcher) InitializeNetworkEvents(n *network.Network) {
 
 	self.AddMetricsMapper(ctrlChannelMetricsMapper{}.mapMetrics)
 	self.AddMetricsMapper((&linkMetricsMapper{network: n}).mapMetrics)
+
+	// broker handlers report their backpressure metrics through the controller's registry
+	self.RegisterEventHandlerFactory("kafka", &BrokerEventLoggerFactory{
+		BrokerType:    "kafka",
+		BrokerFactory: NewKafkaEventBroker,
+		Registry:      n.GetMetricsRegistry(),
+		CloseNotify:   self.closeNotify,
+		Formatters:    self,
+	})
 }
 
 func (self *Dispatcher) AddMetricsMapper(mapper event.MetricsMapper) {
@@ -139,6 +160,15 @@ func (self *Dispatcher) RegisterFormatterFactory(formatType string, factory even
 	self.formatterFactories.Put(formatType, factory)
 }
 
+var _ event.JournalProvider = (*Dispatcher)(nil)
+
+func (self *Dispatcher) GetEventJournal() event.EventJournal {
+	if journal := self.journal.Load(); journal != nil {
+		return journal
+	}
+	return nil
+}
+
 // WireEventHandlers takes the given handler configs and creates handlers and subscriptions for each of them.
 /**
 Example configuration:
@@ -158,7 +188,16 @@ events:
       type: file
       format: json
       path: /tmp/ziti-events.log
+  siemLogger:
+    subscriptions:
+      - type: fabric.routers
+    handler:
+      type: file
+      format: cef
+      path: /var/log/ziti/ziti-events.cef
 
+The built-in formats are json, protobuf (length-delimited), cef, syslog (RFC 5424 with a CEF message) and
+otlp (OTLP logs JSON). Formats added with RegisterFormatterFactory may also be used.
 */
 func (self *Dispatcher) WireEventHandlers(eventHandlerConfigs []*EventHandlerConfig) error {
 	logger := pfxlog.Logger()
@@ -273,6 +312,43 @@ func (self *Dispatcher) ProcessSubscriptions(handler interface{}, subscriptions
 	return nil
 }
 
+// getAcceptedEventTypes returns the event types listed in a subscription's include option, or nil if
+// the subscription doesn't restrict which event types it receives
+func getAcceptedEventTypes[T ~string](namespace string, config map[string]interface{}, validTypes []T) (map[T]struct{}, error) {
+	var includeList []string
+	if includeVar, ok := config["include"]; ok {
+		if includeStr, ok := includeVar.(string); ok {
+			includeList = append(includeList, includeStr)
+		} else if includeIntfList, ok := includeVar.([]interface{}); ok {
+			for _, val := range includeIntfList {
+				includeList = append(includeList, fmt.Sprintf("%v", val))
+			}
+		} else {
+			return nil, errors.Errorf("invalid type %v for %v include configuration", reflect.TypeOf(includeVar), namespace)
+		}
+	}
+
+	if len(includeList) == 0 {
+		return nil, nil
+	}
+
+	accepted := map[T]struct{}{}
+	for _, include := range includeList {
+		found := false
+		for _, t := range validTypes {
+			if include == string(t) {
+				accepted[t] = struct{}{}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("invalid include %v for %v. valid values are %+v", include, namespace, validTypes)
+		}
+	}
+	return accepted, nil
+}
+
 func (self *Dispatcher) RemoveAllSubscriptions(handler interface{}) {
 	for _, registrar := range self.registrationHandlers.AsMap() {
 		registrar.Unregister(handler)
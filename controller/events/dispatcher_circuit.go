@@ -17,7 +17,6 @@
 package events
 
 import (
-	"fmt"
 	"github.com/openziti/fabric/controller/event"
 	"github.com/pkg/errors"
 	"reflect"
@@ -54,38 +53,16 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/CircuitEventHandler interface.", reflect.TypeOf(val))
 	}
 
-	var includeList []string
-	if includeVar, ok := config["include"]; ok {
-		if includeStr, ok := includeVar.(string); ok {
-			includeList = append(includeList, includeStr)
-		} else if includeIntfList, ok := includeVar.([]interface{}); ok {
-			for _, val := range includeIntfList {
-				includeList = append(includeList, fmt.Sprintf("%v", val))
-			}
-		} else {
-			return errors.Errorf("invalid type %v for fabric.circuits include configuration", reflect.TypeOf(includeVar))
-		}
+	accepted, err := getAcceptedEventTypes(event.CircuitEventsNs, config, event.CircuitEventTypes)
+	if err != nil {
+		return err
 	}
 
-	if len(includeList) == 0 {
+	if accepted == nil {
 		self.AddCircuitEventHandler(handler)
 		return nil
 	}
 
-	accepted := map[event.CircuitEventType]struct{}{}
-	for _, include := range includeList {
-		found := false
-		for _, t := range event.CircuitEventTypes {
-			if include == string(t) {
-				accepted[t] = struct{}{}
-				found = true
-				break
-			}
-		}
-		if !found {
-			return errors.Errorf("invalid include %v for fabric.circuits. valid values are %+v", include, event.CircuitEventTypes)
-		}
-	}
 	result := &filteredCircuitEventHandler{
 		accepted: accepted,
 		wrapped:  handler,
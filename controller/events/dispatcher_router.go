@@ -29,7 +29,15 @@ func (self *Dispatcher) AddRouterEventHandler(handler event.RouterEventHandler)
 }
 
 func (self *Dispatcher) RemoveRouterEventHandler(handler event.RouterEventHandler) {
-	self.routerEventHandlers.Delete(handler)
+	self.routerEventHandlers.DeleteIf(func(val event.RouterEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.RouterEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AcceptRouterEvent(event *event.RouterEvent) {
@@ -45,16 +53,29 @@ func (self *Dispatcher) initRouterEvents(n *network.Network) {
 		Dispatcher: self,
 	}
 	n.AddRouterPresenceHandler(routerEvtAdapter)
+	n.AddRouterMaintenanceHandler(routerEvtAdapter)
 }
 
-func (self *Dispatcher) registerRouterEventHandler(val interface{}, _ map[string]interface{}) error {
+func (self *Dispatcher) registerRouterEventHandler(val interface{}, config map[string]interface{}) error {
 	handler, ok := val.(event.RouterEventHandler)
 
 	if !ok {
 		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/RouterEventHandler interface.", reflect.TypeOf(val))
 	}
 
-	self.AddRouterEventHandler(handler)
+	accepted, err := getAcceptedEventTypes(event.RouterEventsNs, config, event.RouterEventTypes)
+	if err != nil {
+		return err
+	}
+
+	if accepted == nil {
+		self.AddRouterEventHandler(handler)
+	} else {
+		self.AddRouterEventHandler(&filteredRouterEventHandler{
+			accepted: accepted,
+			wrapped:  handler,
+		})
+	}
 
 	return nil
 }
@@ -65,6 +86,27 @@ func (self *Dispatcher) unregisterRouterEventHandler(val interface{}) {
 	}
 }
 
+type filteredRouterEventHandler struct {
+	accepted map[event.RouterEventType]struct{}
+	wrapped  event.RouterEventHandler
+}
+
+func (self *filteredRouterEventHandler) IsWrapping(value event.RouterEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.RouterEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredRouterEventHandler) AcceptRouterEvent(event *event.RouterEvent) {
+	if _, found := self.accepted[event.EventType]; found {
+		self.wrapped.AcceptRouterEvent(event)
+	}
+}
+
 // routerEventAdapter converts network router presence events to event.RouterEvent
 type routerEventAdapter struct {
 	*Dispatcher
@@ -78,6 +120,24 @@ func (self *routerEventAdapter) RouterDisconnected(r *network.Router) {
 	self.routerChange(event.RouterOffline, r, false)
 }
 
+func (self *routerEventAdapter) RouterMaintenanceProgress(r *network.Router, transitCircuits int) {
+	eventType := event.RouterMaintenanceProgress
+	if transitCircuits == 0 {
+		eventType = event.RouterMaintenanceComplete
+	}
+
+	evt := &event.RouterEvent{
+		Namespace:       event.RouterEventsNs,
+		EventType:       eventType,
+		Timestamp:       time.Now(),
+		RouterId:        r.Id,
+		RouterOnline:    r.Connected.Load(),
+		TransitCircuits: &transitCircuits,
+	}
+
+	self.Dispatcher.AcceptRouterEvent(evt)
+}
+
 func (self *routerEventAdapter) routerChange(eventType event.RouterEventType, r *network.Router, online bool) {
 	evt := &event.RouterEvent{
 		Namespace:    event.RouterEventsNs,
@@ -72,6 +72,18 @@ func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, options
 		}
 	}
 
+	accepted, err := getAcceptedEventTypes(event.TerminatorEventsNs, options, event.TerminatorEventTypes)
+	if err != nil {
+		return err
+	}
+
+	if accepted != nil {
+		handler = &filteredTerminatorEventHandler{
+			accepted: accepted,
+			wrapped:  handler,
+		}
+	}
+
 	if propagateAlways {
 		self.AddTerminatorEventHandler(handler)
 	} else {
@@ -98,6 +110,8 @@ func (self *Dispatcher) initTerminatorEvents(n *network.Network) {
 	n.GetStores().Terminator.AddEntityEventListenerF(terminatorEvtAdapter.terminatorDeleted, boltz.EntityDeleted)
 
 	n.AddRouterPresenceHandler(terminatorEvtAdapter)
+	n.AddTerminatorHealthHandler(terminatorEvtAdapter)
+	n.AddTerminatorDrainHandler(terminatorEvtAdapter)
 }
 
 type terminatorEventFilter struct {
@@ -120,8 +134,29 @@ func (self *terminatorEventFilter) AcceptTerminatorEvent(evt *event.TerminatorEv
 	}
 }
 
-// terminatorEventAdapter converts router presence online/offline events and terminator entity change events to
-// event.TerminatorEvent instances
+type filteredTerminatorEventHandler struct {
+	accepted map[event.TerminatorEventType]struct{}
+	wrapped  event.TerminatorEventHandler
+}
+
+func (self *filteredTerminatorEventHandler) IsWrapping(value event.TerminatorEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.TerminatorEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *filteredTerminatorEventHandler) AcceptTerminatorEvent(event *event.TerminatorEvent) {
+	if _, found := self.accepted[event.EventType]; found {
+		self.wrapped.AcceptTerminatorEvent(event)
+	}
+}
+
+// terminatorEventAdapter converts router presence online/offline events, terminator health check results, terminator
+// drain progress and terminator entity change events to event.TerminatorEvent instances
 type terminatorEventAdapter struct {
 	Network    *network.Network
 	Dispatcher *Dispatcher
@@ -162,6 +197,38 @@ func (self *terminatorEventAdapter) routerChange(eventType event.TerminatorEvent
 	}
 }
 
+func (self *terminatorEventAdapter) TerminatorHealthChanged(terminatorId string, healthy bool, err error) {
+	eventType := event.TerminatorHealthCheckPassed
+	if !healthy {
+		eventType = event.TerminatorHealthCheckFailed
+	}
+
+	evt := self.createTerminatorEventForId(eventType, terminatorId)
+	if evt == nil {
+		return
+	}
+	if err != nil {
+		evt.HealthCheckError = err.Error()
+	}
+	self.Dispatcher.AcceptTerminatorEvent(evt)
+}
+
+func (self *terminatorEventAdapter) TerminatorDrainProgress(terminatorId string, activeCircuits int, forced bool) {
+	eventType := event.TerminatorDrainProgress
+	if forced {
+		eventType = event.TerminatorDrainForced
+	} else if activeCircuits == 0 {
+		eventType = event.TerminatorDrained
+	}
+
+	evt := self.createTerminatorEventForId(eventType, terminatorId)
+	if evt == nil {
+		return
+	}
+	evt.ActiveCircuits = &activeCircuits
+	self.Dispatcher.AcceptTerminatorEvent(evt)
+}
+
 func (self *terminatorEventAdapter) terminatorCreated(terminator *db.Terminator) {
 	self.terminatorChanged(event.TerminatorCreated, terminator)
 }
@@ -176,10 +243,27 @@ func (self *terminatorEventAdapter) terminatorDeleted(terminator *db.Terminator)
 
 func (self *terminatorEventAdapter) terminatorChanged(eventType event.TerminatorEventType, terminator *db.Terminator) {
 	terminator = self.Network.Services.NotifyTerminatorChanged(terminator)
-	self.createTerminatorEvent(eventType, terminator)
+	self.Dispatcher.AcceptTerminatorEvent(self.createTerminatorEvent(eventType, terminator))
 }
 
-func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.TerminatorEventType, terminator *db.Terminator) {
+// createTerminatorEventForId loads the terminator and creates an event for it, returning nil if it can't be loaded
+func (self *terminatorEventAdapter) createTerminatorEventForId(eventType event.TerminatorEventType, terminatorId string) *event.TerminatorEvent {
+	var terminator *db.Terminator
+	err := self.Network.GetDb().View(func(tx *bbolt.Tx) error {
+		var findErr error
+		terminator, _, findErr = self.Network.GetStores().Terminator.FindById(tx, terminatorId)
+		return findErr
+	})
+
+	if err != nil || terminator == nil {
+		pfxlog.Logger().WithError(err).WithField("terminatorId", terminatorId).Errorf("unable to load terminator for %v event", eventType)
+		return nil
+	}
+
+	return self.createTerminatorEvent(eventType, terminator)
+}
+
+func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.TerminatorEventType, terminator *db.Terminator) *event.TerminatorEvent {
 	service, _ := self.Network.Services.Read(terminator.Service)
 
 	totalTerminators := -1
@@ -192,15 +276,15 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		usableRequiredTerminators = 0
 		for _, t := range service.Terminators {
 			routerOnline := self.Network.ConnectedRouter(t.Router)
-			if t.Precedence.IsDefault() && routerOnline {
+			if t.GetPrecedence().IsDefault() && routerOnline {
 				usableDefaultTerminators++
-			} else if t.Precedence.IsRequired() && routerOnline {
+			} else if t.GetPrecedence().IsRequired() && routerOnline {
 				usableRequiredTerminators++
 			}
 		}
 	}
 
-	evt := &event.TerminatorEvent{
+	return &event.TerminatorEvent{
 		Namespace:                 event.TerminatorEventsNs,
 		EventType:                 eventType,
 		Timestamp:                 time.Now(),
@@ -209,7 +293,7 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		RouterId:                  terminator.Router,
 		HostId:                    terminator.HostId,
 		RouterOnline:              self.Network.ConnectedRouter(terminator.Router),
-		Precedence:                terminator.Precedence,
+		Precedence:                terminator.GetPrecedence().String(),
 		StaticCost:                terminator.Cost,
 		DynamicCost:               xt.GlobalCosts().GetDynamicCost(terminator.Id),
 		TotalTerminators:          totalTerminators,
@@ -217,6 +301,4 @@ func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.Termin
 		UsableRequiredTerminators: usableRequiredTerminators,
 		PropagateIndicator:        self.Network.Dispatcher.IsLeaderOrLeaderless(),
 	}
-
-	self.Dispatcher.AcceptTerminatorEvent(evt)
 }
@@ -19,32 +19,76 @@ package events
 import (
 	"fmt"
 	"github.com/natefinch/lumberjack"
+	"github.com/openziti/fabric/controller/event"
 	"github.com/pkg/errors"
 	"io"
 	"os"
 	"strings"
 )
 
-type fabricFormatterFactory struct{}
+const (
+	FormatJson     = "json"
+	FormatProtobuf = "protobuf"
+	FormatCef      = "cef"
+	FormatSyslog   = "syslog"
+	FormatOtlp     = "otlp"
+)
+
+// eventMarshallers are the built-in formats, other than json, which can be selected using 'format'
+var eventMarshallers = map[string]EventMarshaller{
+	FormatProtobuf: MarshalProtobufEvent,
+	FormatCef:      MarshalCefEvent,
+	FormatSyslog:   MarshalSyslogEvent,
+	FormatOtlp:     MarshalOtlpEvent,
+}
+
+// FormatterFactoryRegistry looks up formatter factories by format name. The Dispatcher implements it, so
+// formats added using RegisterFormatterFactory can be selected by event handlers
+type FormatterFactoryRegistry interface {
+	GetFormatterFactory(formatType string) event.FormatterFactory
+}
+
+type fabricFormatterFactory struct {
+	formatters FormatterFactoryRegistry
+}
 
 func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
-	if strings.EqualFold(format, "json") {
-		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
+	return f.NewFormattingHandler(format, buffer, NewWriterEventSink(out))
+}
+
+// NewFormattingHandler is like NewLoggingHandler, but passes the event type along with each formatted event
+func (f fabricFormatterFactory) NewFormattingHandler(format string, buffer int, sink event.FormattedEventSink) (interface{}, error) {
+	if strings.EqualFold(format, FormatJson) {
+		return NewJsonFormatter(buffer, sink), nil
 	}
 
-	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
+	if marshaller, found := eventMarshallers[strings.ToLower(format)]; found {
+		return NewMarshallingFormatter(buffer, sink, marshaller), nil
+	}
+
+	if f.formatters != nil {
+		if factory := f.formatters.GetFormatterFactory(format); factory != nil {
+			return factory.NewFormatter(sink), nil
+		}
+	}
+
+	return nil, errors.Errorf("invalid 'format' for event handler: %v", format)
 }
 
-type StdOutLoggerFactory struct{}
+type StdOutLoggerFactory struct {
+	Formatters FormatterFactoryRegistry
+}
 
-func (StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, true, config)
+func (self StdOutLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewFileEventLogger(fabricFormatterFactory{formatters: self.Formatters}, true, config)
 }
 
-type FileEventLoggerFactory struct{}
+type FileEventLoggerFactory struct {
+	Formatters FormatterFactoryRegistry
+}
 
-func (FileEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
-	return NewFileEventLogger(fabricFormatterFactory{}, false, config)
+func (self FileEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewFileEventLogger(fabricFormatterFactory{formatters: self.Formatters}, false, config)
 }
 
 func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, config map[interface{}]interface{}) (interface{}, error) {
@@ -93,12 +137,15 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 			return nil, errors.New("missing required 'path' config for events FileLogger handler")
 		}
 
-		output = &newlineWriter{
-			out: &lumberjack.Logger{
-				Filename:   filepath,
-				MaxSize:    maxsize,
-				MaxBackups: maxBackupFiles,
-			},
+		output = &lumberjack.Logger{
+			Filename:   filepath,
+			MaxSize:    maxsize,
+			MaxBackups: maxBackupFiles,
+		}
+
+		// length-delimited protobuf events mustn't have newlines inserted between them
+		if format, _ := config["format"].(string); !strings.EqualFold(format, FormatProtobuf) {
+			output = &newlineWriter{out: output}
 		}
 	}
 
@@ -17,6 +17,7 @@
 package events
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
@@ -24,8 +25,10 @@ import (
 	"github.com/pkg/errors"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"sync/atomic"
+	"time"
 )
 
 type LoggingHandlerFactory interface {
@@ -251,6 +254,189 @@ func (formatter *JsonFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeE
 	formatter.AcceptLoggingEvent((*JsonEntityChangeEvent)(evt))
 }
 
+// EventMarshaller converts an event to its formatted representation. eventType is the short event type,
+// such as circuit or usage.v3, which is also passed along to the FormattedEventSink
+type EventMarshaller func(eventType string, evt interface{}) ([]byte, error)
+
+type marshallingFormatterEvent struct {
+	eventType string
+	evt       interface{}
+	marshal   EventMarshaller
+}
+
+func (self *marshallingFormatterEvent) GetEventType() string {
+	return self.eventType
+}
+
+func (self *marshallingFormatterEvent) Format() ([]byte, error) {
+	return self.marshal(self.eventType, self.evt)
+}
+
+// NewMarshallingFormatter returns a formatter which accepts all fabric event types and formats them using
+// the given EventMarshaller
+func NewMarshallingFormatter(queueDepth int, sink event.FormattedEventSink, marshaller EventMarshaller) *MarshallingFormatter {
+	result := &MarshallingFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+		},
+		marshaller: marshaller,
+	}
+	go result.Run()
+	return result
+}
+
+type MarshallingFormatter struct {
+	BaseFormatter
+	marshaller EventMarshaller
+}
+
+func (formatter *MarshallingFormatter) accept(eventType string, evt interface{}) {
+	formatter.AcceptLoggingEvent(&marshallingFormatterEvent{
+		eventType: eventType,
+		evt:       evt,
+		marshal:   formatter.marshaller,
+	})
+}
+
+func (formatter *MarshallingFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	formatter.accept("circuit", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptLinkEvent(evt *event.LinkEvent) {
+	formatter.accept("link", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	formatter.accept("metrics", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptServiceEvent(evt *event.ServiceEvent) {
+	formatter.accept("service", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	formatter.accept("terminator", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptRouterEvent(evt *event.RouterEvent) {
+	formatter.accept("router", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptUsageEvent(evt *event.UsageEvent) {
+	formatter.accept("usage", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	formatter.accept("usage.v3", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptClusterEvent(evt *event.ClusterEvent) {
+	formatter.accept("cluster", evt)
+}
+
+func (formatter *MarshallingFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	formatter.accept("entity.change", evt)
+}
+
+// eventHeader holds the fields common to all events, which formats such as CEF and OTLP carry outside the
+// event body
+type eventHeader struct {
+	namespace string
+	eventType string
+	timestamp time.Time
+}
+
+// isWarning returns true for events which indicate that something has failed or become unavailable
+func (self *eventHeader) isWarning() bool {
+	_, found := warningEvents[self.namespace+"."+self.eventType]
+	return found
+}
+
+var warningEvents = map[string]struct{}{
+	event.CircuitEventsNs + "." + string(event.CircuitFailed):                  {},
+	event.LinkEventsNs + "." + string(event.LinkFault):                         {},
+	event.RouterEventsNs + "." + string(event.RouterOffline):                   {},
+	event.TerminatorEventsNs + "." + string(event.TerminatorRouterOffline):     {},
+	event.TerminatorEventsNs + "." + string(event.TerminatorHealthCheckFailed): {},
+	event.TerminatorEventsNs + "." + string(event.TerminatorDrainForced):       {},
+	event.ClusterEventsNs + "." + string(event.ClusterPeerDisconnected):        {},
+	event.ClusterEventsNs + "." + string(event.ClusterLeadershipLost):          {},
+	event.ClusterEventsNs + "." + string(event.ClusterStateReadOnly):           {},
+}
+
+func getEventHeader(evt interface{}) *eventHeader {
+	switch e := evt.(type) {
+	case *event.CircuitEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	case *event.LinkEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	case *event.MetricsEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: e.MetricType, timestamp: e.Timestamp}
+	case *event.ServiceEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: e.EventType, timestamp: time.Unix(e.IntervalStartUTC, 0)}
+	case *event.TerminatorEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	case *event.RouterEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	case *event.UsageEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: e.EventType, timestamp: time.Unix(e.IntervalStartUTC, 0)}
+	case *event.UsageEventV3:
+		return &eventHeader{namespace: e.Namespace, eventType: "usage", timestamp: time.Unix(e.IntervalStartUTC, 0)}
+	case *event.ClusterEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	case *event.EntityChangeEvent:
+		return &eventHeader{namespace: e.Namespace, eventType: string(e.EventType), timestamp: e.Timestamp}
+	}
+	return &eventHeader{timestamp: time.Now()}
+}
+
+type eventField struct {
+	key   string
+	value string
+}
+
+// flattenEvent returns the fields of the event's JSON representation as key/value pairs, sorted by key.
+// Nested objects are flattened using dotted keys and lists are left JSON encoded.
+func flattenEvent(evt interface{}) ([]eventField, error) {
+	buf, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+
+	var fields map[string]interface{}
+	if err = decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+
+	var result []eventField
+	var flatten func(prefix string, m map[string]interface{})
+	flatten = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			switch val := v.(type) {
+			case nil:
+			case map[string]interface{}:
+				flatten(prefix+k+".", val)
+			case []interface{}:
+				list, _ := json.Marshal(val)
+				result = append(result, eventField{key: prefix + k, value: string(list)})
+			default:
+				result = append(result, eventField{key: prefix + k, value: fmt.Sprintf("%v", val)})
+			}
+		}
+	}
+	flatten("", fields)
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key < result[j].key
+	})
+	return result, nil
+}
+
 var histogramBuckets = map[string]string{"p50": "0.50", "p75": "0.75", "p95": "0.95", "p99": "0.99", "p999": "0.999", "p9999": "0.9999"}
 
 type PrometheusMetricsEvent event.MetricsEvent
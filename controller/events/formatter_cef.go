@@ -0,0 +1,136 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+const (
+	CefVendor  = "OpenZiti"
+	CefProduct = "ziti-controller"
+
+	// syslog messages are sent using the local0 facility
+	syslogFacility = 16
+)
+
+var cefDeviceVersion = getFabricVersion()
+
+var syslogHostname = func() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return "-"
+}()
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// MarshalCefEvent formats the event as an ArcSight Common Event Format (CEF) record. The signature id is
+// the event type and sub-type, for example router.router-offline, and the event fields are carried as
+// extension key/value pairs, with the event time in rt.
+func MarshalCefEvent(eventType string, evt interface{}) ([]byte, error) {
+	header := getEventHeader(evt)
+	fields, err := flattenEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	severity := 3
+	if header.isWarning() {
+		severity = 7
+	}
+
+	signatureId := eventType
+	name := header.namespace
+	if header.eventType != "" {
+		signatureId += "." + header.eventType
+		name += " " + header.eventType
+	}
+
+	b := &strings.Builder{}
+	_, _ = fmt.Fprintf(b, "CEF:0|%s|%s|%s|%s|%s|%d|rt=%d",
+		cefHeaderEscaper.Replace(CefVendor),
+		cefHeaderEscaper.Replace(CefProduct),
+		cefHeaderEscaper.Replace(cefDeviceVersion),
+		cefHeaderEscaper.Replace(signatureId),
+		cefHeaderEscaper.Replace(name),
+		severity,
+		header.timestamp.UnixMilli())
+
+	for _, field := range fields {
+		b.WriteByte(' ')
+		b.WriteString(toCefExtensionKey(field.key))
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(field.value))
+	}
+
+	return []byte(b.String()), nil
+}
+
+// MarshalSyslogEvent formats the event as an RFC 5424 syslog message with a CEF record as the message
+func MarshalSyslogEvent(eventType string, evt interface{}) ([]byte, error) {
+	cef, err := MarshalCefEvent(eventType, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	syslogSeverity := 6 // informational
+	header := getEventHeader(evt)
+	if header.isWarning() {
+		syslogSeverity = 4
+	}
+
+	prefix := fmt.Sprintf("<%d>1 %s %s %s - %s - ",
+		syslogFacility*8+syslogSeverity,
+		header.timestamp.UTC().Format(time.RFC3339Nano),
+		syslogHostname,
+		CefProduct,
+		eventType)
+
+	return append([]byte(prefix), cef...), nil
+}
+
+// toCefExtensionKey replaces characters which aren't valid in extension keys, such as spaces in tag names
+func toCefExtensionKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '.' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+func getFabricVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		if info.Main.Path == "github.com/openziti/fabric" && info.Main.Version != "" {
+			return info.Main.Version
+		}
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/openziti/fabric" {
+				return dep.Version
+			}
+		}
+	}
+	return "unknown"
+}
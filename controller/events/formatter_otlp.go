@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+)
+
+const (
+	otlpSeverityInfo = 9
+	otlpSeverityWarn = 13
+)
+
+// The types below are the subset of the OTLP logs JSON encoding used by the otlp formatter. See
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/logs/v1/logs.proto
+
+type otlpLogsData struct {
+	ResourceLogs []*otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  *otlpResource    `json:"resource,omitempty"`
+	ScopeLogs []*otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope      *otlpScope       `json:"scope,omitempty"`
+	LogRecords []*otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string          `json:"timeUnixNano"`
+	ObservedTimeUnixNano string          `json:"observedTimeUnixNano"`
+	SeverityNumber       int             `json:"severityNumber"`
+	SeverityText         string          `json:"severityText"`
+	Body                 *otlpAnyValue   `json:"body"`
+	Attributes           []*otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value *otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func newOtlpKeyValue(key, value string) *otlpKeyValue {
+	return &otlpKeyValue{Key: key, Value: &otlpAnyValue{StringValue: value}}
+}
+
+// MarshalOtlpEvent formats the event as an OTLP logs JSON document holding a single log record. The body
+// of the record is the JSON formatted event, and the event fields are also added as ziti.* attributes, so
+// they can be queried without parsing the body. A file of these documents, one per line, can be read by
+// the OpenTelemetry collector's otlpjsonfile receiver.
+func MarshalOtlpEvent(eventType string, evt interface{}) ([]byte, error) {
+	header := getEventHeader(evt)
+
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := flattenEvent(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &otlpLogRecord{
+		TimeUnixNano:         strconv.FormatInt(header.timestamp.UnixNano(), 10),
+		ObservedTimeUnixNano: strconv.FormatInt(time.Now().UnixNano(), 10),
+		SeverityNumber:       otlpSeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 &otlpAnyValue{StringValue: string(body)},
+		Attributes: []*otlpKeyValue{
+			newOtlpKeyValue("event.name", header.namespace+"."+header.eventType),
+			newOtlpKeyValue("ziti.event.type", eventType),
+		},
+	}
+
+	if header.isWarning() {
+		record.SeverityNumber = otlpSeverityWarn
+		record.SeverityText = "WARN"
+	}
+
+	for _, field := range fields {
+		record.Attributes = append(record.Attributes, newOtlpKeyValue("ziti."+field.key, field.value))
+	}
+
+	return json.Marshal(&otlpLogsData{
+		ResourceLogs: []*otlpResourceLogs{{
+			Resource: &otlpResource{
+				Attributes: []*otlpKeyValue{
+					newOtlpKeyValue("service.name", CefProduct),
+					newOtlpKeyValue("service.version", cefDeviceVersion),
+					newOtlpKeyValue("host.name", syslogHostname),
+				},
+			},
+			ScopeLogs: []*otlpScopeLogs{{
+				Scope:      &otlpScope{Name: "github.com/openziti/fabric/controller/events"},
+				LogRecords: []*otlpLogRecord{record},
+			}},
+		}},
+	})
+}
+
+// mergeOtlpLogs combines the log records from OTLP logs documents created by MarshalOtlpEvent into a single
+// document, suitable for posting to an OTLP/HTTP logs endpoint. As all the records come from the same
+// controller, the resource and scope of the first document are used.
+func mergeOtlpLogs(batch [][]byte) ([]byte, error) {
+	var result *otlpLogsData
+	for _, buf := range batch {
+		logs := &otlpLogsData{}
+		if err := json.Unmarshal(buf, logs); err != nil {
+			return nil, err
+		}
+		if result == nil {
+			if len(logs.ResourceLogs) == 0 || len(logs.ResourceLogs[0].ScopeLogs) == 0 {
+				return nil, errors.New("otlp logs document has no scope logs")
+			}
+			result = logs
+			continue
+		}
+		target := result.ResourceLogs[0].ScopeLogs[0]
+		for _, resourceLogs := range logs.ResourceLogs {
+			for _, scopeLogs := range resourceLogs.ScopeLogs {
+				target.LogRecords = append(target.LogRecords, scopeLogs.LogRecords...)
+			}
+		}
+	}
+	return json.Marshal(result)
+}
@@ -0,0 +1,232 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/openziti/fabric/common/pb/event_pb"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MarshalProtobufEvent encodes the event as an event_pb.Event, prefixed with its varint encoded length, so
+// that a stream of events can be read back using protodelim.UnmarshalFrom
+func MarshalProtobufEvent(eventType string, evt interface{}) ([]byte, error) {
+	msg, err := toProtobufEvent(eventType, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err = protodelim.MarshalTo(buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toProtobufEvent(eventType string, evt interface{}) (*event_pb.Event, error) {
+	header := getEventHeader(evt)
+	result := &event_pb.Event{
+		Type:      eventType,
+		Namespace: header.namespace,
+		EventType: header.eventType,
+		Timestamp: timestamppb.New(header.timestamp),
+	}
+
+	switch e := evt.(type) {
+	case *event.CircuitEvent:
+		result.Payload = &event_pb.Event_Circuit{Circuit: &event_pb.CircuitEvent{
+			Version:          e.Version,
+			CircuitId:        e.CircuitId,
+			ClientId:         e.ClientId,
+			ServiceId:        e.ServiceId,
+			TerminatorId:     e.TerminatorId,
+			InstanceId:       e.InstanceId,
+			CreationTimespan: (*int64)(e.CreationTimespan),
+			Path:             toProtobufCircuitPath(&e.Path),
+			SecondaryPath:    toProtobufCircuitPath(e.SecondaryPath),
+			LinkCount:        int32(e.LinkCount),
+			PathCost:         e.Cost,
+			FailureCause:     e.FailureCause,
+			Duration:         (*int64)(e.Duration),
+			Tags:             e.Tags,
+		}}
+	case *event.LinkEvent:
+		link := &event_pb.LinkEvent{
+			LinkId:      e.LinkId,
+			SrcRouterId: e.SrcRouterId,
+			DstRouterId: e.DstRouterId,
+			Protocol:    e.Protocol,
+			DialAddress: e.DialAddress,
+			Cost:        e.Cost,
+		}
+		for _, conn := range e.Connections {
+			link.Connections = append(link.Connections, &event_pb.LinkConnection{
+				Id:         conn.Id,
+				LocalAddr:  conn.LocalAddr,
+				RemoteAddr: conn.RemoteAddr,
+			})
+		}
+		result.Payload = &event_pb.Event_Link{Link: link}
+	case *event.MetricsEvent:
+		metrics := &event_pb.MetricsEvent{
+			MetricType:     e.MetricType,
+			SourceId:       e.SourceAppId,
+			SourceEntityId: e.SourceEntityId,
+			Version:        e.Version,
+			Metric:         e.Metric,
+			IntValues:      map[string]int64{},
+			FloatValues:    map[string]float64{},
+			Tags:           e.Tags,
+			SourceEventId:  e.SourceEventId,
+		}
+		for k, v := range e.Metrics {
+			switch val := v.(type) {
+			case int64:
+				metrics.IntValues[k] = val
+			case int:
+				metrics.IntValues[k] = int64(val)
+			case uint64:
+				metrics.IntValues[k] = int64(val)
+			case float64:
+				metrics.FloatValues[k] = val
+			case float32:
+				metrics.FloatValues[k] = float64(val)
+			default:
+				return nil, errors.Errorf("unsupported value type %T for metric %v.%v", v, e.Metric, k)
+			}
+		}
+		result.Payload = &event_pb.Event_Metrics{Metrics: metrics}
+	case *event.ServiceEvent:
+		result.Payload = &event_pb.Event_Service{Service: &event_pb.ServiceEvent{
+			Version:          e.Version,
+			ServiceId:        e.ServiceId,
+			TerminatorId:     e.TerminatorId,
+			Count:            e.Count,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+		}}
+	case *event.TerminatorEvent:
+		result.Payload = &event_pb.Event_Terminator{Terminator: &event_pb.TerminatorEvent{
+			ServiceId:                 e.ServiceId,
+			TerminatorId:              e.TerminatorId,
+			RouterId:                  e.RouterId,
+			HostId:                    e.HostId,
+			RouterOnline:              e.RouterOnline,
+			Precedence:                e.Precedence,
+			StaticCost:                uint32(e.StaticCost),
+			DynamicCost:               uint32(e.DynamicCost),
+			TotalTerminators:          int64(e.TotalTerminators),
+			UsableDefaultTerminators:  int64(e.UsableDefaultTerminators),
+			UsableRequiredTerminators: int64(e.UsableRequiredTerminators),
+			HealthCheckError:          e.HealthCheckError,
+			ActiveCircuits:            toOptionalInt64(e.ActiveCircuits),
+		}}
+	case *event.RouterEvent:
+		result.Payload = &event_pb.Event_Router{Router: &event_pb.RouterEvent{
+			RouterId:        e.RouterId,
+			RouterOnline:    e.RouterOnline,
+			TransitCircuits: toOptionalInt64(e.TransitCircuits),
+		}}
+	case *event.UsageEvent:
+		result.Payload = &event_pb.Event_Usage{Usage: &event_pb.UsageEvent{
+			Version:          e.Version,
+			SourceId:         e.SourceId,
+			CircuitId:        e.CircuitId,
+			Usage:            e.Usage,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+			Tags:             e.Tags,
+		}}
+	case *event.UsageEventV3:
+		result.Payload = &event_pb.Event_UsageV3{UsageV3: &event_pb.UsageEventV3{
+			Version:          e.Version,
+			SourceId:         e.SourceId,
+			CircuitId:        e.CircuitId,
+			Usage:            e.Usage,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+			Tags:             e.Tags,
+		}}
+	case *event.ClusterEvent:
+		cluster := &event_pb.ClusterEvent{
+			Index: e.Index,
+		}
+		for _, peer := range e.Peers {
+			cluster.Peers = append(cluster.Peers, &event_pb.ClusterPeer{
+				Id:      peer.Id,
+				Addr:    peer.Addr,
+				Version: peer.Version,
+			})
+		}
+		result.Payload = &event_pb.Event_Cluster{Cluster: cluster}
+	case *event.EntityChangeEvent:
+		entityChange := &event_pb.EntityChangeEvent{
+			EventId:       e.EventId,
+			EntityType:    e.EntityType,
+			IsParentEvent: e.IsParentEvent,
+			Metadata:      map[string]string{},
+		}
+		var err error
+		if e.InitialState != nil {
+			if entityChange.InitialState, err = json.Marshal(e.InitialState); err != nil {
+				return nil, err
+			}
+		}
+		if e.FinalState != nil {
+			if entityChange.FinalState, err = json.Marshal(e.FinalState); err != nil {
+				return nil, err
+			}
+		}
+		for k, v := range e.Metadata {
+			entityChange.Metadata[k] = fmt.Sprintf("%v", v)
+		}
+		result.Payload = &event_pb.Event_EntityChange{EntityChange: entityChange}
+	default:
+		return nil, errors.Errorf("unsupported event type %T", evt)
+	}
+
+	return result, nil
+}
+
+func toProtobufCircuitPath(path *event.CircuitPath) *event_pb.CircuitPath {
+	if path == nil {
+		return nil
+	}
+	return &event_pb.CircuitPath{
+		Nodes:                path.Nodes,
+		Links:                path.Links,
+		IngressId:            path.IngressId,
+		EgressId:             path.EgressId,
+		InitiatorLocalAddr:   path.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  path.TerminatorLocalAddr,
+		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+	}
+}
+
+func toOptionalInt64(val *int) *int64 {
+	if val == nil {
+		return nil
+	}
+	result := int64(*val)
+	return &result
+}
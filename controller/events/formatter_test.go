@@ -0,0 +1,213 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/openziti/fabric/common/pb/event_pb"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestRouterEvent(eventType event.RouterEventType, routerId string) *event.RouterEvent {
+	return &event.RouterEvent{
+		Namespace: event.RouterEventsNs,
+		EventType: eventType,
+		Timestamp: time.UnixMilli(1700000000123),
+		RouterId:  routerId,
+	}
+}
+
+func TestProtobufFormatterFileOutput(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	path := filepath.Join(t.TempDir(), "events.pb")
+	handler, err := dispatcher.eventHandlerFactories.Get("file").NewEventHandler(map[interface{}]interface{}{
+		"format": "protobuf",
+		"path":   path,
+	})
+	req.NoError(err)
+	req.NoError(dispatcher.ProcessSubscriptions(handler, []*event.Subscription{
+		{Type: event.RouterEventsNs},
+		{Type: event.CircuitEventsNs},
+	}))
+
+	cost := uint32(12)
+	handler.(event.RouterEventHandler).AcceptRouterEvent(newTestRouterEvent(event.RouterOffline, "r1"))
+	handler.(event.CircuitEventHandler).AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		EventType: event.CircuitCreated,
+		CircuitId: "c1",
+		Timestamp: time.Now(),
+		Path:      event.CircuitPath{Nodes: []string{"r1", "r2"}, Links: []string{"l1"}},
+		Cost:      &cost,
+		Tags:      map[string]string{"clientId": "client"},
+	})
+
+	var events []*event_pb.Event
+	req.Eventually(func() bool {
+		f, err := os.Open(path)
+		req.NoError(err)
+		defer func() { _ = f.Close() }()
+
+		events = nil
+		reader := bufio.NewReader(f)
+		for {
+			msg := &event_pb.Event{}
+			if err = protodelim.UnmarshalFrom(reader, msg); err != nil {
+				req.ErrorIs(err, io.EOF)
+				return len(events) == 2
+			}
+			events = append(events, msg)
+		}
+	}, 2*time.Second, 10*time.Millisecond)
+
+	req.Equal("router", events[0].Type)
+	req.Equal(string(event.RouterOffline), events[0].EventType)
+	req.Equal(int64(1700000000123), events[0].Timestamp.AsTime().UnixMilli())
+	req.Equal("r1", events[0].GetRouter().RouterId)
+
+	req.Equal("circuit", events[1].Type)
+	req.Equal(event.CircuitEventsNs, events[1].Namespace)
+	circuit := events[1].GetCircuit()
+	req.Equal("c1", circuit.CircuitId)
+	req.Equal([]string{"r1", "r2"}, circuit.Path.Nodes)
+	req.Equal(uint32(12), circuit.GetPathCost())
+	req.Nil(circuit.SecondaryPath)
+	req.Equal("client", circuit.Tags["clientId"])
+}
+
+func TestCefFormatter(t *testing.T) {
+	req := require.New(t)
+
+	evt := newTestRouterEvent(event.RouterOffline, "r=1")
+	buf, err := MarshalCefEvent("router", evt)
+	req.NoError(err)
+
+	cef := string(buf)
+	req.True(strings.HasPrefix(cef, "CEF:0|OpenZiti|ziti-controller|"), cef)
+	req.Contains(cef, "|router.router-offline|fabric.routers router-offline|7|rt=1700000000123 ")
+	req.Contains(cef, ` router_id=r\=1 `)
+	req.Contains(cef, " router_online=false")
+
+	buf, err = MarshalCefEvent("router", newTestRouterEvent(event.RouterOnline, "r1"))
+	req.NoError(err)
+	req.Contains(string(buf), "|router.router-online|fabric.routers router-online|3|")
+
+	buf, err = MarshalSyslogEvent("router", evt)
+	req.NoError(err)
+	req.True(strings.HasPrefix(string(buf), "<132>1 2023-11-14T22:13:20.123Z "), string(buf))
+	req.True(strings.HasSuffix(string(buf), cef))
+
+	// nested values are flattened and keys which aren't valid in CEF are cleaned up
+	buf, err = MarshalCefEvent("usage", &event.UsageEvent{
+		Namespace: "fabric.usage",
+		EventType: "usage.ingress.tx",
+		Tags:      map[string]string{"host id": "h1"},
+	})
+	req.NoError(err)
+	req.Contains(string(buf), " tags.host_id=h1")
+}
+
+func TestOtlpFormatter(t *testing.T) {
+	req := require.New(t)
+
+	first, err := MarshalOtlpEvent("router", newTestRouterEvent(event.RouterOffline, "r1"))
+	req.NoError(err)
+	second, err := MarshalOtlpEvent("router", newTestRouterEvent(event.RouterOnline, "r2"))
+	req.NoError(err)
+
+	logs := &otlpLogsData{}
+	req.NoError(json.Unmarshal(first, logs))
+	req.Len(logs.ResourceLogs, 1)
+	req.Len(logs.ResourceLogs[0].ScopeLogs, 1)
+
+	record := logs.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	req.Equal("1700000000123000000", record.TimeUnixNano)
+	req.Equal(otlpSeverityWarn, record.SeverityNumber)
+
+	attributes := map[string]string{}
+	for _, attr := range record.Attributes {
+		attributes[attr.Key] = attr.Value.StringValue
+	}
+	req.Equal("fabric.routers.router-offline", attributes["event.name"])
+	req.Equal("router", attributes["ziti.event.type"])
+	req.Equal("r1", attributes["ziti.router_id"])
+
+	body := &event.RouterEvent{}
+	req.NoError(json.Unmarshal([]byte(record.Body.StringValue), body))
+	req.Equal("r1", body.RouterId)
+
+	merged, err := mergeOtlpLogs([][]byte{first, second})
+	req.NoError(err)
+	logs = &otlpLogsData{}
+	req.NoError(json.Unmarshal(merged, logs))
+	req.Len(logs.ResourceLogs, 1)
+	req.Len(logs.ResourceLogs[0].ScopeLogs[0].LogRecords, 2)
+	req.Equal(otlpSeverityInfo, logs.ResourceLogs[0].ScopeLogs[0].LogRecords[1].SeverityNumber)
+}
+
+type testFormattedEventSink chan string
+
+func (self testFormattedEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	self <- eventType + ":" + string(formattedEvent)
+}
+
+func TestRegisteredFormatterSelectableByHandlers(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	for _, format := range []string{"json", "protobuf", "cef", "syslog", "otlp"} {
+		req.NotNil(dispatcher.GetFormatterFactory(format), format)
+	}
+
+	dispatcher.RegisterFormatterFactory("ids", event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+		return NewMarshallingFormatter(1, sink, func(eventType string, evt interface{}) ([]byte, error) {
+			return []byte(evt.(*event.RouterEvent).RouterId), nil
+		})
+	}))
+
+	sink := make(testFormattedEventSink, 1)
+	handler, err := fabricFormatterFactory{formatters: dispatcher}.NewFormattingHandler("ids", 1, sink)
+	req.NoError(err)
+	handler.(event.RouterEventHandler).AcceptRouterEvent(newTestRouterEvent(event.RouterOnline, "r1"))
+
+	select {
+	case val := <-sink:
+		req.Equal("router:r1", val)
+	case <-time.After(2 * time.Second):
+		req.Fail("timed out waiting for formatted event")
+	}
+
+	_, err = dispatcher.eventHandlerFactories.Get("stdout").NewEventHandler(map[interface{}]interface{}{"format": "xml"})
+	req.ErrorContains(err, "invalid 'format' for event handler: xml")
+}
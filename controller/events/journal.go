@@ -0,0 +1,606 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/pkg/errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	journalSegmentSuffix = ".journal"
+	journalIdFile        = "journal.id"
+
+	// records are a crc32 of the remainder of the record, followed by the sequence number, the timestamp in
+	// unix nanoseconds, the event type length, the event length, the event type and the event
+	journalRecordHeaderSize = 4 + 8 + 8 + 2 + 4
+	journalMaxEventSize     = 64 * 1024 * 1024
+
+	journalRetentionCheckInterval = time.Minute
+)
+
+// JournalEventLoggerFactory creates the event journal, which durably records events in segment files so
+// that clients can resume reading from a cursor after being disconnected, using the mgmt channel or the
+// REST API. Which events are journaled is controlled by the handler's subscriptions. Events are journaled
+// in json format. Only one journal may be configured.
+/**
+Example configuration:
+events:
+  journal:
+    subscriptions:
+      - type: fabric.usage
+        version: 3
+      - type: fabric.circuits
+    handler:
+      type: journal
+      path: /var/lib/ziti/event-journal
+      maxAge: 168h
+      maxSizeMb: 1024
+      segmentSizeMb: 64
+      syncInterval: 1s
+*/
+type JournalEventLoggerFactory struct {
+	Dispatcher *Dispatcher
+}
+
+func (self *JournalEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	if value, found := config["format"]; found && value != FormatJson {
+		return nil, errors.Errorf("invalid 'format' for event journal: %v, only json is supported", value)
+	}
+
+	journal, err := NewEventJournal(self.Dispatcher.closeNotify, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if !self.Dispatcher.journal.CompareAndSwap(nil, journal) {
+		journal.Close()
+		return nil, errors.New("only one event journal may be configured")
+	}
+
+	return NewJsonFormatter(bufferSize, journal), nil
+}
+
+type eventJournalConfig struct {
+	path           string
+	maxAge         time.Duration
+	maxSize        int64
+	maxSegmentSize int64
+	syncInterval   time.Duration
+}
+
+func parseEventJournalConfig(config map[interface{}]interface{}) (*eventJournalConfig, error) {
+	result := &eventJournalConfig{
+		maxAge:       7 * 24 * time.Hour,
+		syncInterval: time.Second,
+	}
+
+	value, found := config["path"]
+	if !found {
+		return nil, errors.New("missing required 'path' config for event journal")
+	}
+
+	var err error
+	if result.path, err = getStringValue("path", value); err != nil {
+		return nil, err
+	}
+
+	maxSizeMb := 1024
+	segmentSizeMb := 64
+	for name, target := range map[string]*int{
+		"maxSizeMb":     &maxSizeMb,
+		"segmentSizeMb": &segmentSizeMb,
+	} {
+		if value, found = config[name]; found {
+			if *target, err = getPositiveIntValue(name, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	result.maxSize = int64(maxSizeMb) * 1024 * 1024
+	result.maxSegmentSize = int64(segmentSizeMb) * 1024 * 1024
+
+	for name, target := range map[string]*time.Duration{
+		"maxAge":       &result.maxAge,
+		"syncInterval": &result.syncInterval,
+	} {
+		if value, found = config[name]; found {
+			if *target, err = getDurationValue(name, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type journalSegment struct {
+	firstSeq  uint64
+	path      string
+	size      int64
+	lastWrite time.Time
+}
+
+// EventJournal appends formatted events to segment files, assigning each a sequence number. A new segment
+// is started once the current one reaches the configured size, and the oldest segments are removed once
+// they're older than the maximum age, or once the journal exceeds its maximum size. The journal id is stored
+// alongside the segments, and is replaced whenever the journal starts without segments, because sequence
+// numbers then start over.
+type EventJournal struct {
+	config    *eventJournalConfig
+	journalId string
+
+	lock         sync.Mutex
+	segments     []*journalSegment
+	current      *os.File
+	nextSeq      uint64
+	dirty        bool
+	closed       bool
+	appendNotify chan struct{}
+}
+
+func NewEventJournal(closeNotify <-chan struct{}, config map[interface{}]interface{}) (*EventJournal, error) {
+	journalConfig, err := parseEventJournalConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse event journal config")
+	}
+
+	result := &EventJournal{
+		config:       journalConfig,
+		nextSeq:      1,
+		appendNotify: make(chan struct{}),
+	}
+
+	if err = result.open(); err != nil {
+		result.Close()
+		return nil, err
+	}
+
+	go result.run(closeNotify)
+
+	return result, nil
+}
+
+func (self *EventJournal) open() error {
+	dir := self.config.path
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "unable to create event journal directory %v", dir)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read event journal directory %v", dir)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), journalSegmentSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), journalSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return errors.Wrapf(err, "unable to stat event journal segment %v", entry.Name())
+		}
+		self.segments = append(self.segments, &journalSegment{
+			firstSeq:  seq,
+			path:      filepath.Join(dir, entry.Name()),
+			size:      info.Size(),
+			lastWrite: info.ModTime(),
+		})
+	}
+
+	sort.Slice(self.segments, func(i, j int) bool {
+		return self.segments[i].firstSeq < self.segments[j].firstSeq
+	})
+
+	if err = self.loadJournalId(); err != nil {
+		return err
+	}
+
+	if len(self.segments) == 0 {
+		return nil
+	}
+
+	return self.recoverLastSegment()
+}
+
+// loadJournalId reads the id of the journal, creating a new one if the journal is empty or has no id yet
+func (self *EventJournal) loadJournalId() error {
+	path := filepath.Join(self.config.path, journalIdFile)
+
+	if len(self.segments) > 0 {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "unable to read event journal id from %v", path)
+		}
+		if journalId := strings.TrimSpace(string(data)); journalId != "" {
+			self.journalId = journalId
+			return nil
+		}
+	}
+
+	self.journalId = uuid.NewString()
+	if err := os.WriteFile(path, []byte(self.journalId), 0600); err != nil {
+		return errors.Wrapf(err, "unable to write event journal id to %v", path)
+	}
+	return nil
+}
+
+// recoverLastSegment finds the end of the last complete record in the last segment, truncating anything
+// after it, which may have been partially written if the controller stopped mid-write
+func (self *EventJournal) recoverLastSegment() error {
+	segment := self.segments[len(self.segments)-1]
+	self.nextSeq = segment.firstSeq
+
+	var validSize int64
+	err := readJournalSegment(segment.path, segment.size, func(entry *event.JournalEntry, recordSize int64) bool {
+		validSize += recordSize
+		self.nextSeq = entry.Sequence + 1
+		return true
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).Warnf("event journal segment %v is truncated, discarding %v bytes",
+			segment.path, segment.size-validSize)
+	}
+
+	f, err := os.OpenFile(segment.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open event journal segment %v", segment.path)
+	}
+	self.current = f
+
+	if validSize < segment.size {
+		if err = f.Truncate(validSize); err != nil {
+			return errors.Wrapf(err, "unable to truncate event journal segment %v", segment.path)
+		}
+		segment.size = validSize
+	}
+
+	return nil
+}
+
+func (self *EventJournal) run(closeNotify <-chan struct{}) {
+	syncTicker := time.NewTicker(self.config.syncInterval)
+	defer syncTicker.Stop()
+
+	retentionTicker := time.NewTicker(journalRetentionCheckInterval)
+	defer retentionTicker.Stop()
+
+	for {
+		select {
+		case <-syncTicker.C:
+			self.sync()
+		case <-retentionTicker.C:
+			self.lock.Lock()
+			self.enforceRetention()
+			self.lock.Unlock()
+		case <-closeNotify:
+			self.Close()
+			return
+		}
+	}
+}
+
+func (self *EventJournal) sync() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.dirty && self.current != nil {
+		if err := self.current.Sync(); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("unable to sync event journal segment %v", self.current.Name())
+		}
+		self.dirty = false
+	}
+}
+
+func (self *EventJournal) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	if _, err := self.Append(eventType, formattedEvent); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to journal %v event", eventType)
+	}
+}
+
+// Append writes the event to the current segment and returns the sequence number assigned to it. Events
+// are written to the OS as they're appended and synced to disk every syncInterval.
+func (self *EventJournal) Append(eventType string, formattedEvent []byte) (uint64, error) {
+	if len(formattedEvent) > journalMaxEventSize {
+		return 0, errors.Errorf("event of %v bytes exceeds the maximum journal event size", len(formattedEvent))
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.closed {
+		return 0, errors.New("event journal is closed")
+	}
+
+	if self.current == nil || self.segments[len(self.segments)-1].size >= self.config.maxSegmentSize {
+		if err := self.rollSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	seq := self.nextSeq
+	now := time.Now()
+	record := appendJournalRecord(nil, seq, now, eventType, formattedEvent)
+
+	segment := self.segments[len(self.segments)-1]
+	if n, err := self.current.Write(record); err != nil {
+		// drop the partial record, so the next append doesn't follow it
+		if n > 0 {
+			_ = self.current.Truncate(segment.size)
+		}
+		return 0, errors.Wrapf(err, "unable to write to event journal segment %v", segment.path)
+	}
+
+	segment.size += int64(len(record))
+	segment.lastWrite = now
+	self.nextSeq++
+	self.dirty = true
+
+	close(self.appendNotify)
+	self.appendNotify = make(chan struct{})
+
+	return seq, nil
+}
+
+func (self *EventJournal) rollSegment() error {
+	self.closeCurrent()
+
+	segment := &journalSegment{
+		firstSeq:  self.nextSeq,
+		path:      filepath.Join(self.config.path, fmt.Sprintf("%020d%s", self.nextSeq, journalSegmentSuffix)),
+		lastWrite: time.Now(),
+	}
+
+	f, err := os.OpenFile(segment.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create event journal segment %v", segment.path)
+	}
+
+	self.segments = append(self.segments, segment)
+	self.current = f
+	self.enforceRetention()
+	return nil
+}
+
+func (self *EventJournal) closeCurrent() {
+	if self.current != nil {
+		if err := self.current.Sync(); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("unable to sync event journal segment %v", self.current.Name())
+		}
+		if err := self.current.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Errorf("error closing event journal segment %v", self.current.Name())
+		}
+		self.current = nil
+		self.dirty = false
+	}
+}
+
+// enforceRetention removes the oldest segments while they're older than maxAge or while the journal is
+// larger than maxSize. The segment being appended to is never removed.
+func (self *EventJournal) enforceRetention() {
+	var totalSize int64
+	for _, segment := range self.segments {
+		totalSize += segment.size
+	}
+
+	log := pfxlog.Logger()
+	for len(self.segments) > 1 {
+		segment := self.segments[0]
+		expired := time.Since(segment.lastWrite) > self.config.maxAge
+		if !expired && totalSize <= self.config.maxSize {
+			return
+		}
+
+		if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Errorf("unable to remove event journal segment %v", segment.path)
+			return
+		}
+
+		log.Infof("removed event journal segment %v, expired: %v, journal size: %v", segment.path, expired, totalSize)
+		totalSize -= segment.size
+		self.segments = self.segments[1:]
+	}
+}
+
+func (self *EventJournal) Close() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.closed = true
+	self.closeCurrent()
+}
+
+func (self *EventJournal) GetJournalId() string {
+	return self.journalId
+}
+
+func (self *EventJournal) GetFirstSequence() uint64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	if len(self.segments) == 0 {
+		return self.nextSeq
+	}
+	return self.segments[0].firstSeq
+}
+
+func (self *EventJournal) GetNextSequence() uint64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.nextSeq
+}
+
+func (self *EventJournal) GetAppendNotify() <-chan struct{} {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.appendNotify
+}
+
+func (self *EventJournal) Read(fromSequence uint64, limit int, eventTypes ...string) ([]*event.JournalEntry, uint64, error) {
+	self.lock.Lock()
+	segments := make([]journalSegment, 0, len(self.segments))
+	for _, segment := range self.segments {
+		segments = append(segments, *segment)
+	}
+	nextSeq := self.nextSeq
+	self.lock.Unlock()
+
+	if len(segments) == 0 || fromSequence >= nextSeq {
+		return nil, nextSeq, nil
+	}
+
+	if fromSequence < segments[0].firstSeq {
+		fromSequence = segments[0].firstSeq
+	}
+
+	var accepted map[string]struct{}
+	if len(eventTypes) > 0 {
+		accepted = map[string]struct{}{}
+		for _, eventType := range eventTypes {
+			accepted[eventType] = struct{}{}
+		}
+	}
+
+	idx := sort.Search(len(segments), func(i int) bool {
+		return segments[i].firstSeq > fromSequence
+	}) - 1
+
+	var result []*event.JournalEntry
+	cursor := fromSequence
+
+	for ; idx < len(segments) && len(result) < limit; idx++ {
+		segment := segments[idx]
+		err := readJournalSegment(segment.path, segment.size, func(entry *event.JournalEntry, _ int64) bool {
+			if entry.Sequence < cursor {
+				return true
+			}
+			cursor = entry.Sequence + 1
+			if _, found := accepted[entry.EventType]; accepted == nil || found {
+				result = append(result, entry)
+			}
+			return len(result) < limit
+		})
+
+		// segments may be removed by retention while they're being read
+		if err != nil && !os.IsNotExist(errors.Cause(err)) {
+			return result, cursor, err
+		}
+	}
+
+	if len(result) < limit {
+		cursor = nextSeq
+	}
+
+	return result, cursor, nil
+}
+
+// readJournalSegment reads the records in the first size bytes of the segment file, passing each to the
+// callback, along with the size of the record, until the callback returns false
+func readJournalSegment(path string, size int64, f func(entry *event.JournalEntry, recordSize int64) bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open event journal segment %v", path)
+	}
+	defer func() { _ = file.Close() }()
+
+	r := bufio.NewReader(io.LimitReader(file, size))
+	for {
+		entry, recordSize, err := readJournalRecord(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "invalid record in event journal segment %v", path)
+		}
+		if !f(entry, recordSize) {
+			return nil
+		}
+	}
+}
+
+func appendJournalRecord(buf []byte, seq uint64, timestamp time.Time, eventType string, formattedEvent []byte) []byte {
+	start := len(buf)
+	buf = append(buf, 0, 0, 0, 0)
+	buf = binary.BigEndian.AppendUint64(buf, seq)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(timestamp.UnixNano()))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(eventType)))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(formattedEvent)))
+	buf = append(buf, eventType...)
+	buf = append(buf, formattedEvent...)
+	binary.BigEndian.PutUint32(buf[start:], crc32.ChecksumIEEE(buf[start+4:]))
+	return buf
+}
+
+func readJournalRecord(r io.Reader) (*event.JournalEntry, int64, error) {
+	header := make([]byte, journalRecordHeaderSize)
+	if n, err := io.ReadFull(r, header); err != nil {
+		if n == 0 && err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	typeLen := int(binary.BigEndian.Uint16(header[20:]))
+	eventLen := int(binary.BigEndian.Uint32(header[22:]))
+	if eventLen > journalMaxEventSize {
+		return nil, 0, errors.Errorf("invalid event length %v", eventLen)
+	}
+
+	body := make([]byte, typeLen+eventLen)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	crc := crc32.ChecksumIEEE(header[4:])
+	crc = crc32.Update(crc, crc32.IEEETable, body)
+	if crc != binary.BigEndian.Uint32(header) {
+		return nil, 0, errors.New("checksum mismatch")
+	}
+
+	entry := &event.JournalEntry{
+		Sequence:  binary.BigEndian.Uint64(header[4:]),
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(header[12:]))),
+		EventType: string(body[:typeLen]),
+		Event:     body[typeLen:],
+	}
+	return entry, int64(len(header) + len(body)), nil
+}
+
+var _ event.EventJournal = (*EventJournal)(nil)
@@ -0,0 +1,248 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestEventJournal(t *testing.T, dir string) *EventJournal {
+	closeNotify := make(chan struct{})
+	journal, err := NewEventJournal(closeNotify, map[interface{}]interface{}{"path": dir})
+	require.NoError(t, err)
+	t.Cleanup(func() { close(closeNotify) })
+	return journal
+}
+
+func getJournalSequences(entries []*event.JournalEntry) []uint64 {
+	var result []uint64
+	for _, entry := range entries {
+		result = append(result, entry.Sequence)
+	}
+	return result
+}
+
+func TestEventJournalReadFromCursor(t *testing.T) {
+	req := require.New(t)
+
+	journal := newTestEventJournal(t, t.TempDir())
+
+	for i := 0; i < 5; i++ {
+		eventType := "circuit"
+		if i%2 == 1 {
+			eventType = "link"
+		}
+		seq, err := journal.Append(eventType, []byte(fmt.Sprintf(`{"index":%v}`, i)))
+		req.NoError(err)
+		req.Equal(uint64(i+1), seq)
+	}
+
+	entries, cursor, err := journal.Read(0, 2)
+	req.NoError(err)
+	req.Equal([]uint64{1, 2}, getJournalSequences(entries))
+	req.Equal(uint64(3), cursor)
+	req.Equal("link", entries[1].EventType)
+	req.Equal(`{"index":1}`, string(entries[1].Event))
+
+	entries, cursor, err = journal.Read(cursor, 10)
+	req.NoError(err)
+	req.Equal([]uint64{3, 4, 5}, getJournalSequences(entries))
+	req.Equal(uint64(6), cursor)
+
+	entries, cursor, err = journal.Read(cursor, 10)
+	req.NoError(err)
+	req.Len(entries, 0)
+	req.Equal(uint64(6), cursor)
+
+	// filtered reads still advance the cursor past events which aren't returned
+	entries, cursor, err = journal.Read(1, 10, "link")
+	req.NoError(err)
+	req.Equal([]uint64{2, 4}, getJournalSequences(entries))
+	req.Equal(uint64(6), cursor)
+}
+
+func TestEventJournalAppendNotify(t *testing.T) {
+	req := require.New(t)
+
+	journal := newTestEventJournal(t, t.TempDir())
+	notify := journal.GetAppendNotify()
+
+	select {
+	case <-notify:
+		req.Fail("append notify shouldn't fire before an event is appended")
+	default:
+	}
+
+	_, err := journal.Append("circuit", []byte(`{}`))
+	req.NoError(err)
+
+	select {
+	case <-notify:
+	case <-time.After(time.Second):
+		req.Fail("append notify should fire once an event is appended")
+	}
+}
+
+func TestEventJournalSegmentsAndRetention(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	journal := newTestEventJournal(t, dir)
+	journal.config.maxSegmentSize = 200
+	journal.config.maxSize = 500
+
+	// each record is just over 100 bytes, so each segment holds two
+	payload := []byte(fmt.Sprintf(`{"value":"%060d"}`, 0))
+	for i := 0; i < 10; i++ {
+		_, err := journal.Append("circuit", payload)
+		req.NoError(err)
+	}
+
+	segments, err := filepath.Glob(filepath.Join(dir, "*.journal"))
+	req.NoError(err)
+	req.Len(segments, 3)
+	req.Equal(fmt.Sprintf("%020d.journal", 5), filepath.Base(segments[0]))
+	req.Equal(uint64(5), journal.GetFirstSequence())
+	req.Equal(uint64(11), journal.GetNextSequence())
+
+	// reading from before the first retained event starts at the first retained event
+	result, cursor, err := journal.Read(1, 100)
+	req.NoError(err)
+	req.Equal([]uint64{5, 6, 7, 8, 9, 10}, getJournalSequences(result))
+	req.Equal(uint64(11), cursor)
+
+	result, _, err = journal.Read(8, 1)
+	req.NoError(err)
+	req.Equal([]uint64{8}, getJournalSequences(result))
+
+	// expired segments are removed, except for the one being appended to
+	journal.config.maxAge = time.Nanosecond
+	journal.lock.Lock()
+	journal.enforceRetention()
+	journal.lock.Unlock()
+
+	segments, err = filepath.Glob(filepath.Join(dir, "*.journal"))
+	req.NoError(err)
+	req.Len(segments, 1)
+	req.Equal(uint64(9), journal.GetFirstSequence())
+}
+
+func TestEventJournalRecovery(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	closeNotify := make(chan struct{})
+	journal, err := NewEventJournal(closeNotify, map[interface{}]interface{}{"path": dir})
+	req.NoError(err)
+	journalId := journal.GetJournalId()
+	req.NotEmpty(journalId)
+
+	for i := 0; i < 3; i++ {
+		_, err = journal.Append("circuit", []byte(`{}`))
+		req.NoError(err)
+	}
+	journal.Close()
+	close(closeNotify)
+
+	// simulate a record which was only partially written when the controller stopped
+	f, err := os.OpenFile(filepath.Join(dir, fmt.Sprintf("%020d.journal", 1)), os.O_WRONLY|os.O_APPEND, 0600)
+	req.NoError(err)
+	_, err = f.Write(appendJournalRecord(nil, 4, time.Now(), "circuit", []byte(`{}`))[:20])
+	req.NoError(err)
+	req.NoError(f.Close())
+
+	journal = newTestEventJournal(t, dir)
+	req.Equal(journalId, journal.GetJournalId())
+	req.Equal(uint64(1), journal.GetFirstSequence())
+	req.Equal(uint64(4), journal.GetNextSequence())
+
+	seq, err := journal.Append("link", []byte(`{}`))
+	req.NoError(err)
+	req.Equal(uint64(4), seq)
+
+	entries, cursor, err := journal.Read(0, 10)
+	req.NoError(err)
+	req.Equal([]uint64{1, 2, 3, 4}, getJournalSequences(entries))
+	req.Equal("link", entries[3].EventType)
+	req.Equal(uint64(5), cursor)
+}
+
+func TestEventJournalIdChangesWhenJournalIsRecreated(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	closeNotify := make(chan struct{})
+	journal, err := NewEventJournal(closeNotify, map[interface{}]interface{}{"path": dir})
+	req.NoError(err)
+	journalId := journal.GetJournalId()
+
+	_, err = journal.Append("circuit", []byte(`{}`))
+	req.NoError(err)
+	journal.Close()
+	close(closeNotify)
+
+	// sequences restart when the journal files are removed, so the id must change as well
+	segments, err := filepath.Glob(filepath.Join(dir, "*.journal"))
+	req.NoError(err)
+	req.NotEmpty(segments)
+	for _, segment := range segments {
+		req.NoError(os.Remove(segment))
+	}
+
+	journal = newTestEventJournal(t, dir)
+	req.NotEmpty(journal.GetJournalId())
+	req.NotEqual(journalId, journal.GetJournalId())
+	req.Equal(uint64(1), journal.GetNextSequence())
+}
+
+func TestJournalEventHandler(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+	req.Nil(dispatcher.GetEventJournal())
+
+	config := map[interface{}]interface{}{"path": t.TempDir()}
+	handler, err := dispatcher.eventHandlerFactories.Get("journal").NewEventHandler(config)
+	req.NoError(err)
+	req.NotNil(dispatcher.GetEventJournal())
+
+	_, err = dispatcher.eventHandlerFactories.Get("journal").NewEventHandler(map[interface{}]interface{}{"path": t.TempDir()})
+	req.ErrorContains(err, "only one event journal may be configured")
+
+	req.NoError(dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{Type: event.RouterEventsNs}}))
+	dispatcher.AcceptRouterEvent(&event.RouterEvent{Namespace: event.RouterEventsNs, EventType: event.RouterOnline, RouterId: "r1"})
+
+	var entries []*event.JournalEntry
+	req.Eventually(func() bool {
+		entries, _, err = dispatcher.GetEventJournal().Read(0, 10)
+		return err == nil && len(entries) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	req.Equal("router", entries[0].EventType)
+	routerEvent := &event.RouterEvent{}
+	req.NoError(json.Unmarshal(entries[0].Event, routerEvent))
+	req.Equal("r1", routerEvent.RouterId)
+}
@@ -0,0 +1,128 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+const KafkaEventTypeHeader = "eventType"
+
+// NewKafkaEventBroker connects to a Kafka compatible broker. Supported options are:
+//
+//   - brokers: list of bootstrap broker addresses (required)
+//   - clientId: client id to report to the broker, defaults to ziti-controller
+//   - requiredAcks: one of all, local or none, defaults to all
+//   - version: Kafka protocol version to use, e.g. 2.8.0
+//   - tls: if true, connect using TLS
+func NewKafkaEventBroker(config map[interface{}]interface{}) (EventBroker, error) {
+	var brokers []string
+	switch val := config["brokers"].(type) {
+	case string:
+		brokers = strings.Split(val, ",")
+	case []interface{}:
+		for _, broker := range val {
+			addr, err := getStringValue("brokers", broker)
+			if err != nil {
+				return nil, err
+			}
+			brokers = append(brokers, addr)
+		}
+	}
+
+	if len(brokers) == 0 {
+		return nil, errors.New("missing kafka 'brokers'")
+	}
+
+	kafkaConfig := sarama.NewConfig()
+	kafkaConfig.ClientID = "ziti-controller"
+	kafkaConfig.Producer.Return.Successes = true
+	kafkaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	kafkaConfig.Producer.Retry.Max = 2
+	kafkaConfig.Metadata.Retry.Max = 1
+	kafkaConfig.Net.DialTimeout = 10 * time.Second
+
+	var err error
+	if value, found := config["clientId"]; found {
+		if kafkaConfig.ClientID, err = getStringValue("clientId", value); err != nil {
+			return nil, err
+		}
+	}
+
+	if value, found := config["requiredAcks"]; found {
+		switch value {
+		case "all":
+			kafkaConfig.Producer.RequiredAcks = sarama.WaitForAll
+		case "local":
+			kafkaConfig.Producer.RequiredAcks = sarama.WaitForLocal
+		case "none":
+			kafkaConfig.Producer.RequiredAcks = sarama.NoResponse
+		default:
+			return nil, errors.Errorf("invalid kafka 'requiredAcks' value '%v', must be one of all, local or none", value)
+		}
+	}
+
+	if value, found := config["version"]; found {
+		version, err := getStringValue("version", value)
+		if err != nil {
+			return nil, err
+		}
+		if kafkaConfig.Version, err = sarama.ParseKafkaVersion(version); err != nil {
+			return nil, errors.Wrap(err, "invalid kafka 'version'")
+		}
+	}
+
+	if value, found := config["tls"]; found {
+		enabled, ok := value.(bool)
+		if !ok {
+			return nil, errors.Errorf("invalid kafka 'tls' value '%v', must be a boolean", value)
+		}
+		kafkaConfig.Net.TLS.Enable = enabled
+	}
+
+	producer, err := sarama.NewSyncProducer(brokers, kafkaConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to connect to kafka brokers %v", brokers)
+	}
+
+	return &kafkaEventBroker{producer: producer}, nil
+}
+
+type kafkaEventBroker struct {
+	producer sarama.SyncProducer
+}
+
+func (self *kafkaEventBroker) Publish(messages []*BrokerMessage) error {
+	var kafkaMessages []*sarama.ProducerMessage
+	for _, msg := range messages {
+		kafkaMessages = append(kafkaMessages, &sarama.ProducerMessage{
+			Topic: msg.Topic,
+			Value: sarama.ByteEncoder(msg.Value),
+			Headers: []sarama.RecordHeader{
+				{Key: []byte(KafkaEventTypeHeader), Value: []byte(msg.EventType)},
+			},
+		})
+	}
+	return self.producer.SendMessages(kafkaMessages)
+}
+
+func (self *kafkaEventBroker) Close() error {
+	return self.producer.Close()
+}
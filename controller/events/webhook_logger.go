@@ -0,0 +1,397 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	WebhookSignatureHeader = "X-Ziti-Signature"
+	WebhookTimestampHeader = "X-Ziti-Timestamp"
+
+	// webhookDropLogInterval is the minimum time between logs of events dropped because a url's queue is full
+	webhookDropLogInterval = time.Minute
+)
+
+// WebhookEventLoggerFactory creates event handlers which POST batches of formatted events to one or more
+// URLs. Which events are sent is controlled by the handler's subscriptions, which can be narrowed to
+// specific event types using include, as with any other handler.
+/**
+Example configuration:
+events:
+  alerts:
+    subscriptions:
+      - type: fabric.routers
+        include:
+          - router-offline
+      - type: fabric.terminators
+        include:
+          - health-check-failed
+    handler:
+      type: webhook
+      format: json
+      urls:
+        - https://alerts.example.com/ziti
+      secret: some-shared-secret
+      headers:
+        Authorization: Bearer some-token
+      batchSize: 10
+      batchTimeout: 5s
+      maxRetryTime: 10m
+*/
+type WebhookEventLoggerFactory struct {
+	CloseNotify <-chan struct{}
+	Formatters  FormatterFactoryRegistry
+}
+
+func (self *WebhookEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	value, found := config["format"]
+	if !found {
+		return nil, errors.New("'format' must be specified for event handler")
+	}
+
+	format, ok := value.(string)
+	if !ok {
+		return nil, errors.New("invalid 'format' for event webhook handler")
+	}
+
+	sink, err := NewWebhookEventSink(format, self.CloseNotify, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return fabricFormatterFactory{formatters: self.Formatters}.NewFormattingHandler(format, bufferSize, sink)
+}
+
+// SignWebhookPayload returns the value of the signature header for a webhook request. It's an HMAC-SHA256
+// of the timestamp header value and the request body, separated by a period, so receivers can reject
+// replayed requests as well as forged ones.
+func SignWebhookPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type webhookConfig struct {
+	urls                 []string
+	secret               []byte
+	headers              map[string]string
+	queueSize            int
+	batchSize            int
+	batchTimeout         time.Duration
+	requestTimeout       time.Duration
+	retryInitialInterval time.Duration
+	retryMaxInterval     time.Duration
+	maxRetryTime         time.Duration
+}
+
+func parseWebhookConfig(config map[interface{}]interface{}) (*webhookConfig, error) {
+	result := &webhookConfig{
+		headers:              map[string]string{},
+		queueSize:            1000,
+		batchSize:            50,
+		batchTimeout:         time.Second,
+		requestTimeout:       10 * time.Second,
+		retryInitialInterval: time.Second,
+		retryMaxInterval:     time.Minute,
+		maxRetryTime:         10 * time.Minute,
+	}
+
+	if value, found := config["url"]; found {
+		url, err := getStringValue("url", value)
+		if err != nil {
+			return nil, err
+		}
+		result.urls = append(result.urls, url)
+	}
+
+	if value, found := config["urls"]; found {
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("'urls' must be a list of urls")
+		}
+		for _, val := range list {
+			url, err := getStringValue("urls", val)
+			if err != nil {
+				return nil, err
+			}
+			result.urls = append(result.urls, url)
+		}
+	}
+
+	if len(result.urls) == 0 {
+		return nil, errors.New("at least one webhook url must be specified, using 'url' or 'urls'")
+	}
+
+	if value, found := config["secret"]; found {
+		secret, err := getStringValue("secret", value)
+		if err != nil {
+			return nil, err
+		}
+		result.secret = []byte(secret)
+	}
+
+	if value, found := config["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("'headers' must be a map of header name to value")
+		}
+		for k, v := range headers {
+			result.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	var err error
+	for name, target := range map[string]*int{
+		"queueSize": &result.queueSize,
+		"batchSize": &result.batchSize,
+	} {
+		if value, found := config[name]; found {
+			if *target, err = getPositiveIntValue(name, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for name, target := range map[string]*time.Duration{
+		"batchTimeout":         &result.batchTimeout,
+		"requestTimeout":       &result.requestTimeout,
+		"retryInitialInterval": &result.retryInitialInterval,
+		"retryMaxInterval":     &result.retryMaxInterval,
+		"maxRetryTime":         &result.maxRetryTime,
+	} {
+		if value, found := config[name]; found {
+			if *target, err = getDurationValue(name, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// WebhookEventSink fans formatted events out to a batching sender per webhook url, so that a url which
+// is down, and being retried, doesn't hold up delivery to the others
+type WebhookEventSink struct {
+	targets []*webhookTarget
+}
+
+func NewWebhookEventSink(format string, closeNotify <-chan struct{}, config map[interface{}]interface{}) (*WebhookEventSink, error) {
+	webhookConfig, err := parseWebhookConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse webhook event handler config")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-closeNotify
+		cancel()
+	}()
+
+	result := &WebhookEventSink{}
+	for _, url := range webhookConfig.urls {
+		target := &webhookTarget{
+			url:    url,
+			config: webhookConfig,
+			format: strings.ToLower(format),
+			client: &http.Client{Timeout: webhookConfig.requestTimeout},
+			queue:  make(chan []byte, webhookConfig.queueSize),
+			ctx:    ctx,
+		}
+		result.targets = append(result.targets, target)
+		go target.run()
+	}
+
+	return result, nil
+}
+
+func (self *WebhookEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	for _, target := range self.targets {
+		select {
+		case target.queue <- formattedEvent:
+		default:
+			target.dropped.Add(1)
+			target.logDropped(time.Now())
+		}
+	}
+}
+
+// webhookTarget batches and sends events to a single url. Failed batches are retried before the next batch is sent,
+// so events stay in order, and events arriving while a url is down are dropped once its queue fills.
+type webhookTarget struct {
+	url    string
+	config *webhookConfig
+	format string
+	client *http.Client
+	queue  chan []byte
+	ctx    context.Context
+
+	dropped     atomic.Uint64
+	lastDropLog atomic.Int64
+}
+
+// logDropped logs the number of events dropped since the last time it logged. A queue stays full for as long as its
+// url is being retried, so drops are logged at most once per webhookDropLogInterval, rather than once per event.
+func (self *webhookTarget) logDropped(now time.Time) {
+	if self.dropped.Load() == 0 {
+		return
+	}
+
+	last := self.lastDropLog.Load()
+	if now.UnixNano()-last < int64(webhookDropLogInterval) || !self.lastDropLog.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	if count := self.dropped.Swap(0); count > 0 {
+		pfxlog.Logger().WithField("url", self.url).WithField("dropped", count).Error("webhook queue full, dropped events")
+	}
+}
+
+func (self *webhookTarget) run() {
+	ticker := time.NewTicker(self.config.batchTimeout)
+	defer ticker.Stop()
+
+	var batch [][]byte
+	for {
+		select {
+		case evt := <-self.queue:
+			batch = append(batch, evt)
+			if len(batch) >= self.config.batchSize {
+				self.send(batch)
+				batch = nil
+			}
+		case now := <-ticker.C:
+			if len(batch) > 0 {
+				self.send(batch)
+				batch = nil
+			}
+			self.logDropped(now)
+		case <-self.ctx.Done():
+			return
+		}
+	}
+}
+
+// encode returns the request body and content type for a batch. JSON events are sent as a JSON array, OTLP
+// events as a single logs document and protobuf events back to back, as they're length-delimited. Other
+// formats are sent one event per line.
+func (self *webhookTarget) encode(batch [][]byte) ([]byte, string, error) {
+	switch self.format {
+	case FormatJson:
+		body := append([]byte("["), bytes.Join(batch, []byte(","))...)
+		return append(body, ']'), "application/json", nil
+	case FormatOtlp:
+		body, err := mergeOtlpLogs(batch)
+		return body, "application/json", err
+	case FormatProtobuf:
+		return bytes.Join(batch, nil), "application/x-protobuf", nil
+	}
+	return append(bytes.Join(batch, []byte("\n")), '\n'), "text/plain", nil
+}
+
+func (self *webhookTarget) send(batch [][]byte) {
+	log := pfxlog.Logger().WithField("url", self.url)
+	body, contentType, err := self.encode(batch)
+	if err != nil {
+		log.WithError(err).Errorf("unable to encode %v events for webhook, dropping them", len(batch))
+		return
+	}
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = self.config.retryInitialInterval
+	expBackoff.MaxInterval = self.config.retryMaxInterval
+	expBackoff.MaxElapsedTime = self.config.maxRetryTime
+
+	operation := func() error {
+		err := self.post(body, contentType)
+		if err != nil {
+			log.WithError(err).Warnf("unable to deliver %v events to webhook", len(batch))
+		}
+		return err
+	}
+
+	if err = backoff.Retry(operation, backoff.WithContext(expBackoff, self.ctx)); err != nil {
+		log.WithError(err).Errorf("giving up delivering %v events to webhook", len(batch))
+	}
+}
+
+func (self *webhookTarget) post(body []byte, contentType string) error {
+	request, err := http.NewRequestWithContext(self.ctx, http.MethodPost, self.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+
+	for k, v := range self.config.headers {
+		request.Header.Set(k, v)
+	}
+	request.Header.Set("Content-Type", contentType)
+
+	if len(self.config.secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		request.Header.Set(WebhookTimestampHeader, timestamp)
+		request.Header.Set(WebhookSignatureHeader, SignWebhookPayload(self.config.secret, timestamp, body))
+	}
+
+	response, err := self.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	err = errors.Errorf("webhook returned status %v", response.Status)
+
+	// other client errors won't be fixed by sending the same request again
+	if response.StatusCode >= 400 && response.StatusCode < 500 &&
+		response.StatusCode != http.StatusRequestTimeout && response.StatusCode != http.StatusTooManyRequests {
+		return backoff.Permanent(err)
+	}
+	return err
+}
+
+var _ event.FormattedEventSink = (*WebhookEventSink)(nil)
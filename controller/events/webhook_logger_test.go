@@ -0,0 +1,227 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"github.com/openziti/fabric/controller/event"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newWebhookTestServer(t *testing.T, statusF func(attempt int32) int) (*httptest.Server, chan *webhookRequest) {
+	requests := make(chan *webhookRequest, 16)
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- &webhookRequest{header: r.Header, body: body}
+		w.WriteHeader(statusF(attempts.Add(1)))
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func newTestWebhookHandler(t *testing.T, config map[interface{}]interface{}) (*Dispatcher, interface{}) {
+	closeNotify := make(chan struct{})
+	t.Cleanup(func() { close(closeNotify) })
+
+	dispatcher := NewDispatcher(closeNotify)
+	handler, err := dispatcher.eventHandlerFactories.Get("webhook").NewEventHandler(config)
+	require.NoError(t, err)
+	return dispatcher, handler
+}
+
+func nextWebhookRequest(t *testing.T, requests chan *webhookRequest) *webhookRequest {
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for webhook request")
+		return nil
+	}
+}
+
+func TestWebhookDeliversFilteredSignedBatches(t *testing.T) {
+	req := require.New(t)
+
+	server, requests := newWebhookTestServer(t, func(int32) int { return http.StatusOK })
+	dispatcher, handler := newTestWebhookHandler(t, map[interface{}]interface{}{
+		"format":       "json",
+		"url":          server.URL,
+		"secret":       "s3cret",
+		"headers":      map[interface{}]interface{}{"Authorization": "Bearer token"},
+		"batchSize":    2,
+		"batchTimeout": "10s",
+	})
+
+	req.NoError(dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.RouterEventsNs,
+		Options: map[string]interface{}{"include": []interface{}{string(event.RouterOffline)}},
+	}}))
+
+	dispatcher.AcceptRouterEvent(&event.RouterEvent{Namespace: event.RouterEventsNs, EventType: event.RouterOnline, RouterId: "r0"})
+	dispatcher.AcceptRouterEvent(&event.RouterEvent{Namespace: event.RouterEventsNs, EventType: event.RouterOffline, RouterId: "r1"})
+	dispatcher.AcceptRouterEvent(&event.RouterEvent{Namespace: event.RouterEventsNs, EventType: event.RouterOffline, RouterId: "r2"})
+
+	request := nextWebhookRequest(t, requests)
+	req.Equal("application/json", request.header.Get("Content-Type"))
+	req.Equal("Bearer token", request.header.Get("Authorization"))
+
+	timestamp := request.header.Get(WebhookTimestampHeader)
+	req.NotEmpty(timestamp)
+	req.Equal(SignWebhookPayload([]byte("s3cret"), timestamp, request.body), request.header.Get(WebhookSignatureHeader))
+
+	var events []*event.RouterEvent
+	req.NoError(json.Unmarshal(request.body, &events))
+	req.Len(events, 2)
+	routerIds := map[string]bool{}
+	for _, evt := range events {
+		req.Equal(event.RouterOffline, evt.EventType)
+		routerIds[evt.RouterId] = true
+	}
+	req.Equal(map[string]bool{"r1": true, "r2": true}, routerIds)
+
+	// the subscription can be removed again, even though the handler is wrapped in a filter
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Len(dispatcher.routerEventHandlers.Value(), 0)
+}
+
+func TestWebhookRetriesWithBackoff(t *testing.T) {
+	req := require.New(t)
+
+	server, requests := newWebhookTestServer(t, func(attempt int32) int {
+		if attempt < 3 {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusOK
+	})
+
+	dispatcher, handler := newTestWebhookHandler(t, map[interface{}]interface{}{
+		"format":               "json",
+		"urls":                 []interface{}{server.URL},
+		"batchTimeout":         "10ms",
+		"retryInitialInterval": "10ms",
+	})
+	req.NoError(dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{Type: event.RouterEventsNs}}))
+
+	dispatcher.AcceptRouterEvent(&event.RouterEvent{Namespace: event.RouterEventsNs, EventType: event.RouterOffline, RouterId: "r1"})
+
+	first := nextWebhookRequest(t, requests)
+	for i := 0; i < 2; i++ {
+		req.Equal(first.body, nextWebhookRequest(t, requests).body)
+	}
+
+	select {
+	case <-requests:
+		req.Fail("unexpected request after successful delivery")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWebhookDoesNotRetryClientErrors(t *testing.T) {
+	req := require.New(t)
+
+	server, requests := newWebhookTestServer(t, func(int32) int { return http.StatusBadRequest })
+	sink, err := NewWebhookEventSink("json", nil, map[interface{}]interface{}{
+		"url":                  server.URL,
+		"batchTimeout":         "10ms",
+		"retryInitialInterval": "10ms",
+	})
+	req.NoError(err)
+
+	sink.AcceptFormattedEvent("router", []byte(`{}`))
+	req.Equal([]byte(`[{}]`), nextWebhookRequest(t, requests).body)
+
+	select {
+	case <-requests:
+		req.Fail("client errors shouldn't be retried")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionIncludeValidation(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	handler := NewJsonFormatter(1, NewWriterEventSink(io.Discard))
+	defer func() { _ = handler.Close() }()
+
+	err := dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.TerminatorEventsNs,
+		Options: map[string]interface{}{"include": "health-check-borked"},
+	}})
+	req.ErrorContains(err, "invalid include health-check-borked")
+
+	req.NoError(dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.TerminatorEventsNs,
+		Options: map[string]interface{}{"include": string(event.TerminatorHealthCheckFailed)},
+	}}))
+	req.Len(dispatcher.terminatorEventHandlers.Value(), 1)
+
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Len(dispatcher.terminatorEventHandlers.Value(), 0)
+}
+
+func TestWebhookAggregatesDroppedEventLogs(t *testing.T) {
+	req := require.New(t)
+
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	// the target isn't running, so its queue fills after the first event
+	target := &webhookTarget{url: "http://localhost", queue: make(chan []byte, 1)}
+	sink := &WebhookEventSink{targets: []*webhookTarget{target}}
+
+	for i := 0; i < 10; i++ {
+		sink.AcceptFormattedEvent("router", []byte(`{}`))
+	}
+
+	// the first drop is logged straight away, later ones are counted until the interval has passed
+	countDropLogs := func() int {
+		count := 0
+		for _, entry := range hook.AllEntries() {
+			if entry.Message == "webhook queue full, dropped events" {
+				count++
+			}
+		}
+		return count
+	}
+	req.Equal(1, countDropLogs())
+	req.Equal(uint64(8), target.dropped.Load())
+
+	target.logDropped(time.Now())
+	req.Equal(1, countDropLogs())
+
+	target.logDropped(time.Now().Add(webhookDropLogInterval))
+	req.Equal(2, countDropLogs())
+	req.Equal(uint64(8), hook.LastEntry().Data["dropped"])
+	req.Equal(uint64(0), target.dropped.Load())
+}
@@ -57,6 +57,7 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newCircuitRequestHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newRouteResultHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCircuitConfirmationHandler(self.network, self.router))
+	binding.AddTypedReceiveHandler(newCircuitFailoverHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCreateTerminatorHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newRemoveTerminatorHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newRemoveTerminatorsHandler(self.network, self.router))
@@ -70,6 +71,8 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newInspectHandler(self.network))
 	binding.AddTypedReceiveHandler(newQuiesceRouterHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newDequiesceRouterHandler(self.router, self.network))
+	binding.AddTypedReceiveHandler(newRouterMaintenanceHandler(self.router, self.network, true))
+	binding.AddTypedReceiveHandler(newRouterMaintenanceHandler(self.router, self.network, false))
 	binding.AddTypedReceiveHandler(newPingHandler())
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(self.network.GetAppId(), binding.GetChannel(), self.network.GetTraceController()))
 	binding.AddPeekHandler(metrics2.NewCtrlChannelPeekHandler(self.router.Id, self.network.GetMetricsRegistry()))
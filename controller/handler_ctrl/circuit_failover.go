@@ -0,0 +1,51 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+type circuitFailoverHandler struct {
+	n *network.Network
+	r *network.Router
+}
+
+func newCircuitFailoverHandler(n *network.Network, r *network.Router) *circuitFailoverHandler {
+	return &circuitFailoverHandler{n, r}
+}
+
+func (self *circuitFailoverHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CircuitFailoverType)
+}
+
+func (self *circuitFailoverHandler) HandleReceive(msg *channel.Message, _ channel.Channel) {
+	log := logrus.WithField("routerId", self.r.Id)
+	failover := &ctrl_pb.CircuitFailover{}
+	if err := proto.Unmarshal(msg.Body, failover); err == nil {
+		log.WithField("linkId", failover.LinkId).
+			WithField("circuitCount", len(failover.CircuitIds)).
+			Info("received circuit failover report")
+		go self.n.CircuitsFailedOver(self.r, failover.LinkId, failover.CircuitIds)
+	} else {
+		log.WithError(err).Error("error unmarshalling circuit failover")
+	}
+}
@@ -19,6 +19,7 @@ package handler_ctrl
 import (
 	"github.com/openziti/fabric/controller/xt"
 	"google.golang.org/protobuf/proto"
+	"net"
 	"time"
 
 	"github.com/michaelquigley/pfxlog"
@@ -75,6 +76,14 @@ func (h *circuitRequestHandler) HandleReceive(msg *channel.Message, ch channel.C
 					responseMsg.Headers[int32(k)] = v
 				}
 
+				if circuit.Datagram {
+					responseMsg.Headers[ctrl_msg.DatagramModeHeader] = []byte{1}
+				}
+
+				if circuit.HalfClose {
+					responseMsg.Headers[ctrl_msg.HalfCloseHeader] = []byte{1}
+				}
+
 				if err := responseMsg.WithTimeout(10 * time.Second).Send(h.r.Control); err != nil {
 					log.Errorf("unable to respond with success to create circuit request for circuit %v (%s)", circuit.Id, err)
 					if err := h.network.RemoveCircuit(circuit.Id, true); err != nil {
@@ -145,3 +154,22 @@ func (self *circuitParams) GetLogContext() logcontext.Context {
 func (self *circuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
+
+// GetAffinityKey returns the affinity key from the peer data, if one was provided. Otherwise the initiator's remote
+// host is used, if known.
+func (self *circuitParams) GetAffinityKey() string {
+	if key, found := self.clientId.Data[ctrl_msg.AffinityKeyHeader]; found && len(key) > 0 {
+		return string(key)
+	}
+
+	if addr, found := self.clientId.Data[ctrl_msg.InitiatorRemoteAddressHeader]; found && len(addr) > 0 {
+		if host, _, err := net.SplitHostPort(string(addr)); err == nil {
+			return host
+		}
+		return string(addr)
+	}
+
+	return ""
+}
+
+var _ network.AffinityKeyProvider = (*circuitParams)(nil)
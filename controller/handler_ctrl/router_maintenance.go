@@ -0,0 +1,74 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/handler_common"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/network"
+)
+
+// routerMaintenanceHandler starts or ends maintenance for the connected router, at the router's request
+type routerMaintenanceHandler struct {
+	baseHandler
+	start bool
+}
+
+func newRouterMaintenanceHandler(router *network.Router, network *network.Network, start bool) *routerMaintenanceHandler {
+	return &routerMaintenanceHandler{
+		baseHandler: baseHandler{
+			router:  router,
+			network: network,
+		},
+		start: start,
+	}
+}
+
+func (self *routerMaintenanceHandler) ContentType() int32 {
+	if self.start {
+		return int32(ctrl_pb.ContentType_StartRouterMaintenanceRequestType)
+	}
+	return int32(ctrl_pb.ContentType_EndRouterMaintenanceRequestType)
+}
+
+func (self *routerMaintenanceHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).Entry
+	log = log.WithField("routerId", self.router.Id).WithField("start", self.start)
+
+	go func() {
+		var err error
+		if self.start {
+			err = self.network.Routers.StartMaintenance(self.router.Id, self.newChangeContext(ch, "start.router.maintenance"))
+		} else {
+			err = self.network.Routers.EndMaintenance(self.router.Id, self.newChangeContext(ch, "end.router.maintenance"))
+		}
+
+		if err == nil {
+			if self.start {
+				handler_common.SendSuccess(msg, ch, "router maintenance started, transit circuits will be rerouted")
+			} else {
+				handler_common.SendSuccess(msg, ch, "router maintenance ended")
+			}
+			log.Debug("router maintenance update successful")
+		} else {
+			handler_common.SendFailure(msg, ch, err.Error())
+			log.WithError(err).Error("router maintenance update failed")
+		}
+	}()
+}
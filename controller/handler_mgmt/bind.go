@@ -43,7 +43,12 @@ func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(eventsHandler)
 	binding.AddCloseHandler(eventsHandler)
 
+	journalHandler := newStreamJournalHandler(bindHandler.network)
+	binding.AddTypedReceiveHandler(journalHandler)
+	binding.AddCloseHandler(journalHandler)
+
 	binding.AddTypedReceiveHandler(newTogglePipeTracesHandler(bindHandler.network))
+	binding.AddTypedReceiveHandler(newTerminatorDrainHandler(bindHandler.network))
 
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(bindHandler.network.GetAppId(), binding.GetChannel(), bindHandler.network.GetTraceController()))
 
@@ -0,0 +1,208 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"encoding/json"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/handler_common"
+	"github.com/openziti/fabric/common/pb/mgmt_pb"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+const streamJournalBatchSize = 100
+
+// StreamJournalRequest requests events from the event journal, starting at FromSequence. Clients should
+// track the JournalSequenceHeader of the last event received, and resume from the following sequence when
+// they reconnect. If EventTypes is set, only events of those types, such as circuit or usage.v3, are sent.
+//
+// The success response and every event carry the JournalIdHeader. Sequence numbers are only meaningful within
+// a journal, so if the id changes, clients should restart from the beginning. If events due to be sent have
+// already been removed by retention, a StreamJournalGapType message is sent first, with the JournalSequenceHeader
+// set to the first missing sequence and the JournalFirstSequenceHeader set to the sequence streaming resumes from.
+// Each channel has at most one stream, so a new request replaces any stream already running on the channel.
+type StreamJournalRequest struct {
+	FromSequence uint64   `json:"fromSequence"`
+	EventTypes   []string `json:"eventTypes"`
+}
+
+type streamJournalHandler struct {
+	network     *network.Network
+	closeNotify chan struct{}
+	closeOnce   sync.Once
+
+	lock         sync.Mutex
+	streamNotify chan struct{}
+}
+
+func newStreamJournalHandler(network *network.Network) *streamJournalHandler {
+	return &streamJournalHandler{
+		network:     network,
+		closeNotify: make(chan struct{}),
+	}
+}
+
+func (*streamJournalHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_StreamJournalRequestType)
+}
+
+func (handler *streamJournalHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	journal := event.GetEventJournal(handler.network.GetEventDispatcher())
+	if journal == nil {
+		handler_common.SendFailure(msg, ch, "event journal is not enabled")
+		return
+	}
+
+	request := &StreamJournalRequest{}
+	if err := json.Unmarshal(msg.Body, request); err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+
+	// read the first batch before responding, so a journal which can't be read is reported as a failure
+	stream := &journalStream{
+		journal:      journal,
+		request:      request,
+		ch:           ch,
+		cursor:       request.FromSequence,
+		streamNotify: handler.replaceStream(),
+		closeNotify:  handler.closeNotify,
+	}
+
+	appendNotify, entries, err := stream.read()
+	if err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+
+	response := channel.NewResult(true, "success")
+	response.ReplyTo(msg)
+	response.PutStringHeader(int32(mgmt_pb.Header_JournalIdHeader), journal.GetJournalId())
+	response.PutUint64Header(int32(mgmt_pb.Header_JournalFirstSequenceHeader), journal.GetFirstSequence())
+	if err = response.WithTimeout(5 * time.Second).SendAndWaitForWire(ch); err != nil {
+		pfxlog.Logger().WithField("channel", ch.Label()).WithError(err).Error("failed to send result")
+		return
+	}
+
+	go stream.run(appendNotify, entries)
+}
+
+// replaceStream stops the stream currently running on the channel, if there is one, and returns the channel used to
+// stop the new stream
+func (handler *streamJournalHandler) replaceStream() chan struct{} {
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+
+	if handler.streamNotify != nil {
+		close(handler.streamNotify)
+	}
+	handler.streamNotify = make(chan struct{})
+	return handler.streamNotify
+}
+
+func (handler *streamJournalHandler) HandleClose(channel.Channel) {
+	handler.closeOnce.Do(func() {
+		close(handler.closeNotify)
+	})
+}
+
+type journalStream struct {
+	journal      event.EventJournal
+	request      *StreamJournalRequest
+	ch           channel.Channel
+	cursor       uint64
+	streamNotify <-chan struct{}
+	closeNotify  <-chan struct{}
+	entries      []*event.JournalEntry
+}
+
+// read returns the next batch of entries, along with a notifier which fires once more entries are appended. If
+// entries from the cursor have been removed by retention, the client is told about the gap first.
+func (self *journalStream) read() (<-chan struct{}, []*event.JournalEntry, error) {
+	// get the notifier before reading, so entries appended after the read aren't missed
+	appendNotify := self.journal.GetAppendNotify()
+
+	if firstSequence := self.journal.GetFirstSequence(); self.cursor > 0 && self.cursor < firstSequence {
+		gapMsg := channel.NewMessage(int32(mgmt_pb.ContentType_StreamJournalGapType), nil)
+		gapMsg.PutStringHeader(int32(mgmt_pb.Header_JournalIdHeader), self.journal.GetJournalId())
+		gapMsg.PutUint64Header(int32(mgmt_pb.Header_JournalSequenceHeader), self.cursor)
+		gapMsg.PutUint64Header(int32(mgmt_pb.Header_JournalFirstSequenceHeader), firstSequence)
+		if err := self.ch.Send(gapMsg); err != nil {
+			return nil, nil, errors.Wrap(err, "unable to send StreamJournalGap")
+		}
+		self.cursor = firstSequence
+	}
+
+	entries, next, err := self.journal.Read(self.cursor, streamJournalBatchSize, self.request.EventTypes...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error reading event journal from sequence %v", self.cursor)
+	}
+	self.cursor = next
+	return appendNotify, entries, nil
+}
+
+// run sends journal entries from the requested sequence, then continues sending new entries as they're
+// appended, until the channel is closed or the stream is replaced. If the journal can't be read, the channel
+// is closed, so the client knows to reconnect and resume from the last sequence it received.
+func (self *journalStream) run(appendNotify <-chan struct{}, entries []*event.JournalEntry) {
+	log := pfxlog.Logger().WithField("channel", self.ch.Label())
+
+	for {
+		for _, entry := range entries {
+			eventMsg := channel.NewMessage(int32(mgmt_pb.ContentType_StreamJournalEventType), entry.Event)
+			eventMsg.PutStringHeader(int32(mgmt_pb.Header_EventTypeHeader), entry.EventType)
+			eventMsg.PutStringHeader(int32(mgmt_pb.Header_JournalIdHeader), self.journal.GetJournalId())
+			eventMsg.PutUint64Header(int32(mgmt_pb.Header_JournalSequenceHeader), entry.Sequence)
+			if err := self.ch.Send(eventMsg); err != nil {
+				log.WithError(err).Error("unexpected error sending StreamJournalEvent")
+				return
+			}
+		}
+
+		if len(entries) < streamJournalBatchSize {
+			select {
+			case <-appendNotify:
+			case <-self.streamNotify:
+				return
+			case <-self.closeNotify:
+				return
+			}
+		} else {
+			select {
+			case <-self.streamNotify:
+				return
+			case <-self.closeNotify:
+				return
+			default:
+			}
+		}
+
+		var err error
+		if appendNotify, entries, err = self.read(); err != nil {
+			log.WithError(err).Error("closing mgmt channel, unable to continue streaming event journal")
+			if closeErr := self.ch.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("error closing mgmt channel")
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"time"
+
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/handler_common"
+	"github.com/openziti/fabric/common/pb/mgmt_pb"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/network"
+	"google.golang.org/protobuf/proto"
+)
+
+type terminatorDrainHandler struct {
+	network *network.Network
+}
+
+func newTerminatorDrainHandler(network *network.Network) *terminatorDrainHandler {
+	return &terminatorDrainHandler{network: network}
+}
+
+func (*terminatorDrainHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_TerminatorDrainRequestType)
+}
+
+func (handler *terminatorDrainHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	request := &mgmt_pb.TerminatorDrainRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+
+	go func() {
+		ctx := change.New().
+			SetChangeAuthorType(change.AuthorTypeCert).
+			SetChangeAuthorId(ch.Id()).
+			SetSourceType(change.SourceTypeMgmtChannel).
+			SetSourceMethod("terminator.drain").
+			SetSourceLocal(ch.Underlay().GetLocalAddr().String()).
+			SetSourceRemote(ch.Underlay().GetRemoteAddr().String())
+
+		if request.Cancel {
+			if err := handler.network.Terminators.CancelDrain(request.TerminatorId, ctx); err != nil {
+				handler_common.SendOpResult(msg, ch, "terminator.drain.cancel", err.Error(), false)
+				return
+			}
+			handler_common.SendOpResult(msg, ch, "terminator.drain.cancel", "terminator drain cancelled", true)
+			return
+		}
+
+		forceCloseAfter := time.Duration(request.ForceCloseAfterSeconds) * time.Second
+		if err := handler.network.Terminators.DrainTerminator(request.TerminatorId, forceCloseAfter, ctx); err != nil {
+			handler_common.SendOpResult(msg, ch, "terminator.drain", err.Error(), false)
+			return
+		}
+		handler_common.SendOpResult(msg, ch, "terminator.drain", "terminator draining", true)
+	}()
+}
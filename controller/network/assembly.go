@@ -38,6 +38,10 @@ func (network *Network) assemble() {
 		missingLinks, err := network.linkController.missingLinks(network.Routers.allConnected(), network.options.PendingLinkTimeout)
 		if err == nil {
 			for _, missingLink := range missingLinks {
+				if !network.Managers.RouterMessaging.IsLinkAllowed(missingLink.Src.Id, missingLink.Dst.Id) {
+					continue
+				}
+
 				network.linkController.add(missingLink)
 
 				dial := &ctrl_pb.Dial{
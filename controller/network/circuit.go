@@ -27,15 +27,21 @@ import (
 )
 
 type Circuit struct {
-	Id         string
-	ClientId   string
-	Service    *Service
-	Terminator xt.CostedTerminator
-	Path       *Path
-	Tags       map[string]string
-	Rerouting  atomic.Bool
-	PeerData   xt.PeerData
-	CreatedAt  time.Time
+	Id            string
+	ClientId      string
+	Service       *Service
+	Terminator    xt.CostedTerminator
+	Path          *Path
+	SecondaryPath *Path
+	BackupPaths   []*BackupPath
+	Tags          map[string]string
+	Rerouting     atomic.Bool
+	PeerData      xt.PeerData
+	CreatedAt     time.Time
+	// Datagram circuits forward payloads without retransmission or reordering
+	Datagram bool
+	// HalfClose circuits carry the end of a peer's input to the other end, rather than closing the circuit
+	HalfClose bool
 }
 
 func (self *Circuit) cost(minRouterCost uint16) int64 {
@@ -51,9 +57,61 @@ func (self *Circuit) HasRouter(routerId string) bool {
 			return true
 		}
 	}
+	if self.SecondaryPath != nil {
+		for _, node := range self.SecondaryPath.Nodes {
+			if node.Id == routerId {
+				return true
+			}
+		}
+	}
+	for _, backup := range self.BackupPaths {
+		for _, node := range backup.Nodes {
+			if node.Id == routerId {
+				return true
+			}
+		}
+	}
 	return false
 }
 
+// unusedRouters returns the routers from the given path which aren't used by any of the circuit's paths
+func (self *Circuit) unusedRouters(path *Path) []*Router {
+	var result []*Router
+	for _, node := range path.Nodes {
+		if !self.HasRouter(node.Id) {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// Routers returns the routers used by the circuit, including those only on the secondary path of a multipath circuit
+// and those only on backup paths
+func (self *Circuit) Routers() []*Router {
+	result := append([]*Router(nil), self.Path.Nodes...)
+	seen := map[string]struct{}{}
+	for _, node := range result {
+		seen[node.Id] = struct{}{}
+	}
+	addRouter := func(node *Router) {
+		if _, found := seen[node.Id]; !found {
+			seen[node.Id] = struct{}{}
+			result = append(result, node)
+		}
+	}
+	if self.SecondaryPath != nil {
+		for _, node := range self.SecondaryPath.Nodes {
+			addRouter(node)
+		}
+	}
+	for _, backup := range self.BackupPaths {
+		for _, node := range backup.Nodes {
+			addRouter(node)
+		}
+	}
+	return result
+}
+
 type circuitController struct {
 	circuits    cmap.ConcurrentMap[string, *Circuit]
 	idGenerator idgen.Generator
@@ -101,3 +159,16 @@ type CreateCircuitParams interface {
 	GetLogContext() logcontext.Context
 	GetDeadline() time.Time
 }
+
+// AffinityKeyProvider may be implemented by CreateCircuitParams which can identify the caller. The key is used by
+// strategies which consistently map callers to the same terminator. An empty string indicates that no key is available.
+type AffinityKeyProvider interface {
+	GetAffinityKey() string
+}
+
+func getAffinityKey(params CreateCircuitParams) string {
+	if provider, ok := params.(AffinityKeyProvider); ok {
+		return provider.GetAffinityKey()
+	}
+	return ""
+}
@@ -27,19 +27,23 @@ func (network *Network) fillCircuitPath(e *event.CircuitEvent, path *Path) {
 	if path == nil {
 		return
 	}
+	fillEventCircuitPath(&e.Path, path)
+	e.LinkCount = len(path.Links)
+}
+
+func fillEventCircuitPath(eventPath *event.CircuitPath, path *Path) {
 	for _, r := range path.Nodes {
-		e.Path.Nodes = append(e.Path.Nodes, r.Id)
+		eventPath.Nodes = append(eventPath.Nodes, r.Id)
 	}
 	for _, l := range path.Links {
-		e.Path.Links = append(e.Path.Links, l.Id)
+		eventPath.Links = append(eventPath.Links, l.Id)
 	}
-	e.Path.IngressId = path.IngressId
-	e.Path.EgressId = path.EgressId
-	e.Path.InitiatorLocalAddr = path.InitiatorLocalAddr
-	e.Path.InitiatorRemoteAddr = path.InitiatorRemoteAddr
-	e.Path.TerminatorLocalAddr = path.TerminatorLocalAddr
-	e.Path.TerminatorRemoteAddr = path.TerminatorRemoteAddr
-	e.LinkCount = len(path.Links)
+	eventPath.IngressId = path.IngressId
+	eventPath.EgressId = path.EgressId
+	eventPath.InitiatorLocalAddr = path.InitiatorLocalAddr
+	eventPath.InitiatorRemoteAddr = path.InitiatorRemoteAddr
+	eventPath.TerminatorLocalAddr = path.TerminatorLocalAddr
+	eventPath.TerminatorRemoteAddr = path.TerminatorRemoteAddr
 }
 
 func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *Circuit, creationTimespan *time.Duration) {
@@ -70,6 +74,10 @@ func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *
 	}
 
 	network.fillCircuitPath(circuitEvent, circuit.Path)
+	if secondary := circuit.SecondaryPath; secondary != nil {
+		circuitEvent.SecondaryPath = &event.CircuitPath{}
+		fillEventCircuitPath(circuitEvent.SecondaryPath, secondary)
+	}
 	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
 }
 
@@ -80,7 +88,9 @@ const (
 	CircuitFailureIdGenerationError                CircuitFailureCause = "ID_GENERATION_ERR"
 	CircuitFailureNoTerminators                    CircuitFailureCause = "NO_TERMINATORS"
 	CircuitFailureNoOnlineTerminators              CircuitFailureCause = "NO_ONLINE_TERMINATORS"
+	CircuitFailureTerminatorsDraining              CircuitFailureCause = "TERMINATORS_DRAINING"
 	CircuitFailureNoPath                           CircuitFailureCause = "NO_PATH"
+	CircuitFailureNoCompliantPath                  CircuitFailureCause = "NO_COMPLIANT_PATH"
 	CircuitFailurePathMissingLink                  CircuitFailureCause = "PATH_MISSING_LINK"
 	CircuitFailureInvalidStrategy                  CircuitFailureCause = "INVALID_STRATEGY"
 	CircuitFailureStrategyError                    CircuitFailureCause = "STRATEGY_ERR"
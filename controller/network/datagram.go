@@ -0,0 +1,50 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/fabric/common/ctrl_msg"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/identity"
+)
+
+// datagramBindings are the terminator bindings which carry datagrams rather than streams. Payloads of datagram
+// circuits may be lost or arrive out of order, so only these bindings can terminate them.
+var datagramBindings = map[string]struct{}{
+	"transport_udp": {},
+}
+
+// isDatagramRequested returns true if the circuit request peer data asks for a datagram circuit
+func isDatagramRequested(clientId *identity.TokenId) bool {
+	_, found := clientId.Data[ctrl_msg.DatagramModeHeader]
+	return found
+}
+
+// supportsDatagram returns true if a datagram circuit can use the path and terminator. Every router on the path has to
+// support datagram circuits, otherwise the circuit falls back to reliable delivery.
+func (self *Path) supportsDatagram(terminator xt.Terminator) bool {
+	if _, found := datagramBindings[terminator.GetBinding()]; !found {
+		return false
+	}
+	for _, r := range self.Nodes {
+		if !r.HasCapability(ctrl_pb.RouterCapability_DatagramCircuits) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,337 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+)
+
+// BackupPath is a detour around one link of a circuit's path. Its forwards are installed ahead of time, so the
+// routers at either end of the protected link can switch to it locally if the link fails, without waiting for the
+// controller to reroute the circuit.
+type BackupPath struct {
+	ProtectedLink *Link
+	Nodes         []*Router
+	Links         []*Link
+}
+
+func (self *BackupPath) usesLink(l *Link) bool {
+	for _, link := range self.Links {
+		if link == l {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateBackupPaths finds a detour for each link of the given path. Detours don't use any links of the path or of
+// other detours, and only pass through routers of the path at their ends. Links which can't be detoured around are
+// left unprotected.
+func (network *Network) CreateBackupPaths(path *Path) []*BackupPath {
+	var result []*BackupPath
+
+	routerFilter, constraintLinkFilter := network.newPathConstraintFilters(path.constraints)
+	linkFilter := func(l *Link) bool {
+		if path.usesLink(l) || (constraintLinkFilter != nil && !constraintLinkFilter(l)) {
+			return false
+		}
+		for _, backup := range result {
+			if backup.usesLink(l) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for i, protected := range path.Links {
+		src, dst := path.Nodes[i], path.Nodes[i+1]
+		detourRouterFilter := func(r *Router) bool {
+			if r.Id == src.Id || r.Id == dst.Id {
+				return true
+			}
+			return !path.hasRouter(r) && (routerFilter == nil || routerFilter(r))
+		}
+
+		nodes, _, err := network.shortestPathMatching(src, dst, detourRouterFilter, linkFilter)
+		if err != nil {
+			pfxlog.Logger().WithField("linkId", protected.Id).WithError(err).Debug("no backup path for link")
+			continue
+		}
+
+		detour := &Path{Nodes: nodes}
+		if err = network.setLinksMatching(detour, linkFilter); err != nil {
+			pfxlog.Logger().WithField("linkId", protected.Id).WithError(err).Debug("no backup path for link")
+			continue
+		}
+
+		result = append(result, &BackupPath{
+			ProtectedLink: protected,
+			Nodes:         nodes,
+			Links:         detour.Links,
+		})
+	}
+
+	return result
+}
+
+// createBackupForwards returns the forwards for the given backup path, keyed by router id. The routers at either end
+// of the protected link get secondary forwards onto the detour, which the router promotes if the link fails.
+func (self *Path) createBackupForwards(backup *BackupPath) map[string][]*ctrl_pb.Route_Forward {
+	hop := -1
+	for i, link := range self.Links {
+		if link == backup.ProtectedLink {
+			hop = i
+		}
+	}
+	if hop < 0 {
+		return nil
+	}
+
+	prevAddr, prevType := self.IngressId, ctrl_pb.DestType_Start
+	if hop > 0 {
+		prevAddr, prevType = self.Links[hop-1].Id, ctrl_pb.DestType_Link
+	}
+
+	nextAddr, nextType := self.EgressId, ctrl_pb.DestType_End
+	if hop < len(self.Links)-1 {
+		nextAddr, nextType = self.Links[hop+1].Id, ctrl_pb.DestType_Link
+	}
+
+	result := map[string][]*ctrl_pb.Route_Forward{}
+	last := len(backup.Links) - 1
+	for i, r := range backup.Nodes {
+		var forwards []*ctrl_pb.Route_Forward
+		switch {
+		case i == 0:
+			forwards = append(forwards,
+				&ctrl_pb.Route_Forward{SrcAddress: prevAddr, DstAddress: backup.Links[0].Id, DstType: ctrl_pb.DestType_Link, Secondary: true},
+				&ctrl_pb.Route_Forward{SrcAddress: backup.Links[0].Id, DstAddress: prevAddr, DstType: prevType})
+		case i == len(backup.Nodes)-1:
+			forwards = append(forwards,
+				&ctrl_pb.Route_Forward{SrcAddress: nextAddr, DstAddress: backup.Links[last].Id, DstType: ctrl_pb.DestType_Link, Secondary: true},
+				&ctrl_pb.Route_Forward{SrcAddress: backup.Links[last].Id, DstAddress: nextAddr, DstType: nextType})
+		default:
+			forwards = append(forwards,
+				&ctrl_pb.Route_Forward{SrcAddress: backup.Links[i-1].Id, DstAddress: backup.Links[i].Id, DstType: ctrl_pb.DestType_Link},
+				&ctrl_pb.Route_Forward{SrcAddress: backup.Links[i].Id, DstAddress: backup.Links[i-1].Id, DstType: ctrl_pb.DestType_Link})
+		}
+		result[r.Id] = append(result[r.Id], forwards...)
+	}
+	return result
+}
+
+// routeBackupPaths computes and installs backup paths for a circuit, if fast reroute is enabled. Routers which are
+// only on a detour are routed first, so the routers on the circuit path don't get secondary forwards onto an
+// incomplete detour. Every router on the circuit path gets a backup route, which replaces any backup forwards left
+// from an earlier path. Routers which were only on earlier detours are unrouted. Failures aren't fatal, the affected
+// links are just left unprotected. The caller is expected to hold the circuit's rerouting flag, if the circuit has
+// already been created.
+func (network *Network) routeBackupPaths(circuit *Circuit, deadline time.Time) {
+	if !network.options.FastReroute || circuit.Service == nil || circuit.Service.IsMultipath() {
+		return
+	}
+
+	if len(circuit.Path.Links) == 0 && len(circuit.BackupPaths) == 0 {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+	path := circuit.Path
+	backups := network.CreateBackupPaths(path)
+
+	newRouteMsg := func() *ctrl_pb.Route {
+		return &ctrl_pb.Route{
+			CircuitId: circuit.Id,
+			Attempt:   SmartRerouteAttempt,
+			Timeout:   uint64(time.Until(deadline)),
+			Tags:      circuit.Tags,
+			QosClass:  circuit.Service.getQosClass(),
+			Backup:    true,
+			Datagram:  circuit.Datagram,
+			HalfClose: circuit.HalfClose,
+		}
+	}
+
+	forwardsByBackup := make([]map[string][]*ctrl_pb.Route_Forward, len(backups))
+	detourRouters := map[string]*Router{}
+	for i, backup := range backups {
+		forwardsByBackup[i] = path.createBackupForwards(backup)
+		for _, r := range backup.Nodes {
+			if !path.hasRouter(r) {
+				detourRouters[r.Id] = r
+			}
+		}
+	}
+
+	failed := map[string]struct{}{}
+	for routerId, r := range detourRouters {
+		msg := newRouteMsg()
+		for _, forwards := range forwardsByBackup {
+			msg.Forwards = append(msg.Forwards, forwards[routerId]...)
+		}
+		if _, err := sendRoute(r, msg, network.options.RouteTimeout); err != nil {
+			log.WithField("routerId", routerId).WithError(err).Warn("error routing backup path")
+			failed[routerId] = struct{}{}
+		}
+	}
+
+	var routed []*BackupPath
+	var routedForwards []map[string][]*ctrl_pb.Route_Forward
+	for i, backup := range backups {
+		usable := true
+		for _, r := range backup.Nodes {
+			if _, isFailed := failed[r.Id]; isFailed {
+				usable = false
+			}
+		}
+		if usable {
+			routed = append(routed, backup)
+			routedForwards = append(routedForwards, forwardsByBackup[i])
+		}
+	}
+
+	for _, r := range path.Nodes {
+		msg := newRouteMsg()
+		for _, forwards := range routedForwards {
+			msg.Forwards = append(msg.Forwards, forwards[r.Id]...)
+		}
+		if _, err := sendRoute(r, msg, network.options.RouteTimeout); err != nil {
+			log.WithField("routerId", r.Id).WithError(err).Warn("error sending backup route to router")
+		}
+	}
+
+	previous := circuit.BackupPaths
+	circuit.BackupPaths = routed
+
+	stale := map[string]*Router{}
+	for _, backup := range previous {
+		for _, r := range backup.Nodes {
+			stale[r.Id] = r
+		}
+	}
+	for routerId := range failed {
+		stale[routerId] = detourRouters[routerId]
+	}
+	for routerId, r := range stale {
+		if !circuit.HasRouter(routerId) {
+			if err := sendUnroute(r, circuit.Id, true); err != nil {
+				log.WithField("routerId", routerId).WithError(err).Error("error sending backup path cleanup unroute")
+			}
+		}
+	}
+
+	log.WithField("protectedLinks", len(routed)).WithField("links", len(path.Links)).Debug("routed backup paths")
+}
+
+// CircuitsFailedOver handles a report from a router that it switched circuits to their backup path because the
+// given link failed. The circuits are rerouted over the backup path, so the controller view matches what the
+// routers are doing, and a new set of backup paths is routed.
+func (network *Network) CircuitsFailedOver(r *Router, linkId string, circuitIds []string) {
+	log := pfxlog.Logger().WithField("routerId", r.Id).WithField("linkId", linkId)
+
+	for _, circuitId := range circuitIds {
+		circuit, found := network.GetCircuit(circuitId)
+		if !found {
+			log.WithField("circuitId", circuitId).Debug("failed over circuit not found")
+			continue
+		}
+
+		path := circuit.Path
+		var backup *BackupPath
+		for _, candidate := range circuit.BackupPaths {
+			if candidate.ProtectedLink.Id == linkId && path.usesLink(candidate.ProtectedLink) {
+				backup = candidate
+			}
+		}
+
+		if backup == nil {
+			log.WithField("circuitId", circuitId).Debug("circuit already moved off failed link")
+			continue
+		}
+
+		log.WithField("circuitId", circuitId).Info("circuit failed over to backup path")
+
+		deadline := time.Now().Add(network.options.RouteTimeout)
+		if network.smartReroute(circuit, path.spliceBackup(backup), deadline) {
+			log.WithField("circuitId", circuitId).Warn("error rerouting circuit over backup path")
+		}
+	}
+}
+
+// spliceBackup returns a copy of the path, with the link protected by the given backup path replaced by the detour
+func (self *Path) spliceBackup(backup *BackupPath) *Path {
+	result := &Path{
+		IngressId:            self.IngressId,
+		EgressId:             self.EgressId,
+		InitiatorLocalAddr:   self.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  self.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  self.TerminatorLocalAddr,
+		TerminatorRemoteAddr: self.TerminatorRemoteAddr,
+		constraints:          self.constraints,
+	}
+
+	for i, link := range self.Links {
+		result.Nodes = append(result.Nodes, self.Nodes[i])
+		if link == backup.ProtectedLink {
+			result.Nodes = append(result.Nodes, backup.Nodes[1:len(backup.Nodes)-1]...)
+			result.Links = append(result.Links, backup.Links...)
+		} else {
+			result.Links = append(result.Links, link)
+		}
+	}
+	result.Nodes = append(result.Nodes, self.Nodes[len(self.Nodes)-1])
+	return result
+}
+
+func (self *Circuit) backupsUseLink(l *Link) bool {
+	for _, backup := range self.BackupPaths {
+		if backup.usesLink(l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (network *Network) rerouteBackupPaths(circuit *Circuit, deadline time.Time) {
+	if circuit.Rerouting.CompareAndSwap(false, true) {
+		defer circuit.Rerouting.Store(false)
+		network.routeBackupPaths(circuit, deadline)
+	}
+}
+
+// routeNewCircuitBackupPaths routes the backup paths of a newly created circuit. If the circuit was removed while the
+// backups were being routed, routers which are only on the backup paths wouldn't have been unrouted, so they're
+// cleaned up here.
+func (network *Network) routeNewCircuitBackupPaths(circuit *Circuit) {
+	network.rerouteBackupPaths(circuit, time.Now().Add(network.options.RouteTimeout))
+
+	if _, found := network.circuitController.get(circuit.Id); !found {
+		for _, backup := range circuit.BackupPaths {
+			for _, r := range backup.Nodes {
+				if !circuit.Path.hasRouter(r) {
+					if err := sendUnroute(r, circuit.Id, true); err != nil {
+						pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("routerId", r.Id).
+							WithError(err).Error("error sending backup path cleanup unroute for removed circuit")
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBackupPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+	r4 := entityHelper.addTestRouter()
+
+	addLink := func(id string, src, dst *Router, staticCost int32) *Link {
+		link := newTestLink(id, "tls")
+		link.SetStaticCost(staticCost)
+		link.Src = src
+		link.Dst = dst
+		link.addState(newLinkState(Connected))
+		network.linkController.add(link)
+		return link
+	}
+
+	l0 := addLink("l0", r0, r1, 10)
+	l1 := addLink("l1", r1, r2, 10)
+	l2 := addLink("l2", r0, r3, 30)
+	l3 := addLink("l3", r3, r1, 30)
+
+	nodes, _, err := network.shortestPath(r0, r2)
+	req.NoError(err)
+	path := &Path{Nodes: nodes, IngressId: "ingress", EgressId: "egress"}
+	req.NoError(network.setLinks(path))
+	req.Equal([]*Link{l0, l1}, path.Links)
+
+	// there's no way around l1, so only l0 is protected
+	backups := network.CreateBackupPaths(path)
+	req.Equal(1, len(backups))
+	req.Equal(l0, backups[0].ProtectedLink)
+	req.Equal([]*Router{r0, r3, r1}, backups[0].Nodes)
+	req.Equal([]*Link{l2, l3}, backups[0].Links)
+
+	l4 := addLink("l4", r1, r4, 30)
+	l5 := addLink("l5", r4, r2, 30)
+	backups = network.CreateBackupPaths(path)
+	req.Equal(2, len(backups))
+	req.Equal(l1, backups[1].ProtectedLink)
+	req.Equal([]*Link{l4, l5}, backups[1].Links)
+
+	forwards := path.createBackupForwards(backups[0])
+	req.Equal([]*ctrl_pb.Route_Forward{
+		{SrcAddress: "ingress", DstAddress: "l2", DstType: ctrl_pb.DestType_Link, Secondary: true},
+		{SrcAddress: "l2", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+	}, forwards[r0.Id])
+	req.Equal([]*ctrl_pb.Route_Forward{
+		{SrcAddress: "l2", DstAddress: "l3", DstType: ctrl_pb.DestType_Link},
+		{SrcAddress: "l3", DstAddress: "l2", DstType: ctrl_pb.DestType_Link},
+	}, forwards[r3.Id])
+	req.Equal([]*ctrl_pb.Route_Forward{
+		{SrcAddress: "l1", DstAddress: "l3", DstType: ctrl_pb.DestType_Link, Secondary: true},
+		{SrcAddress: "l3", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+	}, forwards[r1.Id])
+
+	forwards = path.createBackupForwards(backups[1])
+	req.Equal([]*ctrl_pb.Route_Forward{
+		{SrcAddress: "egress", DstAddress: "l5", DstType: ctrl_pb.DestType_Link, Secondary: true},
+		{SrcAddress: "l5", DstAddress: "egress", DstType: ctrl_pb.DestType_End},
+	}, forwards[r2.Id])
+
+	// routers only on backup paths are part of the circuit, so they get cleaned up with it
+	circuit := &Circuit{Path: path, BackupPaths: backups}
+	req.True(circuit.HasRouter(r4.Id))
+	req.Equal([]*Router{r0, r1, r2, r3, r4}, circuit.Routers())
+	req.True(circuit.backupsUseLink(l3))
+	req.False(circuit.backupsUseLink(l0))
+
+	// after failover, the circuit path goes over the detour
+	spliced := path.spliceBackup(backups[0])
+	req.Equal([]*Router{r0, r3, r1, r2}, spliced.Nodes)
+	req.Equal([]*Link{l2, l3, l1}, spliced.Links)
+	req.Equal("ingress", spliced.IngressId)
+	req.Equal("egress", spliced.EgressId)
+}
@@ -0,0 +1,30 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import "github.com/openziti/fabric/common/pb/ctrl_pb"
+
+// supportsHalfClose returns true if every router on the path supports half close. Otherwise the circuit is closed as
+// soon as either peer finishes writing, as routers which don't support half close would never close the circuit.
+func (self *Path) supportsHalfClose() bool {
+	for _, r := range self.Nodes {
+		if !r.HasCapability(ctrl_pb.RouterCapability_HalfClose) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHalfCloseNegotiation(t *testing.T) {
+	req := require.New(t)
+
+	newRouter := func(id string, capabilities ...ctrl_pb.RouterCapability) *Router {
+		r := NewRouter(id, "", "", 0, false)
+		r.SetMetadata(&ctrl_pb.RouterMetadata{Capabilities: capabilities})
+		return r
+	}
+
+	r0 := newRouter("r0", ctrl_pb.RouterCapability_LinkManagement, ctrl_pb.RouterCapability_HalfClose)
+	r1 := newRouter("r1", ctrl_pb.RouterCapability_HalfClose)
+	r2 := newRouter("r2", ctrl_pb.RouterCapability_LinkManagement)
+
+	req.True((&Path{Nodes: []*Router{r0, r1}}).supportsHalfClose())
+
+	// every router on the path has to support half close
+	req.False((&Path{Nodes: []*Router{r0, r2, r1}}).supportsHalfClose())
+}
@@ -20,3 +20,19 @@ type RouterPresenceHandler interface {
 	RouterConnected(r *Router)
 	RouterDisconnected(r *Router)
 }
+
+type TerminatorHealthHandler interface {
+	TerminatorHealthChanged(terminatorId string, healthy bool, err error)
+}
+
+// TerminatorDrainHandler is notified as the number of circuits using a draining terminator changes. When the
+// terminator's drain deadline passes, the handler is notified that the remaining circuits are being force closed.
+type TerminatorDrainHandler interface {
+	TerminatorDrainProgress(terminatorId string, activeCircuits int, forced bool)
+}
+
+// RouterMaintenanceHandler is notified as the number of circuits transiting a router in maintenance changes. Once no
+// circuits transit the router, it's safe to stop.
+type RouterMaintenanceHandler interface {
+	RouterMaintenanceProgress(r *Router, transitCircuits int)
+}
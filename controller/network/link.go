@@ -24,19 +24,25 @@ import (
 )
 
 type Link struct {
-	SrcLatency  int64
-	DstLatency  int64
-	Cost        int64
-	Id          string
-	Src         *Router
-	Dst         *Router
-	Protocol    string
-	DialAddress string
-	state       []*LinkState
-	down        bool
-	StaticCost  int32
-	usable      atomic.Bool
-	lock        sync.Mutex
+	SrcLatency   int64
+	DstLatency   int64
+	Cost         int64
+	QualityCost  int64
+	Id           string
+	Src          *Router
+	Dst          *Router
+	Protocol     string
+	DialAddress  string
+	state        []*LinkState
+	down         bool
+	StaticCost   int32
+	usable       atomic.Bool
+	lock         sync.Mutex
+	routingTable atomic.Pointer[routingTable]
+	costTags     atomic.Pointer[[]string]
+	costPolicy   *atomic.Pointer[linkCostPolicy]
+	srcQuality   atomic.Pointer[LinkQuality]
+	dstQuality   atomic.Pointer[LinkQuality]
 }
 
 func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration) *Link {
@@ -93,12 +99,11 @@ func (link *Link) IsDown() bool {
 }
 
 func (link *Link) recalculateUsable() {
-	if link.down {
-		link.usable.Store(false)
-	} else if len(link.state) < 1 || link.state[0].Mode != Connected {
-		link.usable.Store(false)
-	} else {
-		link.usable.Store(true)
+	usable := !link.down && len(link.state) > 0 && link.state[0].Mode == Connected
+	if link.usable.Swap(usable) != usable {
+		if rt := link.routingTable.Load(); rt != nil {
+			rt.linkUsableChanged(link, usable)
+		}
 	}
 }
 
@@ -133,9 +138,57 @@ func (link *Link) SetDstLatency(latency int64) {
 	link.recalculateCost()
 }
 
+func (link *Link) GetQualityCost() int64 {
+	return atomic.LoadInt64(&link.QualityCost)
+}
+
+func (link *Link) GetSrcQuality() *LinkQuality {
+	return link.srcQuality.Load()
+}
+
+func (link *Link) GetDstQuality() *LinkQuality {
+	return link.dstQuality.Load()
+}
+
+// updateQuality records the loss and throughput reported by the router at one end of the link. The quality cost is
+// taken from the worse of the two ends, and is only updated if it changed enough to get past the hysteresis.
+func (link *Link) updateQuality(src bool, quality *LinkQuality, options *LinkQualityOptions) {
+	if src {
+		link.srcQuality.Store(quality)
+	} else {
+		link.dstQuality.Store(quality)
+	}
+
+	computed := link.srcQuality.Load().cost(options)
+	if dstCost := link.dstQuality.Load().cost(options); dstCost > computed {
+		computed = dstCost
+	}
+
+	current := link.GetQualityCost()
+	if updated := applyLinkQualityHysteresis(current, computed, options); updated != current {
+		atomic.StoreInt64(&link.QualityCost, updated)
+		link.recalculateCost()
+	}
+}
+
+// GetCostTags returns the cost tags of the listener the link was dialed on
+func (link *Link) GetCostTags() []string {
+	if costTags := link.costTags.Load(); costTags != nil {
+		return *costTags
+	}
+	return nil
+}
+
 func (link *Link) recalculateCost() {
-	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000
-	atomic.StoreInt64(&link.Cost, cost)
+	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000 + link.GetQualityCost()
+	if link.costPolicy != nil {
+		cost = link.costPolicy.Load().apply(cost, link.GetCostTags())
+	}
+	if oldCost := atomic.SwapInt64(&link.Cost, cost); oldCost != cost {
+		if rt := link.routingTable.Load(); rt != nil {
+			rt.linkCostChanged(link, oldCost, cost)
+		}
+	}
 }
 
 func (link *Link) GetCost() int64 {
@@ -22,33 +22,85 @@ import (
 	"github.com/openziti/foundation/v2/info"
 	"github.com/orcaman/concurrent-map/v2"
 	"math"
+	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type linkController struct {
 	linkTable      *linkTable
+	routingTable   *routingTable
 	idGenerator    idgen.Generator
 	lock           sync.Mutex
 	initialLatency time.Duration
+	costPolicy     atomic.Pointer[linkCostPolicy]
 }
 
 func newLinkController(options *Options) *linkController {
 	initialLatency := DefaultOptionsInitialLinkLatency
+	var minRouterCost uint16
 	if options != nil {
 		initialLatency = options.InitialLinkLatency
+		minRouterCost = options.MinRouterCost
 	}
 	return &linkController{
 		linkTable:      newLinkTable(),
+		routingTable:   newRoutingTable(minRouterCost),
 		idGenerator:    idgen.NewGenerator(),
 		initialLatency: initialLatency,
 	}
 }
 
 func (linkController *linkController) add(link *Link) {
+	costTags := getCostTags(link, link.Dst)
+	link.costTags.Store(&costTags)
+	link.costPolicy = &linkController.costPolicy
+	link.recalculateCost()
 	linkController.linkTable.add(link)
 	link.Src.routerLinks.Add(link, link.Dst)
 	link.Dst.routerLinks.Add(link, link.Src)
+	link.routingTable.Store(linkController.routingTable)
+	if link.IsUsable() {
+		linkController.routingTable.linkImproved(link)
+	}
+}
+
+// getCostTags returns the cost tags of the listener on the destination router which the link was dialed on
+func getCostTags(link *Link, dst *Router) []string {
+	if dst == nil {
+		return nil
+	}
+	for _, listener := range dst.Listeners {
+		if listener.GetAddress() == link.DialAddress {
+			return listener.GetCostTags()
+		}
+	}
+	return nil
+}
+
+// refreshCostTags updates the cost tags of links dialed to the given router and recalculates their costs. The router
+// reports its listeners, and so its cost tags, each time it connects, so links which outlived the previous connection
+// may be using stale tags.
+func (linkController *linkController) refreshCostTags(router *Router) {
+	for _, link := range linkController.all() {
+		if link.Dst == nil || link.Dst.Id != router.Id {
+			continue
+		}
+		costTags := getCostTags(link, router)
+		if !reflect.DeepEqual(costTags, link.GetCostTags()) {
+			link.costTags.Store(&costTags)
+			link.recalculateCost()
+		}
+	}
+}
+
+// setCostPolicy replaces the link cost policy and recalculates the cost of all known links
+func (linkController *linkController) setCostPolicy(policy *linkCostPolicy) {
+	linkController.costPolicy.Store(policy)
+	for _, link := range linkController.all() {
+		link.recalculateCost()
+	}
 }
 
 func (linkController *linkController) has(link *Link) bool {
@@ -83,6 +135,8 @@ func (linkController *linkController) remove(link *Link) {
 	linkController.linkTable.remove(link)
 	link.Src.routerLinks.Remove(link, link.Dst)
 	link.Dst.routerLinks.Remove(link, link.Src)
+	link.routingTable.Store(nil)
+	linkController.routingTable.linkWorsened(link)
 }
 
 func (linkController *linkController) connectedNeighborsOfRouter(router *Router) []*Router {
@@ -108,13 +162,19 @@ func (linkController *linkController) connectedNeighborsOfRouter(router *Router)
 }
 
 func (linkController *linkController) leastExpensiveLink(a, b *Router) (*Link, bool) {
+	return linkController.leastExpensiveLinkMatching(a, b, nil)
+}
+
+// leastExpensiveLinkMatching returns the cheapest usable link between the two routers which is accepted by the
+// given filter. A nil filter accepts all links.
+func (linkController *linkController) leastExpensiveLinkMatching(a, b *Router, filter func(*Link) bool) (*Link, bool) {
 	var selected *Link
 	var cost int64 = math.MaxInt64
 
 	linksByRouter := a.routerLinks.GetLinksByRouter()
 	links := linksByRouter[b.Id]
 	for _, link := range links {
-		if link.IsUsable() {
+		if link.IsUsable() && (filter == nil || filter(link)) {
 			linkCost := link.GetCost()
 			if link.Dst == b {
 				if linkCost < cost {
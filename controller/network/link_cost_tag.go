@@ -0,0 +1,204 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/common/pb/cmd_pb"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/command"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"math"
+	"reflect"
+)
+
+type LinkCostTag struct {
+	models.BaseEntity
+	Name           string
+	AdditiveCost   int64
+	CostMultiplier float64
+}
+
+func (entity *LinkCostTag) GetName() string {
+	return entity.Name
+}
+
+func (entity *LinkCostTag) toBolt() *db.LinkCostTag {
+	return &db.LinkCostTag{
+		BaseExtEntity:  *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:           entity.Name,
+		AdditiveCost:   entity.AdditiveCost,
+		CostMultiplier: entity.CostMultiplier,
+	}
+}
+
+// linkCostPolicy maps link cost tags to the adjustments applied to the cost of links carrying those tags
+type linkCostPolicy struct {
+	tags map[string]*LinkCostTag
+}
+
+func (self *linkCostPolicy) apply(cost int64, costTags []string) int64 {
+	if self == nil || len(self.tags) == 0 || len(costTags) == 0 {
+		return cost
+	}
+
+	multiplier := 1.0
+	var additive int64
+	for _, costTag := range costTags {
+		if adjustment, found := self.tags[costTag]; found {
+			multiplier *= adjustment.CostMultiplier
+			additive += adjustment.AdditiveCost
+		}
+	}
+
+	adjusted := float64(cost)*multiplier + float64(additive)
+	if adjusted > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int64(math.Round(adjusted))
+}
+
+func newLinkCostTagManager(managers *Managers) *LinkCostTagManager {
+	result := &LinkCostTagManager{
+		baseEntityManager: newBaseEntityManager[*LinkCostTag, *db.LinkCostTag](managers, managers.stores.LinkCostTag, func() *LinkCostTag {
+			return &LinkCostTag{}
+		}),
+		store: managers.stores.LinkCostTag,
+	}
+	result.populateEntity = result.populateLinkCostTag
+
+	managers.stores.LinkCostTag.AddEntityIdListener(result.costTagChanged, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+
+	return result
+}
+
+type LinkCostTagManager struct {
+	baseEntityManager[*LinkCostTag, *db.LinkCostTag]
+	store db.LinkCostTagStore
+}
+
+func (self *LinkCostTagManager) Create(entity *LinkCostTag, ctx *change.Context) error {
+	return DispatchCreate[*LinkCostTag](self, entity, ctx)
+}
+
+func (self *LinkCostTagManager) ApplyCreate(cmd *command.CreateEntityCommand[*LinkCostTag], ctx boltz.MutateContext) error {
+	return self.db.Update(ctx, func(ctx boltz.MutateContext) error {
+		if err := self.ValidateNameOnCreate(ctx.Tx(), cmd.Entity); err != nil {
+			return err
+		}
+		return self.store.Create(ctx, cmd.Entity.toBolt())
+	})
+}
+
+func (self *LinkCostTagManager) Update(entity *LinkCostTag, updatedFields fields.UpdatedFields, ctx *change.Context) error {
+	return DispatchUpdate[*LinkCostTag](self, entity, updatedFields, ctx)
+}
+
+func (self *LinkCostTagManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*LinkCostTag], ctx boltz.MutateContext) error {
+	return self.updateGeneral(ctx, cmd.Entity, cmd.UpdatedFields)
+}
+
+func (self *LinkCostTagManager) Read(id string) (*LinkCostTag, error) {
+	entity := &LinkCostTag{}
+	if err := self.readEntity(id, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+func (self *LinkCostTagManager) populateLinkCostTag(entity *LinkCostTag, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltLinkCostTag, ok := boltEntity.(*db.LinkCostTag)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model link cost tag", reflect.TypeOf(boltEntity))
+	}
+	entity.Name = boltLinkCostTag.Name
+	entity.AdditiveCost = boltLinkCostTag.AdditiveCost
+	entity.CostMultiplier = boltLinkCostTag.CostMultiplier
+	entity.FillCommon(boltLinkCostTag)
+	return nil
+}
+
+func (self *LinkCostTagManager) costTagChanged(string) {
+	self.loadCostPolicy()
+}
+
+// loadCostPolicy reads all link cost tags and applies them to the link costs. Cost tags are expected to be few, so
+// the whole policy is rebuilt on any change.
+func (self *LinkCostTagManager) loadCostPolicy() {
+	policy := &linkCostPolicy{
+		tags: map[string]*LinkCostTag{},
+	}
+
+	err := self.db.View(func(tx *bbolt.Tx) error {
+		for cursor := self.store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			entity := &LinkCostTag{}
+			if err := self.readEntityInTx(tx, string(cursor.Current()), entity); err != nil {
+				return err
+			}
+			policy.tags[entity.Name] = entity
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to load link cost tags, link cost policy not updated")
+		return
+	}
+
+	self.network.linkController.setCostPolicy(policy)
+}
+
+func (self *LinkCostTagManager) Marshall(entity *LinkCostTag) ([]byte, error) {
+	tags, err := cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &cmd_pb.LinkCostTag{
+		Id:             entity.Id,
+		Name:           entity.Name,
+		Tags:           tags,
+		AdditiveCost:   entity.AdditiveCost,
+		CostMultiplier: entity.CostMultiplier,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *LinkCostTagManager) Unmarshall(bytes []byte) (*LinkCostTag, error) {
+	msg := &cmd_pb.LinkCostTag{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &LinkCostTag{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: cmd_pb.DecodeTags(msg.Tags),
+		},
+		Name:           msg.Name,
+		AdditiveCost:   msg.AdditiveCost,
+		CostMultiplier: msg.CostMultiplier,
+	}, nil
+}
@@ -0,0 +1,147 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkCostPolicy(t *testing.T) {
+	req := require.New(t)
+
+	policy := &linkCostPolicy{
+		tags: map[string]*LinkCostTag{
+			"metered":   {Name: "metered", CostMultiplier: 1.5},
+			"satellite": {Name: "satellite", CostMultiplier: 2, AdditiveCost: 100},
+		},
+	}
+
+	req.Equal(int64(10), policy.apply(10, nil))
+	req.Equal(int64(10), policy.apply(10, []string{"unknown"}))
+	req.Equal(int64(15), policy.apply(10, []string{"metered"}))
+	req.Equal(int64(120), policy.apply(10, []string{"satellite"}))
+	req.Equal(int64(130), policy.apply(10, []string{"metered", "satellite"}))
+
+	var nilPolicy *linkCostPolicy
+	req.Equal(int64(10), nilPolicy.apply(10, []string{"metered"}))
+}
+
+func TestLinkCostTagsInfluencePaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 1, false)
+	r1.AddLinkListener("tcp:localhost:1234", "tls", []string{"satellite"}, nil)
+	network.Routers.markConnected(r1)
+
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r2)
+
+	r3 := newRouterForTest("r3", "", transportAddr, nil, 1, false)
+	network.Routers.markConnected(r3)
+
+	addLink := func(id string, src, dst *Router, staticCost int32) *Link {
+		link := newTestLink(id, "tls")
+		link.SetStaticCost(staticCost)
+		link.Src = src
+		link.Dst = dst
+		link.addState(newLinkState(Connected))
+		network.linkController.add(link)
+		return link
+	}
+
+	l0 := addLink("l0", r0, r1, 10)
+	addLink("l1", r1, r3, 10)
+	addLink("l2", r0, r2, 20)
+	addLink("l3", r2, r3, 20)
+
+	req.Equal([]string{"satellite"}, l0.GetCostTags())
+	req.Equal(int64(10), l0.Cost)
+
+	path, _, err := network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+
+	costTag := &LinkCostTag{
+		Name:           "satellite",
+		AdditiveCost:   5,
+		CostMultiplier: 2,
+	}
+	req.NoError(network.Managers.LinkCostTags.Create(costTag, change.New()))
+	req.Equal(int64(25), l0.Cost)
+
+	path, _, err = network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+
+	costTag.AdditiveCost = 50
+	req.NoError(network.Managers.LinkCostTags.Update(costTag, fields.UpdatedFieldsMap{db.FieldLinkCostTagAdditiveCost: struct{}{}}, change.New()))
+	req.Equal(int64(70), l0.Cost)
+
+	path, _, err = network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, path)
+
+	// links added after the policy was loaded also get adjusted costs
+	l4 := addLink("l4", r2, r1, 1)
+	req.Equal(int64(52), l4.Cost)
+
+	req.NoError(network.Managers.LinkCostTags.Delete(costTag.Id, change.New()))
+	req.Equal(int64(10), l0.Cost)
+	req.Equal(int64(1), l4.Cost)
+
+	path, _, err = network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+
+	// cost tags follow the listeners reported when the router reconnects
+	costTag = &LinkCostTag{
+		Name:         "metered",
+		AdditiveCost: 100,
+	}
+	req.NoError(network.Managers.LinkCostTags.Create(costTag, change.New()))
+	req.Equal(int64(10), l0.Cost)
+
+	r1 = newRouterForTest("r1", "", transportAddr, nil, 1, false)
+	r1.AddLinkListener("tcp:localhost:1234", "tls", []string{"metered"}, nil)
+	network.Routers.markConnected(r1)
+	network.linkController.refreshCostTags(r1)
+
+	req.Equal([]string{"metered"}, l0.GetCostTags())
+	req.Equal(int64(110), l0.Cost)
+	req.Equal(int64(101), l4.Cost)
+}
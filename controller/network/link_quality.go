@@ -0,0 +1,98 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/metrics/metrics_pb"
+	"math"
+)
+
+const maxLinkQualityCost = math.MaxUint16
+
+// LinkQuality holds the loss and throughput measurements reported by the router at one end of a link, for the
+// traffic it sends over the link. Rates are per second.
+type LinkQuality struct {
+	RetransmitRate   float64
+	DuplicateAckRate float64
+	TxMsgRate        float64
+	TxBytesRate      float64
+}
+
+func getLinkQuality(msg *metrics_pb.MetricsMessage, linkId string) (*LinkQuality, bool) {
+	prefix := "link." + linkId
+	txMsgRate, found := msg.Meters[prefix+".tx.msgrate"]
+	if !found {
+		return nil, false
+	}
+
+	result := &LinkQuality{
+		TxMsgRate: txMsgRate.M1Rate,
+	}
+	if txBytesRate, found := msg.Meters[prefix+".tx.bytesrate"]; found {
+		result.TxBytesRate = txBytesRate.M1Rate
+	}
+	if retransmits, found := msg.Meters[prefix+".retransmits"]; found {
+		result.RetransmitRate = retransmits.M1Rate
+	}
+	if duplicateAcks, found := msg.Meters[prefix+".dup_acks"]; found {
+		result.DuplicateAckRate = duplicateAcks.M1Rate
+	}
+	return result, true
+}
+
+// cost computes the cost contribution of the measured loss. Loss is measured as the fraction of sent messages
+// which were retransmits or triggered duplicate acks. Measurements taken with little traffic on the link are less
+// reliable, so they're scaled down if throughput is below the configured minimum.
+func (self *LinkQuality) cost(options *LinkQualityOptions) int64 {
+	if self == nil || self.TxMsgRate <= 0 {
+		return 0
+	}
+
+	retransmitRatio := math.Min(1, self.RetransmitRate/self.TxMsgRate)
+	duplicateAckRatio := math.Min(1, self.DuplicateAckRate/self.TxMsgRate)
+	cost := retransmitRatio*options.RetransmitCostFactor + duplicateAckRatio*options.DuplicateAckCostFactor
+
+	if options.MinThroughput > 0 && self.TxBytesRate < options.MinThroughput {
+		cost *= self.TxBytesRate / options.MinThroughput
+	}
+
+	return int64(math.Min(math.Round(cost), maxLinkQualityCost))
+}
+
+// applyLinkQualityHysteresis returns the quality cost which should be used, given the current and newly computed
+// cost. Small changes are ignored so that link costs, and the circuits routed over them, don't flap. A link which has
+// become fully healthy always has its quality cost cleared, so a small penalty can't be left behind indefinitely.
+func applyLinkQualityHysteresis(current, computed int64, options *LinkQualityOptions) int64 {
+	if computed == 0 {
+		return 0
+	}
+
+	delta := computed - current
+	if delta < 0 {
+		delta = -delta
+	}
+
+	threshold := int64(math.Ceil(float64(current) * options.Hysteresis))
+	if minDelta := int64(options.MinCostDelta); threshold < minDelta {
+		threshold = minDelta
+	}
+
+	if delta == 0 || delta < threshold {
+		return current
+	}
+	return computed
+}
@@ -0,0 +1,130 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkQualityCost(t *testing.T) {
+	req := require.New(t)
+	options := &DefaultOptions().LinkQuality
+
+	quality := &LinkQuality{
+		RetransmitRate: 10,
+		TxMsgRate:      1000,
+		TxBytesRate:    1_000_000,
+	}
+	req.Equal(int64(50), quality.cost(options))
+
+	quality.DuplicateAckRate = 10
+	req.Equal(int64(60), quality.cost(options))
+
+	// measurements with little traffic are scaled down
+	quality.TxBytesRate = options.MinThroughput / 2
+	req.Equal(int64(30), quality.cost(options))
+
+	// no traffic means no measurement
+	req.Equal(int64(0), (&LinkQuality{RetransmitRate: 10}).cost(options))
+
+	// small changes are ignored, as are changes within the hysteresis band
+	req.Equal(int64(0), applyLinkQualityHysteresis(0, 4, options))
+	req.Equal(int64(5), applyLinkQualityHysteresis(0, 5, options))
+	req.Equal(int64(100), applyLinkQualityHysteresis(100, 80, options))
+	req.Equal(int64(100), applyLinkQualityHysteresis(100, 120, options))
+	req.Equal(int64(125), applyLinkQualityHysteresis(100, 125, options))
+	req.Equal(int64(0), applyLinkQualityHysteresis(100, 0, options))
+	req.Equal(int64(0), applyLinkQualityHysteresis(3, 0, options))
+}
+
+func TestLossyLinksAvoided(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+
+	addLink := func(id string, src, dst *Router, staticCost int32) *Link {
+		link := newTestLink(id, "tls")
+		link.SetStaticCost(staticCost)
+		link.Src = src
+		link.Dst = dst
+		link.addState(newLinkState(Connected))
+		network.linkController.add(link)
+		return link
+	}
+
+	l0 := addLink("l0", r0, r1, 10)
+	addLink("l1", r1, r3, 10)
+	addLink("l2", r0, r2, 30)
+	addLink("l3", r2, r3, 30)
+
+	path, _, err := network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+
+	reportLinkMetrics := func(router *Router, linkId string, retransmitRate float64) {
+		network.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+			SourceId: router.Id,
+			Meters: map[string]*metrics_pb.MetricsMessage_Meter{
+				"link." + linkId + ".tx.msgrate":   {M1Rate: 1000},
+				"link." + linkId + ".tx.bytesrate": {M1Rate: 1_000_000},
+				"link." + linkId + ".retransmits":  {M1Rate: retransmitRate},
+			},
+		})
+	}
+
+	// 2% retransmits over a link which is otherwise cheapest
+	reportLinkMetrics(r0, "l0", 20)
+	req.Equal(int64(100), l0.GetQualityCost())
+	req.Equal(int64(110), l0.GetCost())
+
+	path, _, err = network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, path)
+
+	// the far end of the link doesn't see any loss, the worse end is used
+	reportLinkMetrics(r1, "l0", 0)
+	req.Equal(int64(100), l0.GetQualityCost())
+
+	// slight improvement doesn't change the cost
+	reportLinkMetrics(r0, "l0", 17)
+	req.Equal(int64(100), l0.GetQualityCost())
+
+	// once loss goes away, the link is preferred again
+	reportLinkMetrics(r0, "l0", 0)
+	req.Equal(int64(0), l0.GetQualityCost())
+
+	path, _, err = network.shortestPath(r0, r3)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r3}, path)
+}
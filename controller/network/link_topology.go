@@ -0,0 +1,267 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// LinkTopologyFullMesh links every router to every other router
+	LinkTopologyFullMesh = "fullMesh"
+
+	// LinkTopologyHubAndSpoke links hub routers to every router. Routers which aren't hubs only link to hubs.
+	LinkTopologyHubAndSpoke = "hubAndSpoke"
+
+	// LinkTopologyRegional links routers in the same region to each other. Across regions, only hubs are linked.
+	LinkTopologyRegional = "regional"
+)
+
+var linkTopologyModes = []string{LinkTopologyFullMesh, LinkTopologyHubAndSpoke, LinkTopologyRegional}
+
+func isValidLinkTopologyMode(mode string) bool {
+	for _, validMode := range linkTopologyModes {
+		if mode == validMode {
+			return true
+		}
+	}
+	return false
+}
+
+// LinkTopologyOptions control which pairs of routers the controller tells to link to each other. Hubs are the
+// routers matching any of the hub selectors, which use the same router selector format as path constraints. The
+// region of a router is its region attribute, or the value of its region tag if the attribute isn't set. If max degree
+// is set, routers which aren't hubs are limited to that many peers.
+type LinkTopologyOptions struct {
+	Mode         string
+	HubSelectors []string
+	RegionTag    string
+	MaxDegree    uint32
+}
+
+func (self *LinkTopologyOptions) isFullMesh() bool {
+	return (self.Mode == "" || self.Mode == LinkTopologyFullMesh) && self.MaxDegree == 0
+}
+
+func (self *LinkTopologyOptions) isHub(r *Router) bool {
+	for _, selector := range self.HubSelectors {
+		if r.MatchesSelector(selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func (self *LinkTopologyOptions) getRegion(r *Router) string {
+	if region := r.GetRegion(); region != "" {
+		return region
+	}
+	if region, found := r.getSelectorTags()[self.RegionTag]; found {
+		return fmt.Sprintf("%v", region)
+	}
+	return ""
+}
+
+// linkTopology is the set of router pairs which should be linked. A nil linkTopology allows every pair.
+type linkTopology struct {
+	peers map[string]map[string]struct{}
+}
+
+func (self *linkTopology) isLinkAllowed(routerId, peerId string) bool {
+	if self == nil {
+		return true
+	}
+	_, found := self.peers[routerId][peerId]
+	return found
+}
+
+func (self *linkTopology) getDegree(routerId string) int {
+	if self == nil {
+		return 0
+	}
+	return len(self.peers[routerId])
+}
+
+func (self *linkTopology) add(routerId, peerId string) {
+	if self.peers[routerId] == nil {
+		self.peers[routerId] = map[string]struct{}{}
+	}
+	if self.peers[peerId] == nil {
+		self.peers[peerId] = map[string]struct{}{}
+	}
+	self.peers[routerId][peerId] = struct{}{}
+	self.peers[peerId][routerId] = struct{}{}
+}
+
+// remove drops all pairs including the given router and returns the peers it was paired with
+func (self *linkTopology) remove(routerId string) []string {
+	var result []string
+	for peerId := range self.peers[routerId] {
+		delete(self.peers[peerId], routerId)
+		result = append(result, peerId)
+	}
+	delete(self.peers, routerId)
+	sort.Strings(result)
+	return result
+}
+
+func (self *linkTopology) clone() *linkTopology {
+	result := &linkTopology{peers: map[string]map[string]struct{}{}}
+	for routerId, peers := range self.peers {
+		result.peers[routerId] = map[string]struct{}{}
+		for peerId := range peers {
+			result.peers[routerId][peerId] = struct{}{}
+		}
+	}
+	return result
+}
+
+func (self *linkTopology) hasCapacity(options *LinkTopologyOptions, r *Router) bool {
+	return options.MaxDegree == 0 || options.isHub(r) || uint32(self.getDegree(r.Id)) < options.MaxDegree
+}
+
+// getPairPriority returns whether the given routers may be linked, and if so, the priority of the pair when limiting
+// degree. Links between hubs come first, then links to hubs, then other links.
+func (self *LinkTopologyOptions) getPairPriority(a, b *Router) (int, bool) {
+	aIsHub, bIsHub := self.isHub(a), self.isHub(b)
+
+	switch self.Mode {
+	case LinkTopologyHubAndSpoke:
+		if !aIsHub && !bIsHub {
+			return 0, false
+		}
+	case LinkTopologyRegional:
+		if !(aIsHub && bIsHub) && self.getRegion(a) != self.getRegion(b) {
+			return 0, false
+		}
+	}
+
+	if aIsHub && bIsHub {
+		return 0, true
+	}
+	if aIsHub || bIsHub {
+		return 1, true
+	}
+	return 2, true
+}
+
+// pairRouter adds pairs between the given router and the other routers which it isn't yet paired with, in priority
+// order, as long as both sides have capacity. Existing pairs are never dropped to make room.
+func (self *linkTopology) pairRouter(options *LinkTopologyOptions, r *Router, routers []*Router) {
+	type candidate struct {
+		peer     *Router
+		priority int
+	}
+
+	var candidates []candidate
+	for _, peer := range routers {
+		if peer.Id == r.Id || self.isLinkAllowed(r.Id, peer.Id) {
+			continue
+		}
+		if priority, allowed := options.getPairPriority(r, peer); allowed {
+			candidates = append(candidates, candidate{peer: peer, priority: priority})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].priority != candidates[j].priority {
+			return candidates[i].priority < candidates[j].priority
+		}
+		return candidates[i].peer.Id < candidates[j].peer.Id
+	})
+
+	for _, c := range candidates {
+		if self.hasCapacity(options, r) && self.hasCapacity(options, c.peer) {
+			self.add(r.Id, c.peer.Id)
+		}
+	}
+}
+
+// computeLinkTopology returns the pairs of the given routers which should be linked. The result only depends on the
+// options and the set of routers, so it's stable as long as they don't change. When limiting degree, links between
+// hubs are kept in preference to links to hubs, which are kept in preference to other links.
+func computeLinkTopology(options *LinkTopologyOptions, routers []*Router) *linkTopology {
+	if options.isFullMesh() {
+		return nil
+	}
+
+	sorted := append([]*Router(nil), routers...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	type candidate struct {
+		a, b     *Router
+		priority int
+	}
+
+	var candidates []candidate
+	for i, a := range sorted {
+		for _, b := range sorted[i+1:] {
+			if priority, allowed := options.getPairPriority(a, b); allowed {
+				candidates = append(candidates, candidate{a: a, b: b, priority: priority})
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].priority < candidates[j].priority
+	})
+
+	result := &linkTopology{peers: map[string]map[string]struct{}{}}
+	for _, c := range candidates {
+		if result.hasCapacity(options, c.a) && result.hasCapacity(options, c.b) {
+			result.add(c.a.Id, c.b.Id)
+		}
+	}
+
+	return result
+}
+
+// updateLinkTopology returns a copy of the topology, updated for a change to a single router. The pairs of the changed
+// router are recalculated, and routers which lost a pair to it may pick up new pairs with their freed capacity. Pairs
+// between other routers are kept, so routers which are already linked aren't disturbed by unrelated changes.
+func updateLinkTopology(options *LinkTopologyOptions, topology *linkTopology, routerId string, routers []*Router) *linkTopology {
+	if options.isFullMesh() {
+		return nil
+	}
+
+	if topology == nil {
+		return computeLinkTopology(options, routers)
+	}
+
+	result := topology.clone()
+	previousPeers := result.remove(routerId)
+
+	routersById := map[string]*Router{}
+	for _, r := range routers {
+		routersById[r.Id] = r
+	}
+
+	if r, found := routersById[routerId]; found {
+		result.pairRouter(options, r, routers)
+	}
+
+	for _, peerId := range previousPeers {
+		if peer, found := routersById[peerId]; found && !result.isLinkAllowed(routerId, peerId) {
+			result.pairRouter(options, peer, routers)
+		}
+	}
+
+	return result
+}
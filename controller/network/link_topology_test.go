@@ -0,0 +1,185 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/stretchr/testify/require"
+)
+
+func newTopologyTestRouter(id string, tags map[string]interface{}) *Router {
+	r := newRouterForTest(id, "", nil, nil, 0, false)
+	r.Tags = tags
+	return r
+}
+
+func TestLinkTopology(t *testing.T) {
+	req := require.New(t)
+
+	hubEast := newTopologyTestRouter("hub-east", map[string]interface{}{"region": "east", "hub": true})
+	hubWest := newTopologyTestRouter("hub-west", map[string]interface{}{"region": "west", "hub": true})
+	east1 := newTopologyTestRouter("east-1", map[string]interface{}{"region": "east"})
+	east2 := newTopologyTestRouter("east-2", map[string]interface{}{"region": "east"})
+	west1 := newTopologyTestRouter("west-1", map[string]interface{}{"region": "west"})
+	routers := []*Router{hubEast, hubWest, east1, east2, west1}
+
+	options := DefaultOptions().LinkTopology
+	req.Nil(computeLinkTopology(&options, routers))
+	req.True((*linkTopology)(nil).isLinkAllowed(east1.Id, west1.Id))
+
+	options.Mode = LinkTopologyHubAndSpoke
+	options.HubSelectors = []string{"hub"}
+	topology := computeLinkTopology(&options, routers)
+	req.True(topology.isLinkAllowed(hubEast.Id, hubWest.Id))
+	req.True(topology.isLinkAllowed(east1.Id, hubWest.Id))
+	req.True(topology.isLinkAllowed(hubWest.Id, east1.Id))
+	req.False(topology.isLinkAllowed(east1.Id, east2.Id))
+	req.Equal(2, topology.getDegree(west1.Id))
+
+	options.Mode = LinkTopologyRegional
+	topology = computeLinkTopology(&options, routers)
+	req.True(topology.isLinkAllowed(hubEast.Id, hubWest.Id))
+	req.True(topology.isLinkAllowed(east1.Id, east2.Id))
+	req.True(topology.isLinkAllowed(east1.Id, hubEast.Id))
+	req.True(topology.isLinkAllowed(west1.Id, hubWest.Id))
+	req.False(topology.isLinkAllowed(east1.Id, hubWest.Id))
+	req.False(topology.isLinkAllowed(east1.Id, west1.Id))
+
+	// links to hubs are kept in preference to other links, and hubs aren't limited
+	options.Mode = LinkTopologyFullMesh
+	options.MaxDegree = 2
+	topology = computeLinkTopology(&options, routers)
+	for _, r := range []*Router{east1, east2, west1} {
+		req.Equal(2, topology.getDegree(r.Id))
+		req.True(topology.isLinkAllowed(r.Id, hubEast.Id))
+		req.True(topology.isLinkAllowed(r.Id, hubWest.Id))
+	}
+	req.Equal(4, topology.getDegree(hubEast.Id))
+
+	// without hubs, every router is limited
+	options.HubSelectors = nil
+	topology = computeLinkTopology(&options, routers)
+	for _, r := range routers {
+		req.LessOrEqual(topology.getDegree(r.Id), 2)
+	}
+}
+
+func TestUpdateLinkTopology(t *testing.T) {
+	req := require.New(t)
+
+	hub1 := newTopologyTestRouter("hub-1", map[string]interface{}{"hub": true})
+	hub2 := newTopologyTestRouter("hub-2", map[string]interface{}{"hub": true})
+	spoke1 := newTopologyTestRouter("spoke-1", nil)
+	spoke2 := newTopologyTestRouter("spoke-2", nil)
+
+	options := DefaultOptions().LinkTopology
+	options.Mode = LinkTopologyHubAndSpoke
+	options.HubSelectors = []string{"hub"}
+	options.MaxDegree = 1
+
+	topology := updateLinkTopology(&options, nil, hub1.Id, []*Router{hub1, spoke1, spoke2})
+	req.True(topology.isLinkAllowed(spoke1.Id, hub1.Id))
+	req.True(topology.isLinkAllowed(spoke2.Id, hub1.Id))
+
+	// existing pairs are kept when a router connects, even though a full computation might pick differently
+	updated := updateLinkTopology(&options, topology, hub2.Id, []*Router{hub1, hub2, spoke1, spoke2})
+	req.True(updated.isLinkAllowed(hub1.Id, hub2.Id))
+	req.True(updated.isLinkAllowed(spoke1.Id, hub1.Id))
+	req.True(updated.isLinkAllowed(spoke2.Id, hub1.Id))
+	req.False(updated.isLinkAllowed(spoke1.Id, hub2.Id))
+
+	// the previous topology isn't modified
+	req.False(topology.isLinkAllowed(hub1.Id, hub2.Id))
+
+	// spokes which lose their hub pick up a new one
+	updated = updateLinkTopology(&options, updated, hub1.Id, []*Router{hub2, spoke1, spoke2})
+	req.Equal(0, updated.getDegree(hub1.Id))
+	req.True(updated.isLinkAllowed(spoke1.Id, hub2.Id))
+	req.True(updated.isLinkAllowed(spoke2.Id, hub2.Id))
+}
+
+func TestLinkTopologyFollowsRouterEdits(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	network.options.LinkTopology.Mode = LinkTopologyRegional
+	network.options.LinkTopology.HubSelectors = []string{"hub"}
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+
+	setRegion := func(r *Router, region string) {
+		update := newRouterForTest(r.Id, r.Name, nil, nil, 0, false)
+		update.Region = region
+		ctx.NoError(network.Routers.Update(update, fields.UpdatedFieldsMap{db.FieldRouterRegion: struct{}{}}, change.New()))
+	}
+
+	routerMessaging := network.Managers.RouterMessaging
+	setRegion(r0, "east")
+	setRegion(r1, "east")
+	ctx.Eventually(func() bool {
+		return routerMessaging.linkTopology.Load() != nil && routerMessaging.IsLinkAllowed(r0.Id, r1.Id)
+	}, time.Second, 10*time.Millisecond)
+
+	// moving a router to another region disallows its existing links, without waiting for it to reconnect
+	setRegion(r1, "west")
+	ctx.Eventually(func() bool {
+		return !routerMessaging.IsLinkAllowed(r0.Id, r1.Id)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestLoadLinkTopologyOptions(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(map[interface{}]interface{}{
+		"linkTopology": map[interface{}]interface{}{
+			"mode":      "regional",
+			"hubs":      []interface{}{"role=hub"},
+			"regionTag": "site",
+			"maxDegree": 10,
+		},
+	})
+	req.NoError(err)
+	req.Equal(LinkTopologyOptions{
+		Mode:         LinkTopologyRegional,
+		HubSelectors: []string{"role=hub"},
+		RegionTag:    "site",
+		MaxDegree:    10,
+	}, options.LinkTopology)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"linkTopology": map[interface{}]interface{}{"mode": "star"},
+	})
+	req.Error(err)
+
+	_, err = LoadOptions(map[interface{}]interface{}{
+		"linkTopology": map[interface{}]interface{}{"mode": "hubAndSpoke"},
+	})
+	req.Error(err)
+}
@@ -46,6 +46,7 @@ type Managers struct {
 	Terminators     *TerminatorManager
 	Routers         *RouterManager
 	Services        *ServiceManager
+	LinkCostTags    *LinkCostTagManager
 	Inspections     *InspectionsManager
 	Command         *CommandManager
 	Dispatcher      command.Dispatcher
@@ -165,6 +166,7 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	result.Terminators = newTerminatorManager(result)
 	result.Routers = newRouterManager(result)
 	result.Services = newServiceManager(result)
+	result.LinkCostTags = newLinkCostTagManager(result)
 	result.Inspections = NewInspectionsManager(network)
 	if result.Dispatcher == nil {
 		devVersion := versions.MustParseSemVer("0.0.0")
@@ -180,6 +182,7 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	RegisterManagerDecoder[*Service](result, result.Services)
 	RegisterManagerDecoder[*Router](result, result.Routers)
 	RegisterManagerDecoder[*Terminator](result, result.Terminators)
+	RegisterManagerDecoder[*LinkCostTag](result, result.LinkCostTags)
 	RegisterCommand(result, &DeleteTerminatorsBatchCommand{}, &cmd_pb.DeleteTerminatorsBatchCommand{})
 
 	return result
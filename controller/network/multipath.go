@@ -0,0 +1,180 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/pkg/errors"
+)
+
+// CreateDisjointPath returns a path between the same ingress and egress routers as the given path, which shares
+// no links with it. The returned path uses the same ingress and egress addresses, so it can be installed as the
+// secondary path of a multipath circuit.
+func (network *Network) CreateDisjointPath(path *Path) (*Path, error) {
+	if len(path.Links) == 0 {
+		return nil, errors.New("path has no links, no disjoint path possible")
+	}
+
+	routerFilter, constraintLinkFilter := network.newPathConstraintFilters(path.constraints)
+	linkFilter := func(l *Link) bool {
+		return !path.usesLink(l) && (constraintLinkFilter == nil || constraintLinkFilter(l))
+	}
+
+	nodes, _, err := network.shortestPathMatching(path.Nodes[0], path.EgressRouter(), routerFilter, linkFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	secondary := &Path{
+		Nodes:                nodes,
+		IngressId:            path.IngressId,
+		EgressId:             path.EgressId,
+		InitiatorLocalAddr:   path.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  path.TerminatorLocalAddr,
+		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+		constraints:          path.constraints,
+	}
+	if err := network.setLinksMatching(secondary, linkFilter); err != nil {
+		return nil, err
+	}
+	return secondary, nil
+}
+
+// establishSecondaryPath finds and routes a link-disjoint secondary path for the circuit. Failure to do so isn't
+// fatal to the circuit, it will continue to use only the primary path.
+func (network *Network) establishSecondaryPath(circuit *Circuit, attempt uint32, ctx *ctrl_pb.Context, deadline time.Time) error {
+	secondary, err := network.CreateDisjointPath(circuit.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := network.routeSecondaryPath(circuit, secondary, attempt, ctx, deadline); err != nil {
+		return err
+	}
+
+	circuit.SecondaryPath = secondary
+	return nil
+}
+
+// routeSecondaryPath sends the secondary route messages, from the egress router back to the ingress router, so
+// that the ingress router doesn't start forwarding over the secondary path before it's complete. If routing fails,
+// routers which are only part of the secondary path are unrouted.
+func (network *Network) routeSecondaryPath(circuit *Circuit, secondary *Path, attempt uint32, ctx *ctrl_pb.Context, deadline time.Time) error {
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("secondaryPath", secondary)
+
+	rms := secondary.CreateSecondaryRouteMessages(attempt, circuit.Id, deadline)
+	mode := circuit.Service.getMultipathMode()
+	for _, msg := range rms {
+		msg.Context = ctx
+		msg.Tags = circuit.Tags
+		msg.MultipathMode = mode
+		msg.QosClass = circuit.Service.getQosClass()
+		msg.Datagram = circuit.Datagram
+		msg.HalfClose = circuit.HalfClose
+	}
+
+	var routed []*Router
+	for i := len(secondary.Nodes) - 1; i >= 0; i-- {
+		r := secondary.Nodes[i]
+		if _, err := sendRoute(r, rms[i], network.options.RouteTimeout); err != nil {
+			for _, routedR := range routed {
+				if !circuit.Path.hasRouter(routedR) {
+					if err := sendUnroute(routedR, circuit.Id, true); err != nil {
+						log.WithField("routerId", routedR.Id).WithError(err).Error("error sending secondary path cleanup unroute")
+					}
+				}
+			}
+			return errors.Wrapf(err, "error routing secondary path to [r/%s]", r.Id)
+		}
+		routed = append(routed, r)
+	}
+
+	log.Debug("routed secondary path for circuit")
+	return nil
+}
+
+// refreshSecondaryPath ensures that a multipath circuit's secondary path is routable and disjoint from its current
+// primary path, routing a new secondary path if required. The caller is expected to hold the circuit's rerouting
+// flag.
+func (network *Network) refreshSecondaryPath(circuit *Circuit, deadline time.Time) bool {
+	if !circuit.Service.IsMultipath() {
+		return false
+	}
+
+	current := circuit.SecondaryPath
+	if current != nil && !current.usesAnyLink(circuit.Path) && network.isPathUsable(current) {
+		return false
+	}
+
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+
+	secondary, err := network.CreateDisjointPath(circuit.Path)
+	if err == nil {
+		err = network.routeSecondaryPath(circuit, secondary, SmartRerouteAttempt, nil, deadline)
+	}
+
+	if err != nil {
+		log.WithError(err).Warn("unable to route secondary path, circuit continuing with primary path only")
+		circuit.SecondaryPath = nil
+	} else {
+		log.WithField("secondaryPath", secondary).Info("rerouted secondary path")
+		circuit.SecondaryPath = secondary
+	}
+
+	if current != nil {
+		network.unrouteReplacedPath(circuit, current)
+	}
+
+	return current != nil || circuit.SecondaryPath != nil
+}
+
+// unrouteReplacedPath unroutes the circuit from routers which were on a replaced path, but which aren't used by
+// any of the circuit's current paths, so they don't keep stale forwards until the circuit ends
+func (network *Network) unrouteReplacedPath(circuit *Circuit, replaced *Path) {
+	for _, r := range circuit.unusedRouters(replaced) {
+		if err := sendUnroute(r, circuit.Id, true); err != nil {
+			pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("routerId", r.Id).WithError(err).
+				Error("error sending replaced path cleanup unroute")
+		}
+	}
+}
+
+func (network *Network) rerouteSecondaryPath(circuit *Circuit, deadline time.Time) {
+	if circuit.Rerouting.CompareAndSwap(false, true) {
+		defer circuit.Rerouting.Store(false)
+		if network.refreshSecondaryPath(circuit, deadline) {
+			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+		}
+	}
+}
+
+func (network *Network) isPathUsable(path *Path) bool {
+	if path.transitsMaintenance() {
+		return false
+	}
+	for _, l := range path.Links {
+		if !l.IsUsable() || !network.linkController.has(l) {
+			return false
+		}
+	}
+	return true
+}
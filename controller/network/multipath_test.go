@@ -0,0 +1,128 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDisjointPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req := require.New(t)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r1)
+
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 0, false)
+	network.Routers.markConnected(r2)
+
+	addLink := func(id string, src, dst *Router, cost int32) *Link {
+		l := newTestLink(id, "tls")
+		l.Src = src
+		l.Dst = dst
+		l.SetStaticCost(cost)
+		l.addState(newLinkState(Connected))
+		network.linkController.add(l)
+		return l
+	}
+
+	// direct r0 -> r2 is cheapest, r0 -> r1 -> r2 is the only disjoint alternative
+	l0 := addLink("l0", r0, r2, 1)
+	l1 := addLink("l1", r0, r1, 10)
+	l2 := addLink("l2", r1, r2, 10)
+
+	path, err := network.CreatePath(r0, r2)
+	req.NoError(err)
+	req.Equal([]*Link{l0}, path.Links)
+
+	secondary, err := network.CreateDisjointPath(path)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r1, r2}, secondary.Nodes)
+	req.Equal([]*Link{l1, l2}, secondary.Links)
+	req.Equal(path.IngressId, secondary.IngressId)
+	req.Equal(path.EgressId, secondary.EgressId)
+	req.False(secondary.usesAnyLink(path))
+
+	rms := secondary.CreateSecondaryRouteMessages(0, "c0", time.Now().Add(DefaultOptionsRouteTimeout))
+	req.Equal(3, len(rms))
+	for _, rm := range rms {
+		req.Nil(rm.Egress)
+	}
+
+	// ingress forward towards the link is secondary, return forward is not
+	req.Equal(path.IngressId, rms[0].Forwards[0].SrcAddress)
+	req.Equal(l1.Id, rms[0].Forwards[0].DstAddress)
+	req.True(rms[0].Forwards[0].Secondary)
+	req.False(rms[0].Forwards[1].Secondary)
+
+	// transit forwards are never secondary
+	req.False(rms[1].Forwards[0].Secondary)
+	req.False(rms[1].Forwards[1].Secondary)
+
+	// egress forward towards the link is secondary, forward to the egress address is not
+	req.Equal(path.EgressId, rms[2].Forwards[0].SrcAddress)
+	req.Equal(l2.Id, rms[2].Forwards[0].DstAddress)
+	req.True(rms[2].Forwards[0].Secondary)
+	req.Equal(ctrl_pb.DestType_End, rms[2].Forwards[1].DstType)
+	req.False(rms[2].Forwards[1].Secondary)
+
+	// with the alternate route gone, there's no disjoint path
+	l1.SetDown(true)
+	_, err = network.CreateDisjointPath(path)
+	req.Error(err)
+}
+
+func TestCircuitUnusedRouters(t *testing.T) {
+	req := require.New(t)
+
+	r0 := newRouterForTest("r0", "", nil, nil, 0, false)
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+	r2 := newRouterForTest("r2", "", nil, nil, 0, false)
+	r3 := newRouterForTest("r3", "", nil, nil, 0, false)
+	r4 := newRouterForTest("r4", "", nil, nil, 0, false)
+
+	circuit := &Circuit{
+		Path:          &Path{Nodes: []*Router{r0, r2}},
+		SecondaryPath: &Path{Nodes: []*Router{r0, r3, r2}},
+	}
+
+	// when a secondary path is replaced, only routers on none of the current paths are unrouted
+	replaced := &Path{Nodes: []*Router{r0, r1, r3, r4, r2}}
+	req.Equal([]*Router{r1, r4}, circuit.unusedRouters(replaced))
+
+	circuit.SecondaryPath = nil
+	req.Equal([]*Router{r1, r3, r4}, circuit.unusedRouters(replaced))
+}
@@ -83,6 +83,9 @@ type Network struct {
 	eventDispatcher        event.Dispatcher
 	traceController        trace.Controller
 	routerPresenceHandlers []RouterPresenceHandler
+	terminatorHealth       *terminatorHealthChecker
+	terminatorDrain        *terminatorDrainMonitor
+	routerMaintenance      *routerMaintenanceMonitor
 	capabilities           []string
 	closeNotify            <-chan struct{}
 	watchdogCh             chan struct{}
@@ -157,6 +160,7 @@ func NewNetwork(config Config) (*Network, error) {
 	}
 	network.Managers = NewManagers(network, config.GetCommandDispatcher(), config.GetDb(), stores, routerCommPool)
 	network.Managers.Inspections.network = network
+	network.Managers.LinkCostTags.loadCostPolicy()
 
 	network.AddCapability("ziti.fabric")
 	network.showOptions()
@@ -164,6 +168,15 @@ func NewNetwork(config Config) (*Network, error) {
 	network.AddRouterPresenceHandler(network.Managers.RouterMessaging)
 	go network.Managers.RouterMessaging.run()
 
+	network.terminatorHealth = newTerminatorHealthChecker(network)
+	go network.terminatorHealth.run()
+
+	network.terminatorDrain = newTerminatorDrainMonitor(network)
+	go network.terminatorDrain.run()
+
+	network.routerMaintenance = newRouterMaintenanceMonitor(network)
+	go network.routerMaintenance.run()
+
 	return network, nil
 }
 
@@ -315,6 +328,7 @@ func (network *Network) ConnectedRouter(id string) bool {
 
 func (network *Network) ConnectRouter(r *Router) {
 	network.Routers.markConnected(r)
+	network.linkController.refreshCostTags(r)
 
 	time.AfterFunc(250*time.Millisecond, func() { network.routerChanged <- r })
 
@@ -505,7 +519,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		logger = logger.WithField("serviceName", svc.Name)
 
 		// 3: select terminator
-		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, ctx)
+		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, getAffinityKey(params), ctx)
 		if circuitErr != nil {
 			network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, circuitErr.Cause())
 			network.ServiceDialOtherError(serviceId)
@@ -513,7 +527,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		}
 
 		// 4: Create Path
-		path, pathErr := network.CreatePathWithNodes(pathNodes)
+		path, pathErr := network.CreatePathWithConstraints(pathNodes, getServicePathConstraints(strategy, svc))
 		if pathErr != nil {
 			network.CircuitFailedEvent(circuitId, params, startTime, nil, terminator, pathErr.Cause())
 			network.ServiceDialOtherError(serviceId)
@@ -524,14 +538,21 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		tags := params.GetCircuitTags(terminator)
 
 		// 4a: Create Route Messages
+		datagram := isDatagramRequested(clientId) && path.supportsDatagram(terminator)
+		halfClose := path.supportsHalfClose()
 		rms := path.CreateRouteMessages(attempt, circuitId, terminator, deadline)
 		rms[len(rms)-1].Egress.PeerData = clientId.Data
+		rms[len(rms)-1].RateLimit = getCircuitRateLimit(svc, terminator)
 		for _, msg := range rms {
 			msg.Context = &ctrl_pb.Context{
 				Fields:      ctx.GetStringFields(),
 				ChannelMask: ctx.GetChannelsMask(),
 			}
 			msg.Tags = tags
+			msg.MultipathMode = svc.getMultipathMode()
+			msg.QosClass = svc.getQosClass()
+			msg.Datagram = datagram
+			msg.HalfClose = halfClose
 		}
 
 		// 5: Routing
@@ -609,11 +630,31 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 			PeerData:   peerData,
 			CreatedAt:  time.Now(),
 			Tags:       tags,
+			Datagram:   datagram,
+			HalfClose:  halfClose,
+		}
+
+		// 6a: Route Secondary Path, for multipath services
+		if svc.IsMultipath() && len(path.Links) > 0 {
+			if err := network.establishSecondaryPath(circuit, attempt, rms[0].Context, deadline); err != nil {
+				logger.WithError(err).Warn("unable to establish secondary path for multipath circuit, using primary path only")
+			}
 		}
+
 		network.circuitController.add(circuit)
 		creationTimespan := time.Since(startTime)
 		network.CircuitEvent(event.CircuitCreated, circuit, &creationTimespan)
 
+		// 6b: Route Backup Paths, if fast reroute is enabled. Backups only protect an established circuit, so they
+		// don't hold up circuit creation
+		if network.options.FastReroute && !svc.IsMultipath() && len(path.Links) > 0 {
+			go network.routeNewCircuitBackupPaths(circuit)
+		}
+
+		if measuringStrategy, ok := strategy.(xt.MeasuringStrategy); ok {
+			measuringStrategy.NotifyMeasurement(terminator, creationTimespan)
+		}
+
 		logger.WithField("path", circuit.Path).
 			WithField("terminator_local_address", circuit.Path.TerminatorLocalAddr).
 			WithField("terminator_remote_address", circuit.Path.TerminatorRemoteAddr).
@@ -641,7 +682,7 @@ func parseInstanceIdAndService(service string) (string, string) {
 	return identityId, serviceId
 }
 
-func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
+func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, affinityKey string, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
 	paths := map[string]*PathAndCost{}
 	var weightedTerminators []xt.CostedTerminator
 	var errList []error
@@ -649,13 +690,26 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 	log := pfxlog.ChannelLogger(logcontext.SelectPath).Wire(ctx)
 
 	hasOfflineRouters := false
+	hasDrainingTerminators := false
 	pathError := false
+	policyError := false
+
+	strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy)
+	if err != nil {
+		return nil, nil, nil, newCircuitErrWrap(CircuitFailureInvalidStrategy, err)
+	}
+	constraints := getServicePathConstraints(strategy, svc)
 
 	for _, terminator := range svc.Terminators {
 		if terminator.InstanceId != instanceId {
 			continue
 		}
 
+		if terminator.Draining {
+			hasDrainingTerminators = true
+			continue
+		}
+
 		pathAndCost, found := paths[terminator.Router]
 		if !found {
 			dstR := network.Routers.getConnected(terminator.GetRouterId())
@@ -669,21 +723,25 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 				continue
 			}
 
-			path, cost, err := network.shortestPath(srcR, dstR)
+			path, cost, err := network.shortestConstrainedPath(srcR, dstR, constraints)
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
 				pathError = true
+				if !svc.RoutingPolicy.IsEmpty() && !policyError {
+					_, _, err = network.shortestConstrainedPath(srcR, dstR, getPathConstraints(strategy, svc.Id))
+					policyError = err == nil
+				}
 				continue
 			}
 
-			pathAndCost = newPathAndCost(path, cost)
+			pathAndCost = newPathAndCost(path, cost+network.getCrossRegionCost(srcR, dstR))
 			paths[terminator.GetRouterId()] = pathAndCost
 		}
 
 		dynamicCost := xt.GlobalCosts().GetDynamicCost(terminator.Id)
 		unbiasedCost := uint32(terminator.Cost) + uint32(dynamicCost) + pathAndCost.cost
-		biasedCost := terminator.Precedence.GetBiasedCost(unbiasedCost)
+		biasedCost := terminator.GetPrecedence().GetBiasedCost(unbiasedCost)
 		costedTerminator := &RoutingTerminator{
 			Terminator: terminator,
 			RouteCost:  biasedCost,
@@ -696,6 +754,10 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 	}
 
 	if len(weightedTerminators) == 0 {
+		if policyError {
+			return nil, nil, nil, newCircuitErrorf(CircuitFailureNoCompliantPath, "service %v has no path which complies with its routing policy: %v", svc.Id, errorz.MultipleErrors(errList))
+		}
+
 		if pathError {
 			return nil, nil, nil, newCircuitErrWrap(CircuitFailureNoPath, errorz.MultipleErrors(errList))
 		}
@@ -704,19 +766,23 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 			return nil, nil, nil, newCircuitErrorf(CircuitFailureNoOnlineTerminators, "service %v has no online terminators for instanceId %v", svc.Id, instanceId)
 		}
 
-		return nil, nil, nil, newCircuitErrorf(CircuitFailureNoTerminators, "service %v has no terminators for instanceId %v", svc.Id, instanceId)
-	}
+		if hasDrainingTerminators {
+			return nil, nil, nil, newCircuitErrorf(CircuitFailureTerminatorsDraining, "service %v has only draining terminators for instanceId %v", svc.Id, instanceId)
+		}
 
-	strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy)
-	if err != nil {
-		return nil, nil, nil, newCircuitErrWrap(CircuitFailureInvalidStrategy, err)
+		return nil, nil, nil, newCircuitErrorf(CircuitFailureNoTerminators, "service %v has no terminators for instanceId %v", svc.Id, instanceId)
 	}
 
 	sort.Slice(weightedTerminators, func(i, j int) bool {
 		return weightedTerminators[i].GetRouteCost() < weightedTerminators[j].GetRouteCost()
 	})
 
-	terminator, err := strategy.Select(weightedTerminators)
+	var terminator xt.CostedTerminator
+	if affinityStrategy, ok := strategy.(xt.AffinityStrategy); ok && affinityKey != "" {
+		terminator, err = affinityStrategy.SelectWithAffinity(affinityKey, weightedTerminators)
+	} else {
+		terminator, err = strategy.Select(weightedTerminators)
+	}
 
 	if err != nil {
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureStrategyError, "strategy %v errored selecting terminator for service %v: %v", svc.TerminatorStrategy, svc.Id, err)
@@ -750,11 +816,21 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 	return strategy, terminator, path, nil
 }
 
+// getCrossRegionCost returns the cost added to terminators which are in a different region from the ingress router,
+// so terminators in the same region are preferred. Routers without a region aren't penalized.
+func (network *Network) getCrossRegionCost(srcR, dstR *Router) int64 {
+	srcRegion, dstRegion := srcR.GetRegion(), dstR.GetRegion()
+	if srcRegion == "" || dstRegion == "" || srcRegion == dstRegion {
+		return 0
+	}
+	return int64(network.options.CrossRegionCost)
+}
+
 func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	log := pfxlog.Logger().WithField("circuitId", circuitId)
 
 	if circuit, found := network.circuitController.get(circuitId); found {
-		for _, r := range circuit.Path.Nodes {
+		for _, r := range circuit.Routers() {
 			err := sendUnroute(r, circuit.Id, now)
 			if err != nil {
 				log.Errorf("error sending unroute to [r/%s] (%s)", r.Id, err)
@@ -800,6 +876,12 @@ func (network *Network) CreatePath(srcR, dstR *Router) (*Path, error) {
 }
 
 func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitError) {
+	return network.CreatePathWithConstraints(nodes, nil)
+}
+
+// CreatePathWithConstraints creates a path using the given routers. The constraints restrict which links may be
+// used and are retained with the path, so that they're also honoured when the path is updated.
+func (network *Network) CreatePathWithConstraints(nodes []*Router, constraints *xt.PathConstraints) (*Path, CircuitError) {
 	ingressId, err := network.sequence.NextHash()
 	if err != nil {
 		return nil, newCircuitErrWrap(CircuitFailureIdGenerationError, err)
@@ -810,21 +892,33 @@ func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitErro
 		return nil, newCircuitErrWrap(CircuitFailureIdGenerationError, err)
 	}
 
+	if constraints != nil && !satisfiesPathConstraints(nodes, constraints) {
+		return nil, newCircuitErrorf(CircuitFailureNoPath, "path does not satisfy constraints %v", constraints)
+	}
+
 	path := &Path{
-		Nodes:     nodes,
-		IngressId: ingressId,
-		EgressId:  egressId,
+		Nodes:       nodes,
+		IngressId:   ingressId,
+		EgressId:    egressId,
+		constraints: constraints,
 	}
-	if err := network.setLinks(path); err != nil {
+	_, linkFilter := network.newPathConstraintFilters(constraints)
+	if err := network.setLinksMatching(path, linkFilter); err != nil {
 		return nil, newCircuitErrWrap(CircuitFailurePathMissingLink, err)
 	}
 	return path, nil
 }
 
 func (network *Network) UpdatePath(path *Path) (*Path, error) {
+	return network.updatePathWithConstraints(path, path.constraints)
+}
+
+// updatePathWithConstraints returns the best path between the ingress and egress routers of the given path which
+// satisfies the given constraints. The new path retains the constraints.
+func (network *Network) updatePathWithConstraints(path *Path, constraints *xt.PathConstraints) (*Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.shortestConstrainedPath(srcR, dstR, constraints)
 	if err != nil {
 		return nil, err
 	}
@@ -837,17 +931,23 @@ func (network *Network) UpdatePath(path *Path) (*Path, error) {
 		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
 		TerminatorLocalAddr:  path.TerminatorLocalAddr,
 		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+		constraints:          constraints,
 	}
-	if err := network.setLinks(path2); err != nil {
+	_, linkFilter := network.newPathConstraintFilters(constraints)
+	if err := network.setLinksMatching(path2, linkFilter); err != nil {
 		return nil, err
 	}
 	return path2, nil
 }
 
 func (network *Network) setLinks(path *Path) error {
+	return network.setLinksMatching(path, nil)
+}
+
+func (network *Network) setLinksMatching(path *Path, linkFilter func(*Link) bool) error {
 	if len(path.Nodes) > 1 {
 		for i := 0; i < len(path.Nodes)-1; i++ {
-			if link, found := network.linkController.leastExpensiveLink(path.Nodes[i], path.Nodes[i+1]); found {
+			if link, found := network.linkController.leastExpensiveLinkMatching(path.Nodes[i], path.Nodes[i+1], linkFilter); found {
 				path.Links = append(path.Links, link)
 			} else {
 				return errors.Errorf("no link from r/%v to r/%v", path.Nodes[i].Id, path.Nodes[i+1].Id)
@@ -861,12 +961,26 @@ func (network *Network) AddRouterPresenceHandler(h RouterPresenceHandler) {
 	network.routerPresenceHandlers = append(network.routerPresenceHandlers, h)
 }
 
+func (network *Network) AddTerminatorHealthHandler(h TerminatorHealthHandler) {
+	network.terminatorHealth.addHandler(h)
+}
+
+func (network *Network) AddTerminatorDrainHandler(h TerminatorDrainHandler) {
+	network.terminatorDrain.addHandler(h)
+}
+
+func (network *Network) AddRouterMaintenanceHandler(h RouterMaintenanceHandler) {
+	network.routerMaintenance.addHandler(h)
+}
+
 func (network *Network) Run() {
 	defer logrus.Info("exited")
 	logrus.Info("started")
 
 	go network.watchdog()
 
+	network.ReconcileTerminatorCircuitCounts()
+
 	for {
 		select {
 		case r := <-network.routerChanged:
@@ -884,6 +998,7 @@ func (network *Network) Run() {
 			network.assemble()
 			network.clean()
 			network.smart()
+			network.ReconcileTerminatorCircuitCounts()
 
 		case <-network.closeNotify:
 			network.eventDispatcher.RemoveMetricsMessageHandler(network)
@@ -899,6 +1014,34 @@ func (network *Network) Run() {
 	}
 }
 
+// ReconcileTerminatorCircuitCounts provides strategies which track active circuits per terminator with the counts of
+// the circuits currently known to the controller
+func (network *Network) ReconcileTerminatorCircuitCounts() {
+	lister, ok := network.strategyRegistry.(xt.StrategyLister)
+	if !ok {
+		return
+	}
+
+	for _, strategy := range lister.GetStrategies() {
+		if countingStrategy, ok := strategy.(xt.CircuitCountingStrategy); ok {
+			countingStrategy.ReconcileActiveCircuitCounts(func() map[string]uint32 {
+				return network.getTerminatorCircuitCounts(countingStrategy)
+			})
+		}
+	}
+}
+
+// getTerminatorCircuitCounts returns the number of circuits per terminator, for services using the given strategy
+func (network *Network) getTerminatorCircuitCounts(strategy xt.Strategy) map[string]uint32 {
+	counts := map[string]uint32{}
+	for _, circuit := range network.GetAllCircuits() {
+		if circuitStrategy, _ := network.strategyRegistry.GetStrategy(circuit.Service.TerminatorStrategy); circuitStrategy == strategy {
+			counts[circuit.Terminator.GetId()]++
+		}
+	}
+	return counts
+}
+
 func (network *Network) watchdog() {
 	watchdogInterval := 2 * time.Duration(network.options.CycleSeconds) * time.Second
 	consecutiveFails := 0
@@ -995,6 +1138,12 @@ func (network *Network) rerouteLink(l *Link, deadline time.Time) error {
 					log.WithError(err).Error("error removing circuit after reroute failure")
 				}
 			}
+		} else if secondary := circuit.SecondaryPath; secondary != nil && secondary.usesLink(l) {
+			logrus.WithField("linkId", l.Id).WithField("circuitId", circuit.Id).Info("circuit secondary path uses link")
+			network.rerouteSecondaryPath(circuit, deadline)
+		} else if circuit.backupsUseLink(l) {
+			logrus.WithField("linkId", l.Id).WithField("circuitId", circuit.Id).Info("circuit backup path uses link")
+			network.rerouteBackupPaths(circuit, deadline)
 		}
 	}
 
@@ -1027,10 +1176,11 @@ func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) err
 
 		log.Warn("rerouting circuit")
 
-		if cq, err := network.UpdatePath(circuit.Path); err == nil {
+		if cq, err := network.updatePathWithConstraints(circuit.Path, network.getCurrentPathConstraints(circuit)); err == nil {
 			circuit.Path = cq
 
 			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+			setRouteCircuitSettings(rms, circuit)
 
 			for i := 0; i < len(cq.Nodes); i++ {
 				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -1040,6 +1190,8 @@ func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) err
 
 			log.Info("rerouted circuit")
 
+			network.refreshSecondaryPath(circuit, deadline)
+			network.routeBackupPaths(circuit, deadline)
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
 			return nil
 		} else {
@@ -1060,6 +1212,7 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 		circuit.Path = cq
 
 		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+		setRouteCircuitSettings(rms, circuit)
 
 		for i := 0; i < len(cq.Nodes); i++ {
 			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -1071,12 +1224,30 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 
 		if !retry {
 			logrus.Debug("rerouted circuit")
+			network.refreshSecondaryPath(circuit, deadline)
+			network.routeBackupPaths(circuit, deadline)
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
 		}
 	}
 	return retry
 }
 
+// setRouteCircuitSettings carries the service QoS class, the datagram mode and half close support to routers which are
+// new to the circuit after a reroute
+func setRouteCircuitSettings(rms []*ctrl_pb.Route, circuit *Circuit) {
+	for _, msg := range rms {
+		msg.Datagram = circuit.Datagram
+		msg.HalfClose = circuit.HalfClose
+	}
+	if circuit.Service == nil {
+		return
+	}
+	qosClass := circuit.Service.getQosClass()
+	for _, msg := range rms {
+		msg.QosClass = qosClass
+	}
+}
+
 func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 	if metrics.SourceId == network.nodeId {
 		return // ignore metrics coming from the controller itself
@@ -1113,6 +1284,14 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 				log.Warnf("link not for router")
 			}
 		}
+
+		if quality, found := getLinkQuality(metrics, link.Id); found {
+			if link.Src.Id == router.Id {
+				link.updateQuality(true, quality, &network.options.LinkQuality)
+			} else if link.Dst.Id == router.Id {
+				link.updateQuality(false, quality, &network.options.LinkQuality)
+			}
+		}
 	}
 }
 
@@ -138,7 +138,7 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	*/
 	lc := logcontext.NewContext()
-	_, _, _, cerr := network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr := network.selectPath(r0, svc, "", "", lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 
@@ -154,15 +154,15 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	}
 
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "", "", lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoOnlineTerminators, cerr.Cause())
 
 	network.Routers.markConnected(r0)
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "", "", lc)
 	assert.NoError(t, cerr)
 
-	_, _, _, cerr = network.selectPath(r0, svc, "test", lc)
+	_, _, _, cerr = network.selectPath(r0, svc, "test", "", lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 }
@@ -26,6 +26,7 @@ import (
 
 const (
 	DefaultOptionsCreateCircuitRetries      = 2
+	DefaultOptionsCrossRegionCost           = 1000
 	DefaultOptionsCycleSeconds              = 60
 	DefaultOptionsEnableLegacyLinkMgmt      = true
 	DefaultOptionsInitialLinkLatency        = 65 * time.Second
@@ -41,14 +42,24 @@ const (
 	DefaultOptionsSmartRerouteFraction     = 0.02
 	DefaultOptionsSmartRerouteMinCostDelta = 15
 
+	DefaultOptionsLinkQualityRetransmitCostFactor   = 5000
+	DefaultOptionsLinkQualityDuplicateAckCostFactor = 1000
+	DefaultOptionsLinkQualityMinThroughput          = 10_000
+	DefaultOptionsLinkQualityHysteresis             = 0.25
+	DefaultOptionsLinkQualityMinCostDelta           = 5
+
+	DefaultOptionsLinkTopologyRegionTag = "region"
+
 	OptionsRouterCommMaxQueueSize = 1_000_000
 	OptionsRouterCommMaxWorkers   = 10_000
 )
 
 type Options struct {
 	CreateCircuitRetries    uint32
+	CrossRegionCost         uint32
 	CycleSeconds            uint32
 	EnableLegacyLinkMgmt    bool
+	FastReroute             bool
 	InitialLinkLatency      time.Duration
 	IntervalAgeThreshold    time.Duration
 	MetricsReportInterval   time.Duration
@@ -65,11 +76,26 @@ type Options struct {
 		RerouteCap      uint32
 		MinCostDelta    uint32
 	}
+	LinkQuality  LinkQualityOptions
+	LinkTopology LinkTopologyOptions
+}
+
+// LinkQualityOptions control how the loss and throughput reported by routers for a link are folded into its cost.
+// The cost factors are the cost added when every message sent over the link is a retransmit or causes a duplicate
+// ack. Measurements taken at less than the minimum throughput (in bytes/sec) are scaled down proportionally. The
+// quality cost is only changed if it moves by more than the hysteresis fraction and at least the min cost delta.
+type LinkQualityOptions struct {
+	RetransmitCostFactor   float64
+	DuplicateAckCostFactor float64
+	MinThroughput          float64
+	Hysteresis             float64
+	MinCostDelta           uint32
 }
 
 func DefaultOptions() *Options {
 	options := &Options{
 		CreateCircuitRetries:  DefaultOptionsCreateCircuitRetries,
+		CrossRegionCost:       DefaultOptionsCrossRegionCost,
 		CycleSeconds:          DefaultOptionsCycleSeconds,
 		EnableLegacyLinkMgmt:  DefaultOptionsEnableLegacyLinkMgmt,
 		InitialLinkLatency:    DefaultOptionsInitialLinkLatency,
@@ -94,6 +120,17 @@ func DefaultOptions() *Options {
 			RerouteCap:      DefaultOptionsSmartRerouteCap,
 			MinCostDelta:    DefaultOptionsSmartRerouteMinCostDelta,
 		},
+		LinkQuality: LinkQualityOptions{
+			RetransmitCostFactor:   DefaultOptionsLinkQualityRetransmitCostFactor,
+			DuplicateAckCostFactor: DefaultOptionsLinkQualityDuplicateAckCostFactor,
+			MinThroughput:          DefaultOptionsLinkQualityMinThroughput,
+			Hysteresis:             DefaultOptionsLinkQualityHysteresis,
+			MinCostDelta:           DefaultOptionsLinkQualityMinCostDelta,
+		},
+		LinkTopology: LinkTopologyOptions{
+			Mode:      LinkTopologyFullMesh,
+			RegionTag: DefaultOptionsLinkTopologyRegionTag,
+		},
 	}
 	return options
 }
@@ -128,6 +165,22 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["crossRegionCost"]; found {
+		if cost, ok := value.(int); ok && cost >= 0 {
+			options.CrossRegionCost = uint32(cost)
+		} else {
+			return nil, errors.New("invalid value for 'crossRegionCost'")
+		}
+	}
+
+	if value, found := src["fastReroute"]; found {
+		if fastReroute, ok := value.(bool); ok {
+			options.FastReroute = fastReroute
+		} else {
+			return nil, errors.New("invalid value for 'fastReroute'")
+		}
+	}
+
 	if value, found := src["smart"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["rerouteFraction"]; found {
@@ -158,6 +211,90 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["linkQuality"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			floatValues := map[string]*float64{
+				"retransmitCostFactor":   &options.LinkQuality.RetransmitCostFactor,
+				"duplicateAckCostFactor": &options.LinkQuality.DuplicateAckCostFactor,
+				"minThroughput":          &options.LinkQuality.MinThroughput,
+				"hysteresis":             &options.LinkQuality.Hysteresis,
+			}
+			for name, target := range floatValues {
+				if value, found := submap[name]; found {
+					switch v := value.(type) {
+					case int:
+						*target = float64(v)
+					case float64:
+						*target = v
+					default:
+						return nil, errors.Errorf("invalid value for 'linkQuality.%s'", name)
+					}
+					if *target < 0 {
+						return nil, errors.Errorf("invalid value for 'linkQuality.%s', must be greater than or equal to 0", name)
+					}
+				}
+			}
+
+			if value, found := submap["minCostDelta"]; found {
+				if minCostDelta, ok := value.(int); ok && minCostDelta >= 0 {
+					options.LinkQuality.MinCostDelta = uint32(minCostDelta)
+				} else {
+					return nil, errors.New("invalid value for 'linkQuality.minCostDelta'")
+				}
+			}
+		} else {
+			logrus.Errorf("invalid or empty 'linkQuality' stanza")
+		}
+	}
+
+	if value, found := src["linkTopology"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := submap["mode"]; found {
+				if mode, ok := value.(string); ok && isValidLinkTopologyMode(mode) {
+					options.LinkTopology.Mode = mode
+				} else {
+					return nil, errors.Errorf("invalid value for 'linkTopology.mode', must be one of %v", linkTopologyModes)
+				}
+			}
+
+			if value, found := submap["hubs"]; found {
+				if hubs, ok := value.([]interface{}); ok {
+					for _, hub := range hubs {
+						if selector, ok := hub.(string); ok && selector != "" {
+							options.LinkTopology.HubSelectors = append(options.LinkTopology.HubSelectors, selector)
+						} else {
+							return nil, errors.New("invalid value for 'linkTopology.hubs', must be a list of router tag selectors")
+						}
+					}
+				} else {
+					return nil, errors.New("invalid value for 'linkTopology.hubs', must be a list of router tag selectors")
+				}
+			}
+
+			if value, found := submap["regionTag"]; found {
+				if regionTag, ok := value.(string); ok && regionTag != "" {
+					options.LinkTopology.RegionTag = regionTag
+				} else {
+					return nil, errors.New("invalid value for 'linkTopology.regionTag'")
+				}
+			}
+
+			if value, found := submap["maxDegree"]; found {
+				if maxDegree, ok := value.(int); ok && maxDegree >= 0 {
+					options.LinkTopology.MaxDegree = uint32(maxDegree)
+				} else {
+					return nil, errors.New("invalid value for 'linkTopology.maxDegree'")
+				}
+			}
+		} else {
+			logrus.Errorf("invalid or empty 'linkTopology' stanza")
+		}
+
+		if mode := options.LinkTopology.Mode; (mode == LinkTopologyHubAndSpoke || mode == LinkTopologyRegional) && len(options.LinkTopology.HubSelectors) == 0 {
+			return nil, errors.Errorf("'linkTopology.hubs' must be set when 'linkTopology.mode' is %v", mode)
+		}
+	}
+
 	if value, found := src["routerMessaging"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["queueSize"]; found {
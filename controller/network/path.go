@@ -18,7 +18,6 @@ package network
 
 import (
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/openziti/fabric/common/pb/ctrl_pb"
@@ -35,6 +34,7 @@ type Path struct {
 	InitiatorRemoteAddr  string
 	TerminatorLocalAddr  string
 	TerminatorRemoteAddr string
+	constraints          *xt.PathConstraints
 }
 
 func (self *Path) cost(minRouterCost uint16) int64 {
@@ -91,6 +91,18 @@ func (self *Path) EgressRouter() *Router {
 }
 
 func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
+	return self.createRouteMessages(attempt, circuitId, terminator, deadline, false)
+}
+
+// CreateSecondaryRouteMessages creates the route messages for the secondary path of a multipath circuit. The
+// forwards leaving the ingress and egress addresses are flagged as secondary, so they are installed alongside the
+// primary forwards, rather than replacing them. No egress is included, as the terminator is already dialed by the
+// primary path.
+func (self *Path) CreateSecondaryRouteMessages(attempt uint32, circuitId string, deadline time.Time) []*ctrl_pb.Route {
+	return self.createRouteMessages(attempt, circuitId, nil, deadline, true)
+}
+
+func (self *Path) createRouteMessages(attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time, secondary bool) []*ctrl_pb.Route {
 	var routeMessages []*ctrl_pb.Route
 	remainingTime := time.Until(deadline)
 	if len(self.Links) == 0 {
@@ -122,6 +134,7 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 				SrcAddress: self.IngressId,
 				DstAddress: link.Id,
 				DstType:    ctrl_pb.DestType_Link,
+				Secondary:  secondary,
 			})
 			routeMessage.Forwards = append(routeMessage.Forwards, &ctrl_pb.Route_Forward{
 				SrcAddress: link.Id,
@@ -149,7 +162,7 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 		if i == len(self.Links)-1 {
 			// egress
 			routeMessage := &ctrl_pb.Route{CircuitId: circuitId, Attempt: attempt, Timeout: uint64(remainingTime)}
-			if attempt != SmartRerouteAttempt {
+			if attempt != SmartRerouteAttempt && !secondary {
 				routeMessage.Egress = &ctrl_pb.Route_Egress{
 					Binding:     terminator.GetBinding(),
 					Address:     self.EgressId,
@@ -160,6 +173,7 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 				SrcAddress: self.EgressId,
 				DstAddress: link.Id,
 				DstType:    ctrl_pb.DestType_Link,
+				Secondary:  secondary,
 			})
 			routeMessage.Forwards = append(routeMessage.Forwards, &ctrl_pb.Route_Forward{
 				SrcAddress: link.Id,
@@ -172,6 +186,27 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 	return routeMessages
 }
 
+func (self *Path) hasRouter(r *Router) bool {
+	for _, node := range self.Nodes {
+		if node == r {
+			return true
+		}
+	}
+	return false
+}
+
+func (self *Path) usesAnyLink(other *Path) bool {
+	if self == nil || other == nil {
+		return false
+	}
+	for _, l := range other.Links {
+		if self.usesLink(l) {
+			return true
+		}
+	}
+	return false
+}
+
 func (self *Path) usesLink(l *Link) bool {
 	if self.Links != nil {
 		for _, o := range self.Links {
@@ -187,89 +222,22 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
-
-	if srcR == dstR {
-		return []*Router{srcR}, 0, nil
-	}
-
-	dist := make(map[*Router]int64)
-	prev := make(map[*Router]*Router)
-	unvisited := make(map[*Router]bool)
-
-	for _, r := range network.Routers.allConnected() {
-		dist[r] = math.MaxInt32
-		unvisited[r] = true
-	}
-	dist[srcR] = 0
-
-	minRouterCost := network.options.MinRouterCost
-
-	for len(unvisited) > 0 {
-		u := minCost(unvisited, dist)
-		if u == dstR { // if the dest router is the lowest cost next link, we can stop evaluating
-			break
-		}
-		delete(unvisited, u)
-
-		neighbors := network.linkController.connectedNeighborsOfRouter(u)
-		for _, r := range neighbors {
-			if _, found := unvisited[r]; found {
-				var cost int64 = math.MaxInt32 + 1
-				if l, found := network.linkController.leastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
-						cost = l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
-					}
-				}
-
-				alt := dist[u] + cost
-				if alt < dist[r] {
-					dist[r] = alt
-					prev[r] = u
-				}
-			}
-		}
-	}
-
-	/*
-	 * dist: (r2->r1->r0)
-	 *		r0 = 2 <- r1
-	 *		r1 = 1 <- r2
-	 *		r2 = 0 <- nil
-	 */
-
-	routerPath := make([]*Router, 0)
-	p := prev[dstR]
-	for p != nil {
-		routerPath = append([]*Router{p}, routerPath...)
-		p = prev[p]
-	}
-	routerPath = append(routerPath, dstR)
-
-	if routerPath[0] != srcR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v", srcR.Id, dstR.Id)
-	}
-	if routerPath[len(routerPath)-1] != dstR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", srcR.Id, dstR.Id)
-	}
-
-	return routerPath, dist[dstR], nil
+	return network.linkController.routingTable.shortestPath(srcR, dstR)
 }
 
-func minCost(q map[*Router]bool, dist map[*Router]int64) *Router {
-	if dist == nil || len(dist) < 1 {
-		return nil
+// shortestPathMatching finds the lowest cost path between the given routers, only considering routers and links
+// accepted by the given filters. A nil filter accepts everything. Unlike shortestPath, results aren't cached.
+func (network *Network) shortestPathMatching(srcR *Router, dstR *Router, routerFilter func(*Router) bool, linkFilter func(*Link) bool) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
 
-	min := int64(math.MaxInt64)
-	var selected *Router
-	for r := range q {
-		d := dist[r]
-		if d <= min {
-			selected = r
-			min = d
-		}
+	if routerFilter != nil && (!routerFilter(srcR) || !routerFilter(dstR)) {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v. source or destination excluded", srcR.Id, dstR.Id)
 	}
-	return selected
+
+	tree := computeShortestPathTree(srcR, dstR, network.options.MinRouterCost, routerFilter, linkFilter)
+	return tree.pathTo(dstR)
 }
 
 func maxUint16(v1, v2 uint16) uint16 {
@@ -0,0 +1,308 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/pkg/errors"
+)
+
+// maxPathCandidatesPerPath bounds how many candidate paths are evaluated, per requested path, when searching for
+// paths which satisfy path-level constraints, such as max hops or required routers
+const maxPathCandidatesPerPath = 16
+
+// newPathConstraintFilters returns router and link filters implementing the constraints which can be applied while
+// searching for a path. Nil filters are returned if there are no such constraints.
+func (network *Network) newPathConstraintFilters(constraints *xt.PathConstraints) (func(*Router) bool, func(*Link) bool) {
+	if constraints.IsEmpty() {
+		return nil, nil
+	}
+
+	var routerFilter func(*Router) bool
+	if len(constraints.AvoidRouterTags) > 0 || len(constraints.AllowedRouters) > 0 || len(constraints.DeniedRouters) > 0 {
+		routerFilter = func(r *Router) bool {
+			return satisfiesRouterConstraints(r, constraints)
+		}
+	}
+
+	var linkFilter func(*Link) bool
+	if constraints.MaxLinkLatency > 0 {
+		maxLatency := int64(constraints.MaxLinkLatency)
+		linkFilter = func(l *Link) bool {
+			return l.GetSrcLatency() <= maxLatency && l.GetDstLatency() <= maxLatency
+		}
+	}
+
+	return routerFilter, linkFilter
+}
+
+// satisfiesPathConstraints checks the constraints which can only be evaluated against a complete path
+func satisfiesPathConstraints(nodes []*Router, constraints *xt.PathConstraints) bool {
+	if constraints.IsEmpty() {
+		return true
+	}
+
+	if constraints.MaxHops > 0 && len(nodes)-1 > constraints.MaxHops {
+		return false
+	}
+
+	for _, r := range nodes {
+		if !satisfiesRouterConstraints(r, constraints) {
+			return false
+		}
+	}
+
+	for _, selector := range constraints.TraverseRouterTags {
+		found := false
+		for _, r := range nodes {
+			if r.MatchesSelector(selector) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// satisfiesRouterConstraints checks the constraints which apply to each router on a path
+func satisfiesRouterConstraints(r *Router, constraints *xt.PathConstraints) bool {
+	for _, selector := range constraints.AvoidRouterTags {
+		if r.MatchesSelector(selector) {
+			return false
+		}
+	}
+
+	for _, entry := range constraints.DeniedRouters {
+		if r.matchesPolicyEntry(entry) {
+			return false
+		}
+	}
+
+	if len(constraints.AllowedRouters) == 0 {
+		return true
+	}
+
+	for _, entry := range constraints.AllowedRouters {
+		if r.matchesPolicyEntry(entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortestConstrainedPath returns the lowest cost path between the given routers which satisfies the constraints
+func (network *Network) shortestConstrainedPath(srcR, dstR *Router, constraints *xt.PathConstraints) ([]*Router, int64, error) {
+	if constraints.IsEmpty() {
+		return network.shortestPath(srcR, dstR)
+	}
+
+	paths, err := network.kShortestPaths(srcR, dstR, 1, constraints)
+	if err != nil {
+		return nil, 0, err
+	}
+	return paths[0].nodes, paths[0].cost, nil
+}
+
+// KShortestPaths returns up to k loop-free paths between the given routers, in increasing order of cost, which
+// satisfy the given constraints. The returned paths can be passed to CreatePathWithConstraints.
+func (network *Network) KShortestPaths(srcR, dstR *Router, k int, constraints *xt.PathConstraints) ([]*PathAndCost, error) {
+	paths, err := network.kShortestPaths(srcR, dstR, k, constraints)
+	if err != nil {
+		return nil, err
+	}
+	var result []*PathAndCost
+	for _, p := range paths {
+		result = append(result, newPathAndCost(p.nodes, p.cost))
+	}
+	return result, nil
+}
+
+type candidatePath struct {
+	nodes []*Router
+	cost  int64
+}
+
+func (self *candidatePath) key() string {
+	key := ""
+	for _, r := range self.nodes {
+		key += r.Id + "/"
+	}
+	return key
+}
+
+func (self *candidatePath) hasPrefix(nodes []*Router) bool {
+	if len(self.nodes) < len(nodes) {
+		return false
+	}
+	for i, r := range nodes {
+		if self.nodes[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+func routerPairKey(a, b *Router) string {
+	if a.Id < b.Id {
+		return a.Id + "/" + b.Id
+	}
+	return b.Id + "/" + a.Id
+}
+
+// kShortestPaths implements Yen's algorithm, treating all the links between a pair of routers as a single edge.
+// Constraints which can be expressed as router or link filters prune the search, while path-level constraints are
+// applied to the generated candidates.
+func (network *Network) kShortestPaths(srcR, dstR *Router, k int, constraints *xt.PathConstraints) ([]*candidatePath, error) {
+	if srcR == nil || dstR == nil {
+		return nil, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	if k < 1 {
+		return nil, errors.Errorf("invalid path count %v, must be at least 1", k)
+	}
+
+	routerFilter, linkFilter := network.newPathConstraintFilters(constraints)
+
+	nodes, cost, err := network.shortestPathMatching(srcR, dstR, routerFilter, linkFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*candidatePath
+	generated := []*candidatePath{{nodes: nodes, cost: cost}}
+	seen := map[string]struct{}{generated[0].key(): {}}
+	var candidates []*candidatePath
+	maxCandidates := k * maxPathCandidatesPerPath
+
+	for len(generated) <= maxCandidates {
+		last := generated[len(generated)-1]
+		if satisfiesPathConstraints(last.nodes, constraints) {
+			result = append(result, last)
+			if len(result) == k {
+				break
+			}
+		}
+
+		for i := 0; i < len(last.nodes)-1; i++ {
+			spur := last.nodes[i]
+			root := last.nodes[:i+1]
+
+			excludedEdges := map[string]struct{}{}
+			for _, p := range generated {
+				if len(p.nodes) > i+1 && p.hasPrefix(root) {
+					excludedEdges[routerPairKey(p.nodes[i], p.nodes[i+1])] = struct{}{}
+				}
+			}
+
+			excludedRouters := map[*Router]struct{}{}
+			for _, r := range root[:len(root)-1] {
+				excludedRouters[r] = struct{}{}
+			}
+
+			spurRouterFilter := func(r *Router) bool {
+				if _, excluded := excludedRouters[r]; excluded {
+					return false
+				}
+				if r.isNoTraversal() && r != srcR && r != dstR {
+					return false
+				}
+				return routerFilter == nil || routerFilter(r)
+			}
+
+			spurLinkFilter := func(l *Link) bool {
+				if _, excluded := excludedEdges[routerPairKey(l.Src, l.Dst)]; excluded {
+					return false
+				}
+				return linkFilter == nil || linkFilter(l)
+			}
+
+			spurNodes, _, err := network.shortestPathMatching(spur, dstR, spurRouterFilter, spurLinkFilter)
+			if err != nil {
+				continue
+			}
+
+			candidate := &candidatePath{
+				nodes: append(append([]*Router{}, root[:len(root)-1]...), spurNodes...),
+			}
+			key := candidate.key()
+			if _, found := seen[key]; found {
+				continue
+			}
+			candidate.cost, err = network.candidatePathCost(candidate.nodes, linkFilter)
+			if err != nil {
+				continue
+			}
+			seen[key] = struct{}{}
+			candidates = append(candidates, candidate)
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].cost < candidates[j].cost
+		})
+		generated = append(generated, candidates[0])
+		candidates = candidates[1:]
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("can't route from %v -> %v within constraints %v", srcR.Id, dstR.Id, constraints)
+	}
+
+	return result, nil
+}
+
+// candidatePathCost calculates the cost of a path the same way shortestPath does, so that costs are comparable
+func (network *Network) candidatePathCost(nodes []*Router, linkFilter func(*Link) bool) (int64, error) {
+	minRouterCost := network.options.MinRouterCost
+	var cost int64
+	for i := 1; i < len(nodes); i++ {
+		l, found := network.linkController.leastExpensiveLinkMatching(nodes[i-1], nodes[i], linkFilter)
+		if !found {
+			return 0, errors.Errorf("no link from r/%v to r/%v", nodes[i-1].Id, nodes[i].Id)
+		}
+		cost += l.GetCost() + int64(maxUint16(nodes[i].Cost, minRouterCost))
+	}
+	return cost, nil
+}
+
+func (self *PathAndCost) GetPath() []*Router {
+	return self.path
+}
+
+func (self *PathAndCost) GetCost() uint32 {
+	return self.cost
+}
+
+// getPathConstraints returns the path constraints for the service, if the strategy provides any
+func getPathConstraints(strategy xt.Strategy, serviceId string) *xt.PathConstraints {
+	if constrained, ok := strategy.(xt.PathConstrainedStrategy); ok {
+		if constraints := constrained.GetPathConstraints(serviceId); !constraints.IsEmpty() {
+			return constraints
+		}
+	}
+	return nil
+}
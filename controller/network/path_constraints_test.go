@@ -0,0 +1,234 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/common/logcontext"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_latency"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKShortestPathsWithConstraints(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req := require.New(t)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	newRouter := func(id string, tags map[string]interface{}) *Router {
+		r := newRouterForTest(id, "", transportAddr, nil, 0, false)
+		r.Tags = tags
+		network.Routers.markConnected(r)
+		return r
+	}
+
+	r0 := newRouter("r0", nil)
+	r1 := newRouter("r1", map[string]interface{}{"region": "eu"})
+	r2 := newRouter("r2", map[string]interface{}{"region": "us"})
+	r3 := newRouter("r3", nil)
+
+	addLink := func(id string, src, dst *Router, cost int32) *Link {
+		l := newTestLink(id, "tls")
+		l.Src = src
+		l.Dst = dst
+		l.SetStaticCost(cost)
+		l.addState(newLinkState(Connected))
+		network.linkController.add(l)
+		return l
+	}
+
+	addLink("l0", r0, r3, 10)
+	addLink("l1", r0, r1, 2)
+	l2 := addLink("l2", r1, r3, 2)
+	addLink("l3", r0, r2, 4)
+	addLink("l4", r2, r3, 4)
+
+	paths, err := network.KShortestPaths(r0, r3, 3, nil)
+	req.NoError(err)
+	req.Equal(3, len(paths))
+	req.Equal([]*Router{r0, r1, r3}, paths[0].GetPath())
+	req.Equal([]*Router{r0, r2, r3}, paths[1].GetPath())
+	req.Equal([]*Router{r0, r3}, paths[2].GetPath())
+	req.True(paths[0].GetCost() <= paths[1].GetCost())
+	req.True(paths[1].GetCost() <= paths[2].GetCost())
+
+	// asking for more paths than exist returns what's available
+	paths, err = network.KShortestPaths(r0, r3, 5, nil)
+	req.NoError(err)
+	req.Equal(3, len(paths))
+
+	paths, err = network.KShortestPaths(r0, r3, 1, &xt.PathConstraints{AvoidRouterTags: []string{"region=eu"}})
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, paths[0].GetPath())
+
+	paths, err = network.KShortestPaths(r0, r3, 1, &xt.PathConstraints{TraverseRouterTags: []string{"region=us"}})
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, paths[0].GetPath())
+
+	paths, err = network.KShortestPaths(r0, r3, 2, &xt.PathConstraints{MaxHops: 1})
+	req.NoError(err)
+	req.Equal(1, len(paths))
+	req.Equal([]*Router{r0, r3}, paths[0].GetPath())
+
+	l2.SetSrcLatency(int64(50 * time.Millisecond))
+	paths, err = network.KShortestPaths(r0, r3, 1, &xt.PathConstraints{MaxLinkLatency: 10 * time.Millisecond})
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, paths[0].GetPath())
+
+	// constraints provided by a strategy are applied to the service's paths
+	strategy := xt_latency.NewFactoryWithPathConstraints(&xt.PathConstraints{MaxLinkLatency: 10 * time.Millisecond}).NewStrategy()
+	nodes, _, err := network.shortestConstrainedPath(r0, r3, getPathConstraints(strategy, "svc"))
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, nodes)
+
+	req.Nil(getPathConstraints(xt_latency.NewFactory().NewStrategy(), "svc"))
+
+	_, err = network.KShortestPaths(r0, r3, 1, &xt.PathConstraints{AvoidRouterTags: []string{"region"}, MaxHops: 1, TraverseRouterTags: []string{"region"}})
+	req.Error(err)
+
+	constraints := &xt.PathConstraints{AvoidRouterTags: []string{"region=eu"}}
+	path, cerr := network.CreatePathWithConstraints([]*Router{r0, r2, r3}, constraints)
+	req.NoError(cerr)
+	updated, err := network.UpdatePath(path)
+	req.NoError(err)
+	req.Equal([]*Router{r0, r2, r3}, updated.Nodes)
+
+	_, cerr = network.CreatePathWithConstraints([]*Router{r0, r1, r3}, constraints)
+	req.Error(cerr)
+}
+
+func TestRouterAttributeSelectors(t *testing.T) {
+	req := require.New(t)
+
+	r := newRouterForTest("r0", "", nil, nil, 0, false)
+	r.Tags = map[string]interface{}{"region": "eu", "hub": true}
+	req.True(r.MatchesSelector("region=eu"))
+	req.True(r.MatchesSelector("hub"))
+	req.False(r.MatchesSelector("zone"))
+
+	// attributes take precedence over tags of the same name
+	r.Region = "us-east"
+	r.Zone = "us-east-1a"
+	req.True(r.MatchesSelector("region=us-east"))
+	req.False(r.MatchesSelector("region=eu"))
+	req.True(r.MatchesSelector("zone"))
+	req.True(r.MatchesSelector("zone=us-east-1a"))
+	req.False(r.MatchesSelector("provider=aws"))
+}
+
+func TestRouterTagEditsApplyToSelectors(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	req.False(r0.MatchesSelector("region=eu"))
+
+	update := newRouterForTest(r0.Id, r0.Name, nil, nil, 0, false)
+	update.Tags = map[string]interface{}{"region": "eu"}
+	req.NoError(network.Routers.Update(update, fields.UpdatedFieldsMap{boltz.FieldTags: struct{}{}}, change.New()))
+
+	// the connected router instance picks up the edit, without its Tags field being replaced
+	req.True(r0.MatchesSelector("region=eu"))
+	req.Nil(r0.Tags["region"])
+
+	// location edits are picked up the same way, and take precedence over tags
+	update.Region = "us-east"
+	update.Zone = "us-east-1a"
+	req.NoError(network.Routers.Update(update, fields.UpdatedFieldsMap{
+		db.FieldRouterRegion: struct{}{},
+		db.FieldRouterZone:   struct{}{},
+	}, change.New()))
+
+	req.True(r0.MatchesSelector("region=us-east"))
+	req.True(r0.MatchesSelector("zone=us-east-1a"))
+	req.False(r0.MatchesSelector("region=eu"))
+	req.Equal("us-east", r0.GetRegion())
+	req.Equal("", r0.Region)
+}
+
+func TestCrossRegionTerminatorCost(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r0.Region = "us-east"
+	r1.Region = "us-west"
+	r2.Region = "us-east"
+
+	addLink := func(id string, src, dst *Router, cost int32) {
+		l := newTestLink(id, "tls")
+		l.Src = src
+		l.Dst = dst
+		l.SetStaticCost(cost)
+		l.addState(newLinkState(Connected))
+		network.linkController.add(l)
+	}
+	addLink("l0", r0, r1, 10)
+	addLink("l1", r0, r2, 100)
+
+	svc := entityHelper.addTestService("svc")
+	entityHelper.addTestTerminator(svc.Id, r1.Id, "", true)
+	entityHelper.addTestTerminator(svc.Id, r2.Id, "", true)
+	svc, err = network.Services.Read(svc.Id)
+	req.NoError(err)
+
+	// the terminator in the same region as the ingress router is preferred, even though its path is more expensive
+	_, terminator, path, cerr := network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	req.NoError(cerr)
+	req.Equal(r2.Id, terminator.GetRouterId())
+	req.Equal([]*Router{r0, r2}, path)
+
+	network.options.CrossRegionCost = 0
+	_, terminator, _, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	req.NoError(cerr)
+	req.Equal(r1.Id, terminator.GetRouterId())
+}
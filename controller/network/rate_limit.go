@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/fabric/common/pb/cmd_pb"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/xt"
+)
+
+// getCircuitRateLimit returns the rate limit to apply to circuits for the given service and terminator. If both
+// have a limit configured, the more restrictive limit is used. Returns nil if neither is limited.
+func getCircuitRateLimit(svc *Service, terminator xt.Terminator) *ctrl_pb.RateLimit {
+	var rateLimit *db.RateLimit
+	if svc != nil {
+		rateLimit = svc.RateLimit
+	}
+
+	switch t := terminator.(type) {
+	case *Terminator:
+		rateLimit = rateLimit.Min(t.RateLimit)
+	case *RoutingTerminator:
+		rateLimit = rateLimit.Min(t.RateLimit)
+	}
+
+	if rateLimit == nil || rateLimit.BytesPerSecond <= 0 {
+		return nil
+	}
+
+	return &ctrl_pb.RateLimit{
+		BytesPerSecond: uint64(rateLimit.BytesPerSecond),
+		BurstBytes:     uint64(rateLimit.BurstBytes),
+	}
+}
+
+func rateLimitToProto(rateLimit *db.RateLimit) *cmd_pb.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	return &cmd_pb.RateLimit{
+		BytesPerSecond: rateLimit.BytesPerSecond,
+		BurstBytes:     rateLimit.BurstBytes,
+	}
+}
+
+func rateLimitFromProto(msg *cmd_pb.RateLimit) *db.RateLimit {
+	if msg == nil {
+		return nil
+	}
+	return &db.RateLimit{
+		BytesPerSecond: msg.BytesPerSecond,
+		BurstBytes:     msg.BurstBytes,
+	}
+}
@@ -0,0 +1,98 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt_smartrouting"
+)
+
+func TestCircuitRateLimit(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, err := NewNetwork(newTestConfig(ctx))
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	router := entityHelper.addTestRouter()
+
+	svc := &Service{
+		BaseEntity:         models.BaseEntity{Id: "rate-limited-svc"},
+		Name:               "rate-limited-svc",
+		TerminatorStrategy: xt_smartrouting.Name,
+		RateLimit:          &db.RateLimit{BytesPerSecond: 100000},
+	}
+	ctx.NoError(network.Services.Create(svc, change.New()))
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "rate-limited-terminator"},
+		Service:    svc.Id,
+		Router:     router.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1001",
+		RateLimit:  &db.RateLimit{BytesPerSecond: 200000, BurstBytes: 50000},
+	}
+	ctx.NoError(network.Terminators.Create(terminator, change.New()))
+
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+	ctx.Equal(int64(100000), svc.RateLimit.BytesPerSecond)
+
+	terminator, err = network.Terminators.Read(terminator.Id)
+	ctx.NoError(err)
+	ctx.Equal(int64(200000), terminator.RateLimit.BytesPerSecond)
+	ctx.Equal(int64(50000), terminator.RateLimit.BurstBytes)
+
+	// the most restrictive rate and burst are used
+	rateLimit := getCircuitRateLimit(svc, &RoutingTerminator{Terminator: terminator})
+	ctx.NotNil(rateLimit)
+	ctx.Equal(uint64(100000), rateLimit.BytesPerSecond)
+	ctx.Equal(uint64(50000), rateLimit.BurstBytes)
+
+	svc.RateLimit = nil
+	ctx.NoError(network.Services.Update(svc, nil, change.New()))
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+	ctx.Nil(svc.RateLimit)
+
+	rateLimit = getCircuitRateLimit(svc, terminator)
+	ctx.Equal(uint64(200000), rateLimit.BytesPerSecond)
+
+	terminator.RateLimit = nil
+	ctx.Nil(getCircuitRateLimit(svc, terminator))
+
+	// limits must be positive
+	terminator.RateLimit = &db.RateLimit{BytesPerSecond: 0}
+	err = network.Terminators.Update(terminator, nil, change.New())
+	ctx.Error(err)
+	ctx.Contains(err.Error(), db.FieldRateLimit)
+
+	// ensure the persisted values round trip through the raft command encoding
+	bytes, err := network.Services.Marshall(&Service{
+		BaseEntity: models.BaseEntity{Id: "x", Tags: map[string]interface{}{}},
+		RateLimit:  &db.RateLimit{BytesPerSecond: 10, BurstBytes: 5},
+	})
+	ctx.NoError(err)
+	unmarshalled, err := network.Services.Unmarshall(bytes)
+	ctx.NoError(err)
+	ctx.Equal(&db.RateLimit{BytesPerSecond: 10, BurstBytes: 5}, unmarshalled.RateLimit)
+}
@@ -409,3 +409,194 @@ func BenchmarkMoreRealisticShortestPathPerf(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkUncachedShortestPathPerf(b *testing.B) {
+	b.StopTimer()
+	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
+
+	ctx := db.NewTestContext(b)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	var routers []*Router
+
+	for i := 0; i < 400; i++ {
+		router := entityHelper.addTestRouter()
+		routers = append(routers, router)
+	}
+
+	linkIdx := 0
+
+	r := rand.New(rand.NewSource(1))
+
+	nextCost := func() int64 {
+		v := r.Uint32()
+		return int64(v % 1000)
+	}
+
+	addLink := func(srcRouter, dstRouter *Router) {
+		if srcRouter != dstRouter {
+			link := newTestLink(fmt.Sprintf("link-%04d", linkIdx), "tls")
+			link.SetStaticCost(int32(nextCost()))
+			link.SetDstLatency(nextCost() * 100_000)
+			link.SetSrcLatency(nextCost() * 100_000)
+			link.Src = srcRouter
+			link.Dst = dstRouter
+			link.addState(newLinkState(Connected))
+			network.linkController.add(link)
+			linkIdx++
+		}
+	}
+
+	for _, srcRouter := range routers {
+		for _, dstRouter := range routers {
+			addLink(srcRouter, dstRouter)
+		}
+	}
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		srcRouter := routers[r.Intn(len(routers))]
+		dstRouter := routers[r.Intn(len(routers))]
+		_, _, err := network.shortestPathMatching(srcRouter, dstRouter, nil, nil)
+		ctx.NoError(err)
+	}
+}
+
+func BenchmarkShortestPathPerfWithLinkChanges(b *testing.B) {
+	b.StopTimer()
+	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
+
+	ctx := db.NewTestContext(b)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	var routers []*Router
+
+	for i := 0; i < 400; i++ {
+		router := entityHelper.addTestRouter()
+		routers = append(routers, router)
+	}
+
+	linkIdx := 0
+
+	r := rand.New(rand.NewSource(1))
+
+	nextCost := func() int64 {
+		v := r.Uint32()
+		return int64(v % 1000)
+	}
+
+	var links []*Link
+
+	addLink := func(srcRouter, dstRouter *Router) {
+		if srcRouter != dstRouter {
+			link := newTestLink(fmt.Sprintf("link-%04d", linkIdx), "tls")
+			link.SetStaticCost(int32(nextCost()))
+			link.SetDstLatency(nextCost() * 100_000)
+			link.SetSrcLatency(nextCost() * 100_000)
+			link.Src = srcRouter
+			link.Dst = dstRouter
+			link.addState(newLinkState(Connected))
+			network.linkController.add(link)
+			links = append(links, link)
+			linkIdx++
+		}
+	}
+
+	for _, srcRouter := range routers {
+		for _, dstRouter := range routers {
+			addLink(srcRouter, dstRouter)
+		}
+	}
+
+	// paths are mostly requested from a small set of ingress routers
+	ingressRouters := routers[:20]
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		if i%10 == 0 {
+			links[r.Intn(len(links))].SetSrcLatency(nextCost() * 100_000)
+		}
+		srcRouter := ingressRouters[r.Intn(len(ingressRouters))]
+		dstRouter := routers[r.Intn(len(routers))]
+		_, _, err := network.shortestPath(srcRouter, dstRouter)
+		ctx.NoError(err)
+	}
+}
+
+func BenchmarkLargeSparseMeshShortestPathPerf(b *testing.B) {
+	b.StopTimer()
+	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
+
+	ctx := db.NewTestContext(b)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	var routers []*Router
+
+	for i := 0; i < 2000; i++ {
+		router := entityHelper.addTestRouter()
+		routers = append(routers, router)
+	}
+
+	linkIdx := 0
+
+	r := rand.New(rand.NewSource(1))
+
+	nextCost := func() int64 {
+		v := r.Uint32()
+		return int64(v % 1000)
+	}
+
+	addLink := func(srcRouter, dstRouter *Router) {
+		if srcRouter != dstRouter {
+			link := newTestLink(fmt.Sprintf("link-%05d", linkIdx), "tls")
+			link.SetStaticCost(int32(nextCost()))
+			link.SetDstLatency(nextCost() * 100_000)
+			link.SetSrcLatency(nextCost() * 100_000)
+			link.Src = srcRouter
+			link.Dst = dstRouter
+			link.addState(newLinkState(Connected))
+			network.linkController.add(link)
+			linkIdx++
+		}
+	}
+
+	// a ring, so everything is reachable, plus a handful of random links per router
+	for idx, srcRouter := range routers {
+		addLink(srcRouter, routers[(idx+1)%len(routers)])
+		for j := 0; j < 4; j++ {
+			addLink(srcRouter, routers[r.Intn(len(routers))])
+		}
+	}
+
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		srcRouter := routers[r.Intn(len(routers))]
+		dstRouter := routers[r.Intn(len(routers))]
+		_, _, err := network.shortestPath(srcRouter, dstRouter)
+		ctx.NoError(err)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/openziti/foundation/v2/versions"
 	"google.golang.org/protobuf/proto"
 	"reflect"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -65,7 +66,23 @@ type Router struct {
 	Cost        uint16
 	NoTraversal bool
 	Disabled    bool
+	Region      string
+	Zone        string
+	Provider    string
+	Maintenance bool
 	Metadata    *ctrl_pb.RouterMetadata
+
+	// selectorTags and location hold tag and location edits made while the router is cached or connected. The Tags,
+	// Region, Zone and Provider fields are read without locking, so they aren't replaced in place.
+	selectorTags atomic.Pointer[map[string]interface{}]
+	location     atomic.Pointer[routerLocation]
+}
+
+// routerLocation holds where a router is deployed, so that it can be replaced as a whole when the router is edited
+type routerLocation struct {
+	region   string
+	zone     string
+	provider string
 }
 
 func (entity *Router) toBolt() *db.Router {
@@ -76,7 +93,74 @@ func (entity *Router) toBolt() *db.Router {
 		Cost:          entity.Cost,
 		NoTraversal:   entity.NoTraversal,
 		Disabled:      entity.Disabled,
+		Region:        entity.Region,
+		Zone:          entity.Zone,
+		Provider:      entity.Provider,
+		Maintenance:   entity.Maintenance,
+	}
+}
+
+// MatchesSelector returns true if the router matches the given router selector. Selectors are either a name, which
+// matches routers which have a value for it, or name=value. The region, zone and provider names match the router's
+// attributes, if set, otherwise selectors match the router's tags.
+func (entity *Router) MatchesSelector(selector string) bool {
+	name, value, hasValue := strings.Cut(selector, "=")
+	if attr := entity.getAttribute(name); attr != "" {
+		return !hasValue || attr == value
+	}
+	return xt.MatchesRouterSelector(entity.getSelectorTags(), selector)
+}
+
+// getSelectorTags returns the current tags of the router, including edits made since it was loaded
+func (entity *Router) getSelectorTags() map[string]interface{} {
+	if tags := entity.selectorTags.Load(); tags != nil {
+		return *tags
+	}
+	return entity.Tags
+}
+
+// getLocation returns the current location of the router, including edits made since it was loaded
+func (entity *Router) getLocation() *routerLocation {
+	if location := entity.location.Load(); location != nil {
+		return location
+	}
+	return &routerLocation{
+		region:   entity.Region,
+		zone:     entity.Zone,
+		provider: entity.Provider,
+	}
+}
+
+// GetRegion returns the current region of the router, including edits made since it was loaded
+func (entity *Router) GetRegion() string {
+	return entity.getLocation().region
+}
+
+// GetZone returns the current zone of the router, including edits made since it was loaded
+func (entity *Router) GetZone() string {
+	return entity.getLocation().zone
+}
+
+// GetProvider returns the current provider of the router, including edits made since it was loaded
+func (entity *Router) GetProvider() string {
+	return entity.getLocation().provider
+}
+
+func (entity *Router) getAttribute(name string) string {
+	switch name {
+	case db.FieldRouterRegion:
+		return entity.GetRegion()
+	case db.FieldRouterZone:
+		return entity.GetZone()
+	case db.FieldRouterProvider:
+		return entity.GetProvider()
 	}
+	return ""
+}
+
+// isNoTraversal returns true if the router may be the end of a path, but not used as transit
+func (entity *Router) isNoTraversal() bool {
+	return entity.NoTraversal || entity.Maintenance
 }
 
 func (entity *Router) AddLinkListener(addr, linkProtocol string, linkCostTags []string, groups []string) {
@@ -151,6 +235,7 @@ func (self *RouterManager) markConnected(r *Router) {
 
 	r.Connected.Store(true)
 	self.connected.Set(r.Id, r)
+	self.network.linkController.routingTable.clear()
 }
 
 func (self *RouterManager) markDisconnected(r *Router) {
@@ -163,6 +248,7 @@ func (self *RouterManager) markDisconnected(r *Router) {
 		return exists
 	})
 	r.routerLinks.Clear()
+	self.network.linkController.routingTable.clear()
 }
 
 func (self *RouterManager) IsConnected(id string) bool {
@@ -249,6 +335,10 @@ func (self *RouterManager) populateRouter(entity *Router, _ *bbolt.Tx, boltEntit
 	entity.Cost = boltRouter.Cost
 	entity.NoTraversal = boltRouter.NoTraversal
 	entity.Disabled = boltRouter.Disabled
+	entity.Region = boltRouter.Region
+	entity.Zone = boltRouter.Zone
+	entity.Provider = boltRouter.Provider
+	entity.Maintenance = boltRouter.Maintenance
 	entity.FillCommon(boltRouter)
 	return nil
 }
@@ -372,6 +462,13 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 	if router, err := self.readUncached(id); err != nil {
 		log.WithError(err).Error("failed to read router for cache update")
 	} else {
+		// the links a router may have under the link topology depend on its location and tags
+		topologyChanged := false
+		if connected, found := self.connected.Get(id); found {
+			topologyChanged = *connected.getLocation() != *router.getLocation() ||
+				!reflect.DeepEqual(connected.getSelectorTags(), router.Tags)
+		}
+
 		updateCb := func(key string, v *Router, exist bool) bool {
 			if !exist {
 				return false
@@ -382,6 +479,10 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 			v.Cost = router.Cost
 			v.NoTraversal = router.NoTraversal
 			v.Disabled = router.Disabled
+			v.location.Store(router.getLocation())
+			v.Maintenance = router.Maintenance
+			tags := router.Tags
+			v.selectorTags.Store(&tags)
 
 			if v.Disabled {
 				if ctrl := v.Control; ctrl != nil {
@@ -395,6 +496,11 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 
 		self.cache.RemoveCb(id, updateCb)
 		self.connected.RemoveCb(id, updateCb)
+		self.network.linkController.routingTable.clear()
+
+		if topologyChanged {
+			go self.Managers.RouterMessaging.RouterTopologyChanged(id)
+		}
 	}
 }
 
@@ -421,6 +527,10 @@ func (self *RouterManager) Marshall(entity *Router) ([]byte, error) {
 		NoTraversal: entity.NoTraversal,
 		Disabled:    entity.Disabled,
 		Tags:        tags,
+		Region:      entity.Region,
+		Zone:        entity.Zone,
+		Provider:    entity.Provider,
+		Maintenance: entity.Maintenance,
 	}
 
 	return proto.Marshal(msg)
@@ -448,6 +558,10 @@ func (self *RouterManager) Unmarshall(bytes []byte) (*Router, error) {
 		Cost:        uint16(msg.Cost),
 		NoTraversal: msg.NoTraversal,
 		Disabled:    msg.Disabled,
+		Region:      msg.Region,
+		Zone:        msg.Zone,
+		Provider:    msg.Provider,
+		Maintenance: msg.Maintenance,
 	}, nil
 }
 
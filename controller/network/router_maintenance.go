@@ -0,0 +1,259 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/channel/v2/protobufs"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+)
+
+const routerMaintenanceRerouteInterval = 5 * time.Second
+
+// StartMaintenance puts the router into maintenance. Routers in maintenance aren't used as transit for new paths and
+// circuits which transit them are rerouted. Circuits which start or end at the router aren't affected. Once no
+// circuits transit the router, it's notified that it's safe to stop.
+func (self *RouterManager) StartMaintenance(id string, ctx *change.Context) error {
+	return self.updateMaintenance(id, true, ctx)
+}
+
+// EndMaintenance returns a router in maintenance to service, so it can be used as transit again
+func (self *RouterManager) EndMaintenance(id string, ctx *change.Context) error {
+	return self.updateMaintenance(id, false, ctx)
+}
+
+func (self *RouterManager) updateMaintenance(id string, maintenance bool, ctx *change.Context) error {
+	router := &Router{
+		BaseEntity:  models.BaseEntity{Id: id},
+		Maintenance: maintenance,
+	}
+	return self.Update(router, fields.UpdatedFieldsMap{db.FieldRouterMaintenance: struct{}{}}, ctx)
+}
+
+// transitsRouter returns true if the path passes through the given router, rather than starting or ending there
+func (self *Path) transitsRouter(routerId string) bool {
+	for i := 1; i < len(self.Nodes)-1; i++ {
+		if self.Nodes[i].Id == routerId {
+			return true
+		}
+	}
+	return false
+}
+
+// transitsMaintenance returns true if the path passes through a router which is in maintenance
+func (self *Path) transitsMaintenance() bool {
+	for i := 1; i < len(self.Nodes)-1; i++ {
+		if self.Nodes[i].Maintenance {
+			return true
+		}
+	}
+	return false
+}
+
+// transitsRouter returns true if the backup path detours through the given router
+func (self *BackupPath) transitsRouter(routerId string) bool {
+	for i := 1; i < len(self.Nodes)-1; i++ {
+		if self.Nodes[i].Id == routerId {
+			return true
+		}
+	}
+	return false
+}
+
+// pathsTransitRouter returns true if the primary or secondary path of the circuit passes through the given router
+func (self *Circuit) pathsTransitRouter(routerId string) bool {
+	return self.Path.transitsRouter(routerId) || (self.SecondaryPath != nil && self.SecondaryPath.transitsRouter(routerId))
+}
+
+// transitsRouter returns true if any path of the circuit, including its backup paths, passes through the given router
+func (self *Circuit) transitsRouter(routerId string) bool {
+	if self.pathsTransitRouter(routerId) {
+		return true
+	}
+	for _, backup := range self.BackupPaths {
+		if backup.transitsRouter(routerId) {
+			return true
+		}
+	}
+	return false
+}
+
+// routerMaintenanceMonitor reroutes circuits transiting routers in maintenance. As the number of transit circuits
+// changes, it reports progress to handlers and to the router itself. Circuits are owned by the controller which
+// created them, so every controller monitors its own circuits and routers may receive status from each controller.
+type routerMaintenanceMonitor struct {
+	network  *Network
+	states   map[string]*routerMaintenanceState
+	handlers []RouterMaintenanceHandler
+	lock     sync.Mutex
+}
+
+type routerMaintenanceState struct {
+	transitCircuits int
+	notifiedCtrl    channel.Channel
+	lastAttempt     map[string]time.Time
+}
+
+func newRouterMaintenanceMonitor(network *Network) *routerMaintenanceMonitor {
+	return &routerMaintenanceMonitor{
+		network: network,
+		states:  map[string]*routerMaintenanceState{},
+	}
+}
+
+func (self *routerMaintenanceMonitor) addHandler(handler RouterMaintenanceHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.handlers = append(append([]RouterMaintenanceHandler{}, self.handlers...), handler)
+}
+
+func (self *routerMaintenanceMonitor) getHandlers() []RouterMaintenanceHandler {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.handlers
+}
+
+func (self *routerMaintenanceMonitor) run() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			self.checkRouters(now)
+		case <-self.network.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *routerMaintenanceMonitor) checkRouters(now time.Time) {
+	current := map[string]*Router{}
+	for _, r := range self.network.AllConnectedRouters() {
+		if r.Maintenance {
+			current[r.Id] = r
+		}
+	}
+
+	for routerId := range self.states {
+		if _, found := current[routerId]; !found {
+			delete(self.states, routerId)
+			// if the router is still connected, it's no longer in maintenance, rather than having been stopped
+			if r := self.network.GetConnectedRouter(routerId); r != nil {
+				pfxlog.Logger().WithField("routerId", routerId).Info("router maintenance ended")
+				self.sendStatus(r, &ctrl_pb.RouterMaintenanceStatus{})
+			}
+		}
+	}
+
+	if len(current) == 0 {
+		return
+	}
+
+	transit := map[string][]*Circuit{}
+	for _, circuit := range self.network.GetAllCircuits() {
+		for routerId := range current {
+			if circuit.transitsRouter(routerId) {
+				transit[routerId] = append(transit[routerId], circuit)
+			}
+		}
+	}
+
+	for routerId, r := range current {
+		state, found := self.states[routerId]
+		if !found {
+			state = &routerMaintenanceState{
+				transitCircuits: -1,
+				lastAttempt:     map[string]time.Time{},
+			}
+			self.states[routerId] = state
+			pfxlog.Logger().WithField("routerId", routerId).Info("router maintenance started, rerouting transit circuits")
+		}
+
+		circuits := transit[routerId]
+		attempted := state.lastAttempt
+		state.lastAttempt = map[string]time.Time{}
+		for _, circuit := range circuits {
+			lastAttempt, found := attempted[circuit.Id]
+			if found && now.Sub(lastAttempt) < routerMaintenanceRerouteInterval {
+				state.lastAttempt[circuit.Id] = lastAttempt
+				continue
+			}
+			state.lastAttempt[circuit.Id] = now
+			go self.reroute(circuit, r)
+		}
+
+		if len(circuits) != state.transitCircuits || r.Control != state.notifiedCtrl {
+			state.transitCircuits = len(circuits)
+			state.notifiedCtrl = r.Control
+			self.notify(r, len(circuits))
+		}
+	}
+}
+
+// reroute moves the circuit off the router in maintenance. If the router is only on backup paths, just the backup
+// paths are recomputed. If the router is no longer used by the circuit, it's told to drop its forwarding entries for
+// it, so the router can see that it's no longer carrying the circuit.
+func (self *routerMaintenanceMonitor) reroute(circuit *Circuit, r *Router) {
+	log := pfxlog.Logger().WithField("circuitId", circuit.Id).WithField("routerId", r.Id)
+	deadline := time.Now().Add(DefaultOptionsRouteTimeout)
+	if circuit.pathsTransitRouter(r.Id) {
+		if err := self.network.rerouteCircuit(circuit, deadline); err != nil {
+			log.WithError(err).Warn("unable to reroute circuit away from router in maintenance")
+			return
+		}
+	} else {
+		self.network.rerouteBackupPaths(circuit, deadline)
+	}
+
+	if !circuit.HasRouter(r.Id) && r.Control != nil {
+		if err := sendUnroute(r, circuit.Id, false); err != nil {
+			log.WithError(err).Error("error sending unroute to router in maintenance")
+		}
+	}
+}
+
+func (self *routerMaintenanceMonitor) notify(r *Router, transitCircuits int) {
+	pfxlog.Logger().WithField("routerId", r.Id).WithField("transitCircuits", transitCircuits).Info("router maintenance progress")
+
+	self.sendStatus(r, &ctrl_pb.RouterMaintenanceStatus{
+		Maintenance:     true,
+		TransitCircuits: uint32(transitCircuits),
+		SafeToStop:      transitCircuits == 0,
+	})
+
+	for _, handler := range self.getHandlers() {
+		handler.RouterMaintenanceProgress(r, transitCircuits)
+	}
+}
+
+func (self *routerMaintenanceMonitor) sendStatus(r *Router, status *ctrl_pb.RouterMaintenanceStatus) {
+	if r.Control == nil {
+		return
+	}
+	if err := protobufs.MarshalTyped(status).Send(r.Control); err != nil {
+		pfxlog.Logger().WithField("routerId", r.Id).WithError(err).Error("unable to send maintenance status to router")
+	}
+}
@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openziti/fabric/common/logcontext"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+)
+
+type testMaintenanceHandler chan int
+
+func (self testMaintenanceHandler) RouterMaintenanceProgress(_ *Router, transitCircuits int) {
+	self <- transitCircuits
+}
+
+func (self testMaintenanceHandler) next(ctx *db.TestContext) int {
+	select {
+	case transitCircuits := <-self:
+		return transitCircuits
+	case <-time.After(5 * time.Second):
+		ctx.FailNow("timed out waiting for maintenance progress")
+		return -1
+	}
+}
+
+func TestRouterMaintenance(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	handler := testMaintenanceHandler(make(chan int, 16))
+	network.AddRouterMaintenanceHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+
+	addLink := func(id string, src, dst *Router, cost int32) {
+		l := newTestLink(id, "tls")
+		l.Src = src
+		l.Dst = dst
+		l.SetStaticCost(cost)
+		l.addState(newLinkState(Connected))
+		network.linkController.add(l)
+	}
+	addLink("l0", r0, r1, 2)
+	addLink("l1", r1, r3, 2)
+	addLink("l2", r0, r2, 20)
+	addLink("l3", r2, r3, 20)
+
+	svc := entityHelper.addTestService("svc")
+	entityHelper.addTestTerminator(svc.Id, r3.Id, "", true)
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+
+	_, terminator, pathNodes, cerr := network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r0, r1, r3}, pathNodes)
+
+	path, cerr := network.CreatePathWithNodes(pathNodes)
+	ctx.NoError(cerr)
+
+	circuit := &Circuit{
+		Id:         uuid.NewString(),
+		Service:    svc,
+		Path:       path,
+		Terminator: terminator,
+		CreatedAt:  time.Now(),
+	}
+	network.circuitController.add(circuit)
+
+	// the reroute is treated as already in progress, so the monitor leaves the circuit alone
+	circuit.Rerouting.Store(true)
+
+	ctx.NoError(network.Routers.StartMaintenance(r1.Id, change.New()))
+	ctx.True(network.GetConnectedRouter(r1.Id).Maintenance)
+	ctx.False(network.isPathUsable(path))
+
+	// new paths avoid the router in maintenance
+	_, _, pathNodes, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r0, r2, r3}, pathNodes)
+
+	// a router in maintenance may still be the end of a path
+	_, _, pathNodes, cerr = network.selectPath(r1, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r1, r3}, pathNodes)
+
+	ctx.Equal(1, handler.next(ctx))
+
+	// once no circuits transit the router, it's safe to stop
+	network.circuitController.remove(circuit)
+	ctx.Equal(0, handler.next(ctx))
+
+	// circuits with a backup path detouring through the router also hold it up
+	backupPath, cerr := network.CreatePathWithNodes([]*Router{r0, r2, r3})
+	ctx.NoError(cerr)
+
+	backupCircuit := &Circuit{
+		Id:          uuid.NewString(),
+		Service:     svc,
+		Path:        backupPath,
+		Terminator:  terminator,
+		CreatedAt:   time.Now(),
+		BackupPaths: []*BackupPath{{ProtectedLink: backupPath.Links[0], Nodes: []*Router{r0, r1, r2}}},
+	}
+	backupCircuit.Rerouting.Store(true)
+	ctx.True(backupCircuit.transitsRouter(r1.Id))
+	ctx.False(backupCircuit.pathsTransitRouter(r1.Id))
+
+	network.circuitController.add(backupCircuit)
+	ctx.Equal(1, handler.next(ctx))
+
+	network.circuitController.remove(backupCircuit)
+	ctx.Equal(0, handler.next(ctx))
+
+	// replacing the router leaves it in maintenance
+	router, err := network.Routers.Read(r1.Id)
+	ctx.NoError(err)
+	ctx.NoError(network.Routers.Update(router, nil, change.New()))
+	router, err = network.Routers.Read(r1.Id)
+	ctx.NoError(err)
+	ctx.True(router.Maintenance)
+
+	ctx.NoError(network.Routers.EndMaintenance(r1.Id, change.New()))
+	ctx.False(network.GetConnectedRouter(r1.Id).Maintenance)
+
+	_, _, pathNodes, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r0, r1, r3}, pathNodes)
+
+	ctx.Error(network.Routers.StartMaintenance(uuid.NewString(), change.New()))
+}
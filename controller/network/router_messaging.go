@@ -55,6 +55,8 @@ type RouterMessaging struct {
 	eventsC        chan routerEvent
 	routers        map[string]*routerUpdates
 	routerCommPool goroutines.Pool
+	linkTopology   atomic.Pointer[linkTopology]
+	noHubsReported bool
 }
 
 func (self *RouterMessaging) RouterConnected(r *Router) {
@@ -69,6 +71,11 @@ func (self *RouterMessaging) RouterDeleted(routerId string) {
 	self.routerChanged(routerId, false)
 }
 
+// RouterTopologyChanged recalculates the link topology for a connected router whose location or tags have been edited
+func (self *RouterMessaging) RouterTopologyChanged(routerId string) {
+	self.queueEvent(&routerTopologyChangedEvent{routerId: routerId})
+}
+
 func (self *RouterMessaging) routerChanged(routerId string, connected bool) {
 	self.queueEvent(&routerChangedEvent{
 		routerId:  routerId,
@@ -135,7 +142,14 @@ func (self *RouterMessaging) syncStates() {
 
 		for routerId := range updates.changedRouters {
 			router := self.managers.Routers.getConnected(routerId)
-			if router != nil {
+			if router != nil && !self.IsLinkAllowed(notifyRouterId, routerId) {
+				// routers which shouldn't link to each other are told the other router is gone, which also
+				// closes any links they already have
+				changes.Changes = append(changes.Changes, &ctrl_pb.PeerStateChange{
+					Id:    routerId,
+					State: ctrl_pb.PeerState_Removed,
+				})
+			} else if router != nil {
 				changes.Changes = append(changes.Changes, &ctrl_pb.PeerStateChange{
 					Id:        routerId,
 					Version:   router.VersionInfo.Version,
@@ -206,6 +220,99 @@ func (self *routerChangedEvent) handle(c *RouterMessaging) {
 			sourceRouterState.stateUpdated(router.Id)
 		}
 	}
+
+	c.updateLinkTopology(self.routerId, routers)
+}
+
+type routerTopologyChangedEvent struct {
+	routerId string
+}
+
+func (self *routerTopologyChangedEvent) handle(c *RouterMessaging) {
+	c.updateLinkTopology(self.routerId, c.managers.Routers.allConnected())
+}
+
+// IsLinkAllowed returns true if the link topology policy allows the given routers to link to each other
+func (self *RouterMessaging) IsLinkAllowed(routerId, peerId string) bool {
+	return self.linkTopology.Load().isLinkAllowed(routerId, peerId)
+}
+
+// updateLinkTopology updates the link topology for a change to the given router. Routers on either side of a pair
+// whose link status changed are sent updated peer states.
+func (self *RouterMessaging) updateLinkTopology(routerId string, routers []*Router) {
+	options := &self.managers.network.options.LinkTopology
+	if options.isFullMesh() {
+		return
+	}
+
+	self.checkLinkTopologyHubs(options, routers)
+
+	previous := self.linkTopology.Load()
+	topology := updateLinkTopology(options, previous, routerId, routers)
+	self.linkTopology.Store(topology)
+
+	changed := 0
+	pairChanged := func(router, peer string) {
+		self.getRouterStates(router).stateUpdated(peer)
+		self.getRouterStates(peer).stateUpdated(router)
+		changed++
+	}
+
+	if previous == nil {
+		for i, router := range routers {
+			for _, peer := range routers[i+1:] {
+				if !topology.isLinkAllowed(router.Id, peer.Id) {
+					pairChanged(router.Id, peer.Id)
+				}
+			}
+		}
+	} else {
+		for router, peers := range previous.peers {
+			for peer := range peers {
+				if router < peer && !topology.isLinkAllowed(router, peer) {
+					pairChanged(router, peer)
+				}
+			}
+		}
+		for router, peers := range topology.peers {
+			for peer := range peers {
+				if router < peer && !previous.isLinkAllowed(router, peer) {
+					pairChanged(router, peer)
+				}
+			}
+		}
+	}
+
+	if changed > 0 {
+		log.WithField("mode", options.Mode).
+			WithField("routerId", routerId).
+			WithField("changedPairs", changed).
+			Info("link topology updated")
+	}
+}
+
+// checkLinkTopologyHubs warns when none of the connected routers match the hub selectors, as routers which aren't
+// hubs won't be linked across regions, or at all in hub and spoke mode. The warning is only logged when the hubs
+// go missing, not on every router change.
+func (self *RouterMessaging) checkLinkTopologyHubs(options *LinkTopologyOptions, routers []*Router) {
+	if options.Mode != LinkTopologyHubAndSpoke && options.Mode != LinkTopologyRegional {
+		return
+	}
+
+	hasHub := false
+	for _, r := range routers {
+		if options.isHub(r) {
+			hasHub = true
+			break
+		}
+	}
+
+	if !hasHub && len(routers) > 0 && !self.noHubsReported {
+		log.WithField("mode", options.Mode).
+			WithField("hubs", options.HubSelectors).
+			Warn("link topology hub selectors don't match any connected routers")
+	}
+	self.noHubsReported = !hasHub && len(routers) > 0
 }
 
 type routerSendDone struct {
@@ -0,0 +1,94 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/fabric/common/pb/cmd_pb"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/xt"
+)
+
+// matchesPolicyEntry returns true if the entry is the router id, or is a selector matching the router
+func (self *Router) matchesPolicyEntry(entry string) bool {
+	return self.Id == entry || self.MatchesSelector(entry)
+}
+
+// getServicePathConstraints returns the constraints which paths for circuits of the given service must satisfy
+func getServicePathConstraints(strategy xt.Strategy, svc *Service) *xt.PathConstraints {
+	return applyRoutingPolicy(getPathConstraints(strategy, svc.Id), svc.RoutingPolicy)
+}
+
+// applyRoutingPolicy returns the given constraints, extended with the routing policy. Denied routers are added to
+// any denied by the constraints. If the policy has allowed routers, they take the place of any allowed by the
+// constraints, since the policy is the authority on where a service's traffic may go.
+func applyRoutingPolicy(constraints *xt.PathConstraints, policy *db.RoutingPolicy) *xt.PathConstraints {
+	if policy.IsEmpty() {
+		return constraints
+	}
+
+	result := &xt.PathConstraints{}
+	if constraints != nil {
+		*result = *constraints
+	}
+
+	if len(policy.AllowedRouters) > 0 {
+		result.AllowedRouters = policy.AllowedRouters
+	}
+	result.DeniedRouters = append(append([]string(nil), result.DeniedRouters...), policy.DeniedRouters...)
+	return result
+}
+
+func routingPolicyToProto(policy *db.RoutingPolicy) *cmd_pb.RoutingPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &cmd_pb.RoutingPolicy{
+		AllowedRouters: policy.AllowedRouters,
+		DeniedRouters:  policy.DeniedRouters,
+	}
+}
+
+func routingPolicyFromProto(msg *cmd_pb.RoutingPolicy) *db.RoutingPolicy {
+	if msg == nil {
+		return nil
+	}
+	return &db.RoutingPolicy{
+		AllowedRouters: msg.AllowedRouters,
+		DeniedRouters:  msg.DeniedRouters,
+	}
+}
+
+// getCurrentPathConstraints returns the path constraints for the circuit's service as it's currently configured, so
+// that changes to a service routing policy are applied to existing circuits when they're rerouted. Falls back to the
+// constraints the circuit path was created with if the service or its strategy can't be loaded.
+func (network *Network) getCurrentPathConstraints(circuit *Circuit) *xt.PathConstraints {
+	if circuit.Service == nil {
+		return circuit.Path.constraints
+	}
+
+	svc, err := network.Services.Read(circuit.Service.Id)
+	if err != nil || svc == nil {
+		return circuit.Path.constraints
+	}
+
+	strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy)
+	if err != nil {
+		return circuit.Path.constraints
+	}
+
+	return getServicePathConstraints(strategy, svc)
+}
@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openziti/fabric/common/logcontext"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+)
+
+func TestServiceRoutingPolicy(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+	r3 := entityHelper.addTestRouter()
+	r0.Region = "eu"
+	r1.Region = "eu"
+	r2.Region = "us"
+	r3.Region = "eu"
+
+	addLink := func(id string, src, dst *Router, cost int32) {
+		l := newTestLink(id, "tls")
+		l.Src = src
+		l.Dst = dst
+		l.SetStaticCost(cost)
+		l.addState(newLinkState(Connected))
+		network.linkController.add(l)
+	}
+	addLink("l0", r0, r1, 20)
+	addLink("l1", r1, r3, 20)
+	addLink("l2", r0, r2, 2)
+	addLink("l3", r2, r3, 2)
+
+	svc := entityHelper.addTestService("svc")
+	entityHelper.addTestTerminator(svc.Id, r3.Id, "", true)
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+
+	strategy, terminator, pathNodes, cerr := network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r0, r2, r3}, pathNodes)
+
+	path, cerr := network.CreatePathWithConstraints(pathNodes, getServicePathConstraints(strategy, svc))
+	ctx.NoError(cerr)
+
+	circuit := &Circuit{
+		Id:         uuid.NewString(),
+		Service:    svc,
+		Path:       path,
+		Terminator: terminator,
+		CreatedAt:  time.Now(),
+	}
+	network.circuitController.add(circuit)
+	ctx.Equal(0, len(network.getRerouteCandidates()))
+
+	svc.RoutingPolicy = &db.RoutingPolicy{AllowedRouters: []string{"region=eu"}}
+	ctx.NoError(network.Services.Update(svc, nil, change.New()))
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+	ctx.Equal([]string{"region=eu"}, svc.RoutingPolicy.AllowedRouters)
+
+	// new circuits avoid the us router, even though the path is more expensive
+	_, _, pathNodes, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal([]*Router{r0, r1, r3}, pathNodes)
+
+	// existing circuits are moved off the us router, regardless of cost
+	candidates := network.getRerouteCandidates()
+	ctx.Equal(1, len(candidates))
+	ctx.Equal([]*Router{r0, r1, r3}, candidates[0].path.Nodes)
+
+	// routers can also be denied by id
+	svc.RoutingPolicy = &db.RoutingPolicy{AllowedRouters: []string{"region=eu"}, DeniedRouters: []string{r1.Id}}
+	ctx.NoError(network.Services.Update(svc, nil, change.New()))
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+
+	_, _, _, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.Error(cerr)
+	ctx.Equal(CircuitFailureNoCompliantPath, cerr.Cause())
+
+	// the policy also applies to the ingress router
+	svc.RoutingPolicy = &db.RoutingPolicy{DeniedRouters: []string{r0.Id}}
+	ctx.NoError(network.Services.Update(svc, nil, change.New()))
+	svc, err = network.Services.Read(svc.Id)
+	ctx.NoError(err)
+
+	_, _, _, cerr = network.selectPath(r0, svc, "", "", logcontext.NewContext())
+	ctx.Error(cerr)
+	ctx.Equal(CircuitFailureNoCompliantPath, cerr.Cause())
+
+	svc.RoutingPolicy = &db.RoutingPolicy{DeniedRouters: []string{""}}
+	ctx.Error(network.Services.Update(svc, nil, change.New()))
+}
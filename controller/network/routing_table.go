@@ -0,0 +1,296 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// routingTable caches shortest path trees, keyed by source router. Trees are computed on demand, the first time a
+// path is requested from a given source, and are invalidated incrementally. A link change only invalidates the
+// trees which it could affect: a link getting worse only matters to trees which use it, while a link getting better
+// only matters to trees where it would shorten the path to one of its endpoints. Router changes clear the table.
+type routingTable struct {
+	minRouterCost uint16
+	lock          sync.Mutex
+	trees         map[string]*shortestPathTree
+	generation    uint64
+}
+
+func newRoutingTable(minRouterCost uint16) *routingTable {
+	return &routingTable{
+		minRouterCost: minRouterCost,
+		trees:         map[string]*shortestPathTree{},
+	}
+}
+
+func (self *routingTable) getTree(srcR *Router) *shortestPathTree {
+	self.lock.Lock()
+	tree, found := self.trees[srcR.Id]
+	generation := self.generation
+	self.lock.Unlock()
+
+	if found && tree.src == srcR && tree.isRouterStateCurrent() {
+		return tree
+	}
+
+	tree = computeShortestPathTree(srcR, nil, self.minRouterCost, nil, nil)
+
+	// only cache the tree if nothing changed while it was being computed
+	self.lock.Lock()
+	if self.generation == generation {
+		self.trees[srcR.Id] = tree
+	}
+	self.lock.Unlock()
+
+	return tree
+}
+
+func (self *routingTable) shortestPath(srcR, dstR *Router) ([]*Router, int64, error) {
+	return self.getTree(srcR).pathTo(dstR)
+}
+
+func (self *routingTable) size() int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return len(self.trees)
+}
+
+func (self *routingTable) clear() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.generation++
+	self.trees = map[string]*shortestPathTree{}
+}
+
+// linkWorsened should be called when a link becomes more expensive, unusable or is removed
+func (self *routingTable) linkWorsened(link *Link) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.generation++
+	for id, tree := range self.trees {
+		if tree.usesLink(link) {
+			delete(self.trees, id)
+		}
+	}
+}
+
+// linkImproved should be called when a link becomes cheaper, usable or is added
+func (self *routingTable) linkImproved(link *Link) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.generation++
+	for id, tree := range self.trees {
+		if tree.isImprovedBy(link, self.minRouterCost) {
+			delete(self.trees, id)
+		}
+	}
+}
+
+func (self *routingTable) linkCostChanged(link *Link, oldCost, newCost int64) {
+	if !link.IsUsable() {
+		return
+	}
+	if newCost > oldCost {
+		self.linkWorsened(link)
+	} else {
+		self.linkImproved(link)
+	}
+}
+
+func (self *routingTable) linkUsableChanged(link *Link, usable bool) {
+	if usable {
+		self.linkImproved(link)
+	} else {
+		self.linkWorsened(link)
+	}
+}
+
+type shortestPathTree struct {
+	src         *Router
+	dist        map[*Router]int64
+	prev        map[*Router]*Router
+	prevLink    map[*Router]*Link
+	routerState []routerPathState
+}
+
+// routerPathState records the router properties which a tree was computed with. Router properties may be updated in
+// place, so cached trees are checked against them before use.
+type routerPathState struct {
+	router      *Router
+	cost        uint16
+	noTraversal bool
+}
+
+func (self *shortestPathTree) isRouterStateCurrent() bool {
+	for _, state := range self.routerState {
+		if state.router.Cost != state.cost || state.router.isNoTraversal() != state.noTraversal {
+			return false
+		}
+	}
+	return true
+}
+
+func (self *shortestPathTree) usesLink(link *Link) bool {
+	return self.prevLink[link.Src] == link || self.prevLink[link.Dst] == link
+}
+
+func (self *shortestPathTree) isImprovedBy(link *Link, minRouterCost uint16) bool {
+	return self.isImprovedByDirection(link.Src, link.Dst, link.GetCost(), minRouterCost) ||
+		self.isImprovedByDirection(link.Dst, link.Src, link.GetCost(), minRouterCost)
+}
+
+func (self *shortestPathTree) isImprovedByDirection(from, to *Router, linkCost int64, minRouterCost uint16) bool {
+	fromDist, found := self.dist[from]
+	if !found || (from != self.src && from.isNoTraversal()) {
+		return false
+	}
+	toDist, found := self.dist[to]
+	return !found || fromDist+linkCost+int64(maxUint16(to.Cost, minRouterCost)) <= toDist
+}
+
+func (self *shortestPathTree) pathTo(dstR *Router) ([]*Router, int64, error) {
+	if dstR == nil {
+		return nil, 0, fmt.Errorf("can't route from %v -> nil", self.src.Id)
+	}
+
+	if dstR == self.src {
+		return []*Router{self.src}, 0, nil
+	}
+
+	cost, found := self.dist[dstR]
+	if !found {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v", self.src.Id, dstR.Id)
+	}
+
+	routerPath := []*Router{dstR}
+	for p := self.prev[dstR]; p != nil; p = self.prev[p] {
+		routerPath = append(routerPath, p)
+	}
+
+	for i, j := 0, len(routerPath)-1; i < j; i, j = i+1, j-1 {
+		routerPath[i], routerPath[j] = routerPath[j], routerPath[i]
+	}
+
+	if routerPath[0] != self.src {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v", self.src.Id, dstR.Id)
+	}
+
+	return routerPath, cost, nil
+}
+
+// computeShortestPathTree runs Dijkstra's algorithm from the given source, using a binary heap as the priority
+// queue. If dstR is not nil, the search stops once the destination is reached. Routers marked as no-traversal or in
+// maintenance may be the end of a path but aren't used as transit. Only routers and links accepted by the given filters
+// are considered. A nil filter accepts everything.
+func computeShortestPathTree(srcR, dstR *Router, minRouterCost uint16, routerFilter func(*Router) bool, linkFilter func(*Link) bool) *shortestPathTree {
+	tree := &shortestPathTree{
+		src:      srcR,
+		dist:     map[*Router]int64{srcR: 0},
+		prev:     map[*Router]*Router{},
+		prevLink: map[*Router]*Link{},
+	}
+
+	visited := map[*Router]struct{}{}
+	queue := &pathQueue{}
+	heap.Push(queue, &pathQueueEntry{router: srcR, dist: 0})
+
+	for queue.Len() > 0 {
+		entry := heap.Pop(queue).(*pathQueueEntry)
+		u := entry.router
+		if _, found := visited[u]; found {
+			continue
+		}
+		visited[u] = struct{}{}
+		tree.routerState = append(tree.routerState, routerPathState{router: u, cost: u.Cost, noTraversal: u.isNoTraversal()})
+
+		if u == dstR {
+			break
+		}
+
+		if u != srcR && u.isNoTraversal() {
+			continue
+		}
+
+		for _, link := range u.routerLinks.GetLinks() {
+			if !link.IsUsable() || (linkFilter != nil && !linkFilter(link)) {
+				continue
+			}
+
+			r := link.Dst
+			if r == u {
+				r = link.Src
+			}
+
+			if _, found := visited[r]; found || r == u || !r.Connected.Load() {
+				continue
+			}
+
+			if routerFilter != nil && !routerFilter(r) {
+				continue
+			}
+
+			alt := entry.dist + link.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+			if alt >= math.MaxInt32 {
+				continue
+			}
+			if current, found := tree.dist[r]; !found || alt < current {
+				tree.dist[r] = alt
+				tree.prev[r] = u
+				tree.prevLink[r] = link
+				heap.Push(queue, &pathQueueEntry{router: r, dist: alt})
+			}
+		}
+	}
+
+	return tree
+}
+
+type pathQueueEntry struct {
+	router *Router
+	dist   int64
+}
+
+type pathQueue []*pathQueueEntry
+
+func (self pathQueue) Len() int {
+	return len(self)
+}
+
+func (self pathQueue) Less(i, j int) bool {
+	return self[i].dist < self[j].dist
+}
+
+func (self pathQueue) Swap(i, j int) {
+	self[i], self[j] = self[j], self[i]
+}
+
+func (self *pathQueue) Push(x any) {
+	*self = append(*self, x.(*pathQueueEntry))
+}
+
+func (self *pathQueue) Pop() any {
+	old := *self
+	n := len(old)
+	entry := old[n-1]
+	old[n-1] = nil
+	*self = old[:n-1]
+	return entry
+}
@@ -0,0 +1,172 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRoutingTableMatchesUncachedAfterChanges(t *testing.T) {
+	pfxlog.GlobalInit(logrus.WarnLevel, pfxlog.DefaultOptions())
+
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	var routers []*Router
+	for i := 0; i < 30; i++ {
+		routers = append(routers, entityHelper.addTestRouter())
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	var links []*Link
+	for i, srcRouter := range routers {
+		// sparse mesh, so that link changes actually change paths
+		for j := 0; j < 3; j++ {
+			dstRouter := routers[r.Intn(len(routers))]
+			if dstRouter != srcRouter {
+				link := newTestLink(fmt.Sprintf("link-%03d-%d", i, j), "tls")
+				link.SetStaticCost(int32(r.Intn(100) + 1))
+				link.SetSrcLatency(0)
+				link.SetDstLatency(0)
+				link.Src = srcRouter
+				link.Dst = dstRouter
+				link.addState(newLinkState(Connected))
+				network.linkController.add(link)
+				links = append(links, link)
+			}
+		}
+	}
+
+	verify := func() {
+		for _, srcRouter := range routers[:5] {
+			for _, dstRouter := range routers {
+				cachedPath, cachedCost, cachedErr := network.shortestPath(srcRouter, dstRouter)
+				path, cost, err := network.shortestPathMatching(srcRouter, dstRouter, nil, nil)
+				if err != nil {
+					ctx.Error(cachedErr, "src: %v, dst: %v", srcRouter.Id, dstRouter.Id)
+					continue
+				}
+				ctx.NoError(cachedErr)
+				ctx.Equal(cost, cachedCost, "src: %v, dst: %v, expected: %v, actual: %v", srcRouter.Id, dstRouter.Id, path, cachedPath)
+			}
+		}
+	}
+
+	verify()
+	ctx.Equal(5, network.linkController.routingTable.size())
+
+	for i := 0; i < 200; i++ {
+		link := links[r.Intn(len(links))]
+		switch r.Intn(4) {
+		case 0:
+			link.SetStaticCost(int32(r.Intn(100) + 1))
+		case 1:
+			link.SetDown(!link.IsDown())
+		case 2:
+			link.SetSrcLatency(int64(r.Intn(50)) * 1_000_000)
+		case 3:
+			routers[r.Intn(len(routers))].Cost = uint16(r.Intn(20))
+		}
+		verify()
+	}
+}
+
+func TestRoutingTableIncrementalInvalidation(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+
+	r0 := entityHelper.addTestRouter()
+	r1 := entityHelper.addTestRouter()
+	r2 := entityHelper.addTestRouter()
+
+	newPathTestLink(network, "l0", r0, r1)
+	l1 := newPathTestLink(network, "l1", r1, r2)
+	l2 := newPathTestLink(network, "l2", r0, r2)
+	l2.SetStaticCost(5)
+
+	table := network.linkController.routingTable
+
+	_, _, err = network.shortestPath(r0, r2)
+	ctx.NoError(err)
+	_, _, err = network.shortestPath(r2, r0)
+	ctx.NoError(err)
+	ctx.Equal(2, table.size())
+
+	// l2 isn't used by either tree, so making it more expensive doesn't affect them
+	l2.SetStaticCost(6)
+	ctx.Equal(2, table.size())
+
+	// l1 is used by both trees
+	l1.SetStaticCost(10)
+	ctx.Equal(0, table.size())
+
+	path, cost, err := network.shortestPath(r0, r2)
+	ctx.NoError(err)
+	ctx.Equal([]*Router{r0, r2}, path)
+	ctx.Equal(int64(6), cost)
+	ctx.Equal(1, table.size())
+
+	// a new link which doesn't shorten any of r0's paths doesn't invalidate its tree
+	l3 := newTestLink("l3", "tls")
+	l3.Src = r1
+	l3.Dst = r2
+	l3.SrcLatency = 0
+	l3.DstLatency = 0
+	l3.SetStaticCost(20)
+	l3.addState(newLinkState(Connected))
+	network.linkController.add(l3)
+	ctx.Equal(1, table.size())
+
+	// a new link which does shorten one of r0's paths does invalidate its tree
+	newPathTestLink(network, "l4", r0, r2)
+	ctx.Equal(0, table.size())
+
+	path, cost, err = network.shortestPath(r0, r2)
+	ctx.NoError(err)
+	ctx.Equal([]*Router{r0, r2}, path)
+	ctx.Equal(int64(1), cost)
+
+	// router changes clear the table
+	_, _, err = network.shortestPath(r1, r2)
+	ctx.NoError(err)
+	ctx.Equal(2, table.size())
+	network.Routers.markDisconnected(r1)
+	ctx.Equal(0, table.size())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/common/pb/cmd_pb"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
 	"github.com/openziti/storage/boltz"
 	"github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
@@ -36,6 +37,10 @@ type Service struct {
 	models.BaseEntity
 	Name               string
 	TerminatorStrategy string
+	MultipathMode      string
+	QosClass           string
+	RateLimit          *db.RateLimit
+	RoutingPolicy      *db.RoutingPolicy
 	Terminators        []*Terminator
 }
 
@@ -43,11 +48,41 @@ func (self *Service) GetName() string {
 	return self.Name
 }
 
+func (self *Service) IsMultipath() bool {
+	return db.IsMultipathMode(self.MultipathMode)
+}
+
+func (self *Service) getMultipathMode() ctrl_pb.MultipathMode {
+	switch self.MultipathMode {
+	case db.MultipathModeStripe:
+		return ctrl_pb.MultipathMode_Stripe
+	case db.MultipathModeDuplicate:
+		return ctrl_pb.MultipathMode_Duplicate
+	default:
+		return ctrl_pb.MultipathMode_SinglePath
+	}
+}
+
+func (self *Service) getQosClass() ctrl_pb.QosClass {
+	switch self.QosClass {
+	case db.QosClassInteractive:
+		return ctrl_pb.QosClass_QosInteractive
+	case db.QosClassBulk:
+		return ctrl_pb.QosClass_QosBulk
+	default:
+		return ctrl_pb.QosClass_QosDefault
+	}
+}
+
 func (entity *Service) toBolt() *db.Service {
 	return &db.Service{
 		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
 		Name:               entity.Name,
 		TerminatorStrategy: entity.TerminatorStrategy,
+		MultipathMode:      entity.MultipathMode,
+		QosClass:           entity.QosClass,
+		RateLimit:          entity.RateLimit,
+		RoutingPolicy:      entity.RoutingPolicy,
 	}
 }
 
@@ -169,6 +204,10 @@ func (self *ServiceManager) populateService(entity *Service, tx *bbolt.Tx, boltE
 	}
 	entity.Name = boltService.Name
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.MultipathMode = boltService.MultipathMode
+	entity.QosClass = boltService.QosClass
+	entity.RateLimit = boltService.RateLimit
+	entity.RoutingPolicy = boltService.RoutingPolicy
 	entity.FillCommon(boltService)
 
 	terminatorIds := self.store.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
@@ -209,6 +248,10 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 		Name:               entity.Name,
 		TerminatorStrategy: entity.TerminatorStrategy,
 		Tags:               tags,
+		MultipathMode:      entity.MultipathMode,
+		QosClass:           entity.QosClass,
+		RateLimit:          rateLimitToProto(entity.RateLimit),
+		RoutingPolicy:      routingPolicyToProto(entity.RoutingPolicy),
 	}
 
 	return proto.Marshal(msg)
@@ -227,5 +270,9 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		},
 		Name:               msg.Name,
 		TerminatorStrategy: msg.TerminatorStrategy,
+		MultipathMode:      msg.MultipathMode,
+		QosClass:           msg.QosClass,
+		RateLimit:          rateLimitFromProto(msg.RateLimit),
+		RoutingPolicy:      routingPolicyFromProto(msg.RoutingPolicy),
 	}, nil
 }
@@ -79,13 +79,24 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
 	for _, sId := range orderedCircuits {
 		if circuit, found := network.GetCircuit(sId); found {
-			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
+			// circuits whose path no longer satisfies the service constraints, for example after a routing policy
+			// change, are always rerouted, regardless of cost
+			constraints := network.getCurrentPathConstraints(circuit)
+			compliant := satisfiesPathConstraints(circuit.Path.Nodes, constraints)
+			if updatedPath, err := network.updatePathWithConstraints(circuit.Path, constraints); err == nil {
 				pathChanged := !updatedPath.EqualPath(circuit.Path)
 				oldCost := circuit.Path.cost(minRouterCost)
 				newCost := updatedPath.cost(minRouterCost)
 				costDelta := oldCost - newCost
 				log.Tracef("old cost: %v, new cost: %v, delta: %v", oldCost, newCost, costDelta)
-				if count < ceiling && pathChanged && costDelta >= int64(network.options.Smart.MinCostDelta) {
+				if !compliant && pathChanged {
+					count++
+					candidates = append(candidates, &newCircuitPath{
+						circuit: circuit,
+						path:    updatedPath,
+					})
+					log.Infof("rerouting [s/%s] to comply with path constraints %s ==> %s", circuit.Id, circuit.Path.String(), updatedPath.String())
+				} else if count < ceiling && pathChanged && costDelta >= int64(network.options.Smart.MinCostDelta) {
 					count++
 					candidates = append(candidates, &newCircuitPath{
 						circuit: circuit,
@@ -93,6 +104,8 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 					})
 					log.Debugf("rerouting [s/%s] [l:%d] %s ==> %s", circuit.Id, circuitLatencies[circuit.Id], circuit.Path.String(), updatedPath.String())
 				}
+			} else if !compliant {
+				log.Warnf("[s/%s] path %s does not satisfy path constraints %v and no compliant path is available: %v", circuit.Id, circuit.Path.String(), constraints, err)
 			}
 		}
 	}
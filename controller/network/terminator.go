@@ -15,13 +15,13 @@ package network
 
 import (
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/common/pb/cmd_pb"
 	"github.com/openziti/fabric/controller/change"
 	"github.com/openziti/fabric/controller/command"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/controller/xt"
-	"github.com/openziti/fabric/common/pb/cmd_pb"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
 	"github.com/pkg/errors"
@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"reflect"
 	"strings"
+	"time"
 )
 
 type Terminator struct {
@@ -44,6 +45,11 @@ type Terminator struct {
 	PeerData        map[uint32][]byte
 	HostId          string
 	SavedPrecedence xt.Precedence
+	HealthCheck     *db.TerminatorHealthCheck
+	RateLimit       *db.RateLimit
+	Draining        bool
+	DrainDeadline   *time.Time
+	HealthFailed    bool
 }
 
 func (entity *Terminator) GetServiceId() string {
@@ -74,7 +80,12 @@ func (entity *Terminator) GetCost() uint16 {
 	return entity.Cost
 }
 
+// GetPrecedence returns the precedence used when selecting terminators. Terminators which are failing their health
+// check are treated as failed, whatever their configured precedence.
 func (entity *Terminator) GetPrecedence() xt.Precedence {
+	if entity.HealthFailed {
+		return xt.Precedences.Failed
+	}
 	return entity.Precedence
 }
 
@@ -111,6 +122,11 @@ func (entity *Terminator) toBolt() *db.Terminator {
 		PeerData:        entity.PeerData,
 		HostId:          entity.HostId,
 		SavedPrecedence: savedPrecedence,
+		HealthCheck:     entity.HealthCheck,
+		RateLimit:       entity.RateLimit,
+		Draining:        entity.Draining,
+		DrainDeadline:   entity.DrainDeadline,
+		HealthFailed:    entity.HealthFailed,
 	}
 }
 
@@ -269,6 +285,11 @@ func (self *TerminatorManager) populateTerminator(entity *Terminator, _ *bbolt.T
 	entity.Cost = boltTerminator.Cost
 	entity.Precedence = xt.GetPrecedenceForName(boltTerminator.Precedence)
 	entity.HostId = boltTerminator.HostId
+	entity.HealthCheck = boltTerminator.HealthCheck
+	entity.RateLimit = boltTerminator.RateLimit
+	entity.Draining = boltTerminator.Draining
+	entity.DrainDeadline = boltTerminator.DrainDeadline
+	entity.HealthFailed = boltTerminator.HealthFailed
 	entity.FillCommon(boltTerminator)
 
 	if boltTerminator.SavedPrecedence != nil {
@@ -319,6 +340,24 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 		HostId:          entity.HostId,
 		IsSystem:        entity.IsSystem,
 		SavedPrecedence: savedPrecedence,
+		RateLimit:       rateLimitToProto(entity.RateLimit),
+		Draining:        entity.Draining,
+		HealthFailed:    entity.HealthFailed,
+	}
+
+	if entity.DrainDeadline != nil {
+		msg.DrainDeadline = entity.DrainDeadline.UnixMilli()
+	}
+
+	if hc := entity.HealthCheck; hc != nil {
+		msg.HealthCheck = &cmd_pb.TerminatorHealthCheck{
+			Type:             hc.Type,
+			Address:          hc.Address,
+			Interval:         hc.Interval,
+			Timeout:          hc.Timeout,
+			FailureThreshold: hc.FailureThreshold,
+			SuccessThreshold: hc.SuccessThreshold,
+		}
 	}
 
 	return proto.Marshal(msg)
@@ -363,6 +402,25 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		PeerData:        msg.PeerData,
 		HostId:          msg.HostId,
 		SavedPrecedence: savedPrecedence,
+		RateLimit:       rateLimitFromProto(msg.RateLimit),
+		Draining:        msg.Draining,
+		HealthFailed:    msg.HealthFailed,
+	}
+
+	if msg.DrainDeadline != 0 {
+		drainDeadline := time.UnixMilli(msg.DrainDeadline)
+		result.DrainDeadline = &drainDeadline
+	}
+
+	if hc := msg.HealthCheck; hc != nil {
+		result.HealthCheck = &db.TerminatorHealthCheck{
+			Type:             hc.Type,
+			Address:          hc.Address,
+			Interval:         hc.Interval,
+			Timeout:          hc.Timeout,
+			FailureThreshold: hc.FailureThreshold,
+			SuccessThreshold: hc.SuccessThreshold,
+		}
 	}
 
 	return result, nil
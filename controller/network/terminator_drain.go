@@ -0,0 +1,229 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/storage/boltz"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// DrainTerminator marks the terminator as draining, so it no longer receives new circuits. Circuits already using the
+// terminator are left to finish. If forceCloseAfter is greater than zero, circuits still using the terminator once it
+// has elapsed are closed.
+func (self *TerminatorManager) DrainTerminator(id string, forceCloseAfter time.Duration, ctx *change.Context) error {
+	if forceCloseAfter < 0 {
+		return errors.Errorf("invalid force close interval %v, may not be negative", forceCloseAfter)
+	}
+
+	terminator, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+
+	terminator.Draining = true
+	terminator.DrainDeadline = nil
+	if forceCloseAfter > 0 {
+		deadline := time.Now().Add(forceCloseAfter)
+		terminator.DrainDeadline = &deadline
+	}
+
+	return self.updateDrainState(terminator, ctx)
+}
+
+// CancelDrain returns a draining terminator to service, so it can receive new circuits again
+func (self *TerminatorManager) CancelDrain(id string, ctx *change.Context) error {
+	terminator, err := self.Read(id)
+	if err != nil {
+		return err
+	}
+
+	terminator.Draining = false
+	terminator.DrainDeadline = nil
+	return self.updateDrainState(terminator, ctx)
+}
+
+func (self *TerminatorManager) updateDrainState(terminator *Terminator, ctx *change.Context) error {
+	updatedFields := fields.UpdatedFieldsMap{
+		db.FieldTerminatorDraining:      struct{}{},
+		db.FieldTerminatorDrainDeadline: struct{}{},
+	}
+	return self.Update(terminator, updatedFields, ctx)
+}
+
+// terminatorDrainMonitor tracks the circuits still using draining terminators. It reports progress to handlers as
+// the number of circuits changes, until it reaches zero. If a terminator has a drain deadline, circuits still using
+// it after the deadline are closed. Circuits are owned by the controller which created them, so every controller
+// monitors its own circuits.
+type terminatorDrainMonitor struct {
+	network  *Network
+	pending  cmap.ConcurrentMap[string, struct{}]
+	states   map[string]*terminatorDrainState
+	handlers []TerminatorDrainHandler
+	lock     sync.Mutex
+}
+
+type terminatorDrainState struct {
+	deadline       *time.Time
+	activeCircuits int
+	forced         bool
+}
+
+func newTerminatorDrainMonitor(network *Network) *terminatorDrainMonitor {
+	result := &terminatorDrainMonitor{
+		network: network,
+		pending: cmap.New[struct{}](),
+		states:  map[string]*terminatorDrainState{},
+	}
+
+	store := network.GetStores().Terminator
+	store.AddEntityEventListenerF(result.terminatorChanged, boltz.EntityCreated, boltz.EntityUpdated, boltz.EntityDeleted)
+	return result
+}
+
+func (self *terminatorDrainMonitor) addHandler(handler TerminatorDrainHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.handlers = append(append([]TerminatorDrainHandler{}, self.handlers...), handler)
+}
+
+func (self *terminatorDrainMonitor) getHandlers() []TerminatorDrainHandler {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.handlers
+}
+
+// terminatorChanged queues the terminator to have its drain state reloaded. Updates may only contain some fields,
+// so the terminator is reloaded from the store, rather than trusting the entity in the event.
+func (self *terminatorDrainMonitor) terminatorChanged(terminator *db.Terminator) {
+	self.pending.Set(terminator.Id, struct{}{})
+}
+
+func (self *terminatorDrainMonitor) loadTerminators() {
+	store := self.network.GetStores().Terminator
+	err := self.network.GetDb().View(func(tx *bbolt.Tx) error {
+		ids, _, err := store.QueryIds(tx, "draining = true")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			self.pending.Set(id, struct{}{})
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to load draining terminators")
+	}
+}
+
+func (self *terminatorDrainMonitor) run() {
+	self.loadTerminators()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			self.checkDrains(now)
+		case <-self.network.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *terminatorDrainMonitor) refreshPending() {
+	for _, terminatorId := range self.pending.Keys() {
+		self.pending.Remove(terminatorId)
+
+		terminator, _ := self.network.Terminators.Read(terminatorId)
+		if terminator == nil || !terminator.Draining {
+			delete(self.states, terminatorId)
+			continue
+		}
+
+		state, found := self.states[terminatorId]
+		if !found {
+			state = &terminatorDrainState{activeCircuits: -1}
+			self.states[terminatorId] = state
+		}
+
+		// a new deadline gives remaining circuits another chance to finish before being closed
+		if state.deadline == nil || terminator.DrainDeadline == nil || !state.deadline.Equal(*terminator.DrainDeadline) {
+			state.forced = false
+		}
+		state.deadline = terminator.DrainDeadline
+	}
+}
+
+func (self *terminatorDrainMonitor) checkDrains(now time.Time) {
+	self.refreshPending()
+	if len(self.states) == 0 {
+		return
+	}
+
+	circuits := map[string][]*Circuit{}
+	for _, circuit := range self.network.GetAllCircuits() {
+		if circuit.Terminator == nil {
+			continue
+		}
+		terminatorId := circuit.Terminator.GetId()
+		if _, found := self.states[terminatorId]; found {
+			circuits[terminatorId] = append(circuits[terminatorId], circuit)
+		}
+	}
+
+	for terminatorId, state := range self.states {
+		active := circuits[terminatorId]
+
+		if len(active) > 0 && state.deadline != nil && !now.Before(*state.deadline) && !state.forced {
+			state.forced = true
+			pfxlog.Logger().WithField("terminatorId", terminatorId).WithField("circuits", len(active)).
+				Info("terminator drain deadline reached, closing remaining circuits")
+			self.notify(terminatorId, len(active), true)
+			go self.closeCircuits(active)
+		}
+
+		if len(active) != state.activeCircuits {
+			state.activeCircuits = len(active)
+			self.notify(terminatorId, len(active), false)
+		}
+	}
+}
+
+func (self *terminatorDrainMonitor) notify(terminatorId string, activeCircuits int, forced bool) {
+	for _, handler := range self.getHandlers() {
+		handler.TerminatorDrainProgress(terminatorId, activeCircuits, forced)
+	}
+}
+
+func (self *terminatorDrainMonitor) closeCircuits(circuits []*Circuit) {
+	for _, circuit := range circuits {
+		if err := self.network.RemoveCircuit(circuit.Id, true); err != nil {
+			pfxlog.Logger().WithField("circuitId", circuit.Id).WithError(err).Error("unable to close circuit of draining terminator")
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openziti/fabric/common/logcontext"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+)
+
+type testDrainProgress struct {
+	terminatorId   string
+	activeCircuits int
+	forced         bool
+}
+
+type testDrainHandler chan testDrainProgress
+
+func (self testDrainHandler) TerminatorDrainProgress(terminatorId string, activeCircuits int, forced bool) {
+	self <- testDrainProgress{
+		terminatorId:   terminatorId,
+		activeCircuits: activeCircuits,
+		forced:         forced,
+	}
+}
+
+func (self testDrainHandler) next(ctx *db.TestContext, terminatorId string) testDrainProgress {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case progress := <-self:
+			if progress.terminatorId == terminatorId {
+				return progress
+			}
+		case <-timeout:
+			ctx.FailNow("timed out waiting for drain progress", "terminator: %v", terminatorId)
+		}
+	}
+}
+
+func TestTerminatorDrain(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	ctx.NoError(err)
+
+	handler := testDrainHandler(make(chan testDrainProgress, 16))
+	network.AddTerminatorDrainHandler(handler)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	r0 := entityHelper.addTestRouter()
+
+	svc := entityHelper.addTestService("svc")
+	t0 := entityHelper.addTestTerminator(svc.Id, r0.Id, "", true)
+	t1 := entityHelper.addTestTerminator(svc.Id, r0.Id, "", true)
+
+	readService := func() *Service {
+		network.Services.RemoveFromCache(svc.Id)
+		result, err := network.Services.Read(svc.Id)
+		ctx.NoError(err)
+		return result
+	}
+
+	terminator, err := network.Terminators.Read(t0.Id)
+	ctx.NoError(err)
+
+	circuit := &Circuit{
+		Id:         uuid.NewString(),
+		Service:    readService(),
+		Path:       &Path{},
+		Terminator: &RoutingTerminator{Terminator: terminator},
+		CreatedAt:  time.Now(),
+	}
+	network.circuitController.add(circuit)
+
+	ctx.NoError(network.Terminators.DrainTerminator(t0.Id, 0, change.New()))
+	ctx.Equal(testDrainProgress{terminatorId: t0.Id, activeCircuits: 1}, handler.next(ctx, t0.Id))
+
+	// a draining terminator is never selected for new circuits
+	for i := 0; i < 10; i++ {
+		_, selected, _, cerr := network.selectPath(r0, readService(), "", "", logcontext.NewContext())
+		ctx.NoError(cerr)
+		ctx.Equal(t1.Id, selected.GetId())
+	}
+
+	ctx.NoError(network.Terminators.DrainTerminator(t1.Id, 0, change.New()))
+	ctx.Equal(testDrainProgress{terminatorId: t1.Id}, handler.next(ctx, t1.Id))
+
+	_, _, _, cerr := network.selectPath(r0, readService(), "", "", logcontext.NewContext())
+	ctx.Error(cerr)
+	ctx.Equal(CircuitFailureTerminatorsDraining, cerr.Cause())
+
+	// replacing the terminator leaves the drain state in place
+	terminator, err = network.Terminators.Read(t0.Id)
+	ctx.NoError(err)
+	terminator.Cost = 10
+	ctx.NoError(network.Terminators.Update(terminator, nil, change.New()))
+	terminator, err = network.Terminators.Read(t0.Id)
+	ctx.NoError(err)
+	ctx.True(terminator.Draining)
+	ctx.Nil(terminator.DrainDeadline)
+
+	// once the deadline passes remaining circuits are closed
+	ctx.NoError(network.Terminators.DrainTerminator(t0.Id, time.Millisecond, change.New()))
+	terminator, err = network.Terminators.Read(t0.Id)
+	ctx.NoError(err)
+	ctx.NotNil(terminator.DrainDeadline)
+
+	ctx.Equal(testDrainProgress{terminatorId: t0.Id, activeCircuits: 1, forced: true}, handler.next(ctx, t0.Id))
+	ctx.Equal(testDrainProgress{terminatorId: t0.Id}, handler.next(ctx, t0.Id))
+	_, found := network.circuitController.get(circuit.Id)
+	ctx.False(found)
+
+	ctx.NoError(network.Terminators.CancelDrain(t1.Id, change.New()))
+	terminator, err = network.Terminators.Read(t1.Id)
+	ctx.NoError(err)
+	ctx.False(terminator.Draining)
+
+	_, selected, _, cerr := network.selectPath(r0, readService(), "", "", logcontext.NewContext())
+	ctx.NoError(cerr)
+	ctx.Equal(t1.Id, selected.GetId())
+
+	ctx.Error(network.Terminators.DrainTerminator(t1.Id, -time.Second, change.New()))
+}
@@ -0,0 +1,311 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2/protobufs"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// errHealthCheckSkipped is returned by probes which couldn't be run, for example because the hosting router is
+// offline. Skipped checks don't count as either successes or failures.
+var errHealthCheckSkipped = errors.New("health check skipped")
+
+type terminatorHealthStatus int
+
+const (
+	terminatorHealthUnknown terminatorHealthStatus = iota
+	terminatorHealthy
+	terminatorUnhealthy
+)
+
+// terminatorHealthChecker runs the health checks configured on terminators. Checks are run by the router hosting the
+// terminator, so they see the terminator's address the same way circuits will. When a terminator fails enough
+// consecutive checks, it's marked as health failed, which causes it to be treated as having failed precedence. When it
+// passes enough consecutive checks, the mark is removed. Checks are only run by the leader, as they result in model
+// updates.
+type terminatorHealthChecker struct {
+	network  *Network
+	states   cmap.ConcurrentMap[string, *terminatorHealthState]
+	handlers []TerminatorHealthHandler
+	lock     sync.Mutex
+}
+
+type terminatorHealthState struct {
+	sync.Mutex
+	terminatorId         string
+	routerId             string
+	binding              string
+	address              string
+	config               db.TerminatorHealthCheck
+	nextCheck            time.Time
+	running              atomic.Bool
+	status               terminatorHealthStatus
+	consecutiveFailures  int32
+	consecutiveSuccesses int32
+}
+
+func newTerminatorHealthChecker(network *Network) *terminatorHealthChecker {
+	result := &terminatorHealthChecker{
+		network: network,
+		states:  cmap.New[*terminatorHealthState](),
+	}
+
+	store := network.GetStores().Terminator
+	store.AddEntityEventListenerF(result.terminatorChanged, boltz.EntityCreated, boltz.EntityUpdated)
+	store.AddEntityEventListenerF(result.terminatorDeleted, boltz.EntityDeleted)
+	return result
+}
+
+func (self *terminatorHealthChecker) addHandler(handler TerminatorHealthHandler) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.handlers = append(append([]TerminatorHealthHandler{}, self.handlers...), handler)
+}
+
+func (self *terminatorHealthChecker) getHandlers() []TerminatorHealthHandler {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.handlers
+}
+
+func (self *terminatorHealthChecker) terminatorChanged(terminator *db.Terminator) {
+	if terminator.HealthCheck == nil {
+		self.states.Remove(terminator.Id)
+		if terminator.HealthFailed {
+			// clearing the failure updates the terminator, so it can't be done from inside the entity event callback
+			go self.clearHealthFailed(terminator.Id)
+		}
+		return
+	}
+
+	self.states.Upsert(terminator.Id, nil, func(exist bool, state *terminatorHealthState, _ *terminatorHealthState) *terminatorHealthState {
+		if !exist {
+			state = &terminatorHealthState{terminatorId: terminator.Id}
+		}
+		state.Lock()
+		defer state.Unlock()
+		state.routerId = terminator.Router
+		state.binding = terminator.Binding
+		state.address = terminator.Address
+		state.config = *terminator.HealthCheck
+		return state
+	})
+}
+
+func (self *terminatorHealthChecker) terminatorDeleted(terminator *db.Terminator) {
+	self.states.Remove(terminator.Id)
+}
+
+func (self *terminatorHealthChecker) loadTerminators() {
+	store := self.network.GetStores().Terminator
+	err := self.network.GetDb().View(func(tx *bbolt.Tx) error {
+		for cursor := store.IterateIds(tx, ast.BoolNodeTrue); cursor.IsValid(); cursor.Next() {
+			terminator, found, err := store.FindById(tx, string(cursor.Current()))
+			if err != nil {
+				return err
+			}
+			if found {
+				self.terminatorChanged(terminator)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to load terminator health checks")
+	}
+}
+
+func (self *terminatorHealthChecker) run() {
+	self.loadTerminators()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			if self.network.Dispatcher.IsLeaderOrLeaderless() {
+				self.startDueChecks(now)
+			}
+		case <-self.network.closeNotify:
+			return
+		}
+	}
+}
+
+func (self *terminatorHealthChecker) startDueChecks(now time.Time) {
+	for _, state := range self.states.Items() {
+		state.Lock()
+		due := !now.Before(state.nextCheck)
+		if due {
+			state.nextCheck = now.Add(time.Duration(state.config.Interval) * time.Second)
+		}
+		state.Unlock()
+
+		if due && state.running.CompareAndSwap(false, true) {
+			go self.check(state)
+		}
+	}
+}
+
+func (self *terminatorHealthChecker) check(state *terminatorHealthState) {
+	defer state.running.Store(false)
+
+	state.Lock()
+	config := state.config
+	routerId := state.routerId
+	binding := state.binding
+	address := state.address
+	state.Unlock()
+
+	if config.Address != "" {
+		address = config.Address
+	}
+	timeout := time.Duration(config.Timeout) * time.Second
+
+	err := self.checkViaRouter(state.terminatorId, routerId, binding, config.Type, address, timeout)
+	if err == errHealthCheckSkipped {
+		return
+	}
+
+	self.handleResult(state, err)
+}
+
+func (self *terminatorHealthChecker) handleResult(state *terminatorHealthState, err error) {
+	log := pfxlog.Logger().WithField("terminatorId", state.terminatorId)
+
+	state.Lock()
+	var changed bool
+	if err == nil {
+		state.consecutiveFailures = 0
+		state.consecutiveSuccesses++
+		if state.status != terminatorHealthy && state.consecutiveSuccesses >= state.config.SuccessThreshold {
+			state.status = terminatorHealthy
+			changed = true
+		}
+	} else {
+		state.consecutiveSuccesses = 0
+		state.consecutiveFailures++
+		log.WithError(err).WithField("consecutiveFailures", state.consecutiveFailures).Debug("terminator health check failed")
+		if state.status != terminatorUnhealthy && state.consecutiveFailures >= state.config.FailureThreshold {
+			state.status = terminatorUnhealthy
+			changed = true
+		}
+	}
+	state.Unlock()
+
+	if !changed {
+		return
+	}
+
+	if err == nil {
+		log.Info("terminator health check passed, clearing health failure")
+	} else {
+		log.WithError(err).Warn("terminator health check failed, marking terminator failed")
+	}
+	self.setHealthFailed(state.terminatorId, err != nil)
+
+	for _, handler := range self.getHandlers() {
+		handler.TerminatorHealthChanged(state.terminatorId, err == nil, err)
+	}
+}
+
+func (self *terminatorHealthChecker) newChangeContext() *change.Context {
+	return change.New().
+		SetSourceType(change.SourceTypeXt).
+		SetSourceMethod("health-check").
+		SetChangeAuthorType(change.AuthorTypeController)
+}
+
+// setHealthFailed records whether the terminator is failing its health check. This is kept separate from the
+// terminator's precedence, so it doesn't interact with precedence changes made by operators or by quiescing the router.
+func (self *terminatorHealthChecker) setHealthFailed(terminatorId string, failed bool) {
+	terminator, err := self.network.Terminators.Read(terminatorId)
+	if err != nil {
+		pfxlog.Logger().WithField("terminatorId", terminatorId).WithError(err).Error("unable to read terminator to update health")
+		return
+	}
+
+	if terminator.HealthFailed == failed {
+		return
+	}
+
+	terminator.HealthFailed = failed
+	updatedFields := fields.UpdatedFieldsMap{
+		db.FieldTerminatorHealthFailed: struct{}{},
+	}
+
+	if err = self.network.Terminators.Update(terminator, updatedFields, self.newChangeContext()); err != nil {
+		pfxlog.Logger().WithField("terminatorId", terminatorId).WithError(err).Error("unable to update terminator health")
+	}
+}
+
+// clearHealthFailed clears the health failure of a terminator whose health check has been removed, as nothing would
+// otherwise clear it
+func (self *terminatorHealthChecker) clearHealthFailed(terminatorId string) {
+	if self.network.Dispatcher.IsLeaderOrLeaderless() {
+		self.setHealthFailed(terminatorId, false)
+	}
+}
+
+// checkViaRouter asks the router hosting the terminator to run the check. If the router is offline or doesn't respond,
+// the check is skipped.
+func (self *terminatorHealthChecker) checkViaRouter(terminatorId, routerId, binding, checkType, address string, timeout time.Duration) error {
+	r := self.network.GetConnectedRouter(routerId)
+	if r == nil || r.Control == nil {
+		return errHealthCheckSkipped
+	}
+
+	request := &ctrl_pb.TerminatorHealthCheckRequest{
+		TerminatorId: terminatorId,
+		Binding:      binding,
+		Address:      address,
+		Timeout:      int64(timeout),
+		Type:         checkType,
+	}
+
+	reply, err := protobufs.MarshalTyped(request).WithTimeout(timeout + 5*time.Second).SendForReply(r.Control)
+	if err != nil {
+		return errHealthCheckSkipped
+	}
+
+	response := &ctrl_pb.TerminatorHealthCheckResponse{}
+	if err = proto.Unmarshal(reply.Body, response); err != nil {
+		return errors.Wrapf(err, "unable to decode health check response from router %v", routerId)
+	}
+
+	if !response.Healthy {
+		return errors.Errorf("router %v reported terminator unhealthy: %v", routerId, response.Error)
+	}
+	return nil
+}
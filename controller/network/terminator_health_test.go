@@ -0,0 +1,156 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/change"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+type testTerminatorHealthHandler struct {
+	results []bool
+}
+
+func (self *testTerminatorHealthHandler) TerminatorHealthChanged(_ string, healthy bool, _ error) {
+	self.results = append(self.results, healthy)
+}
+
+func TestTerminatorHealthCheckPrecedence(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	network, err := NewNetwork(newTestConfig(ctx))
+	ctx.NoError(err)
+
+	entityHelper := newTestEntityHelper(ctx, network)
+	router := entityHelper.addTestRouter()
+	svc := entityHelper.addTestService("health-check-svc")
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "health-check-terminator"},
+		Service:    svc.Id,
+		Router:     router.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1234",
+		Precedence: xt.Precedences.Required,
+		HealthCheck: &db.TerminatorHealthCheck{
+			Type:             db.TerminatorHealthCheckTypeTcp,
+			FailureThreshold: 2,
+		},
+	}
+	ctx.NoError(network.Terminators.Create(terminator, change.New()))
+
+	handler := &testTerminatorHealthHandler{}
+	network.AddTerminatorHealthHandler(handler)
+
+	state, found := network.terminatorHealth.states.Get(terminator.Id)
+	ctx.True(found)
+
+	// keep the background checker from running checks while the test drives them
+	state.Lock()
+	state.nextCheck = time.Now().Add(time.Hour)
+	ctx.Equal(int32(db.DefaultTerminatorHealthCheckInterval), state.config.Interval)
+	state.Unlock()
+
+	getTerminator := func() *Terminator {
+		result, err := network.Terminators.Read(terminator.Id)
+		ctx.NoError(err)
+		return result
+	}
+
+	// checks are run by the hosting router, so they're skipped while it's offline
+	network.terminatorHealth.check(state)
+	ctx.Len(handler.results, 0)
+
+	checkErr := errors.New("connection refused")
+
+	network.terminatorHealth.handleResult(state, nil)
+	ctx.Equal([]bool{true}, handler.results)
+	ctx.True(getTerminator().GetPrecedence().IsRequired())
+
+	network.terminatorHealth.handleResult(state, checkErr)
+	ctx.False(getTerminator().HealthFailed)
+
+	network.terminatorHealth.handleResult(state, checkErr)
+	ctx.Equal([]bool{true, false}, handler.results)
+	failed := getTerminator()
+	ctx.True(failed.HealthFailed)
+	ctx.True(failed.GetPrecedence().IsFailed())
+	ctx.True(failed.Precedence.IsRequired())
+	ctx.Nil(failed.SavedPrecedence)
+
+	// the precedence reported in events and by the REST API reflects the failure
+	ctx.NoError(network.GetDb().View(func(tx *bbolt.Tx) error {
+		boltTerminator, _, err := network.GetStores().Terminator.FindById(tx, terminator.Id)
+		ctx.NoError(err)
+		ctx.True(boltTerminator.GetPrecedence().IsFailed())
+		ctx.Equal(xt.Precedences.Required.String(), boltTerminator.Precedence)
+		return nil
+	}))
+
+	// further failures don't generate more changes
+	network.terminatorHealth.handleResult(state, checkErr)
+	ctx.Equal([]bool{true, false}, handler.results)
+
+	// quiescing the router while the terminator is failing, then passing the health check, leaves it quiesced
+	ctx.NoError(network.Routers.QuiesceRouter(router, change.New()))
+	network.terminatorHealth.handleResult(state, nil)
+	ctx.Equal([]bool{true, false, true}, handler.results)
+	quiesced := getTerminator()
+	ctx.False(quiesced.HealthFailed)
+	ctx.True(quiesced.GetPrecedence().IsFailed())
+	ctx.True(quiesced.SavedPrecedence.IsRequired())
+
+	// failing the health check while quiesced doesn't stop the router being dequiesced
+	network.terminatorHealth.handleResult(state, checkErr)
+	network.terminatorHealth.handleResult(state, checkErr)
+	ctx.NoError(network.Routers.DequiesceRouter(router, change.New()))
+	dequiesced := getTerminator()
+	ctx.True(dequiesced.Precedence.IsRequired())
+	ctx.True(dequiesced.GetPrecedence().IsFailed())
+
+	network.terminatorHealth.handleResult(state, nil)
+	ctx.True(getTerminator().GetPrecedence().IsRequired())
+
+	// removing the health check from a failed terminator clears the failure
+	network.terminatorHealth.handleResult(state, checkErr)
+	network.terminatorHealth.handleResult(state, checkErr)
+	ctx.True(getTerminator().GetPrecedence().IsFailed())
+
+	unchecked := getTerminator()
+	unchecked.HealthCheck = nil
+	ctx.NoError(network.Terminators.Update(unchecked, fields.UpdatedFieldsMap{db.FieldTerminatorHealthCheck: struct{}{}}, change.New()))
+	_, found = network.terminatorHealth.states.Get(terminator.Id)
+	ctx.False(found)
+
+	ctx.Eventually(func() bool {
+		return !getTerminator().HealthFailed
+	}, time.Second, 10*time.Millisecond)
+	ctx.True(getTerminator().GetPrecedence().IsRequired())
+
+	ctx.NoError(network.Terminators.Delete(terminator.Id, change.New()))
+	_, found = network.terminatorHealth.states.Get(terminator.Id)
+	ctx.False(found)
+}
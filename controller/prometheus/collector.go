@@ -0,0 +1,158 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"github.com/openziti/fabric/controller/event"
+	"github.com/openziti/metrics"
+	"github.com/openziti/metrics/metrics_pb"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Collector keeps the most recent metrics message reported by each router, along with running totals
+// of the controller's service interval counters, so they can be rendered when a scrape comes in. The
+// controller's own registry is polled at scrape time.
+type Collector struct {
+	controllerId string
+	registry     metrics.Registry
+	dispatcher   event.Dispatcher
+
+	lock            sync.Mutex
+	sources         map[string]*reportedMetrics
+	serviceCounters map[string]map[string]uint64
+}
+
+type reportedMetrics struct {
+	msg      *metrics_pb.MetricsMessage
+	received time.Time
+}
+
+func NewCollector(controllerId string, registry metrics.Registry, dispatcher event.Dispatcher) *Collector {
+	return &Collector{
+		controllerId:    controllerId,
+		registry:        registry,
+		dispatcher:      dispatcher,
+		sources:         map[string]*reportedMetrics{},
+		serviceCounters: map[string]map[string]uint64{},
+	}
+}
+
+func (self *Collector) AcceptMetricsMsg(msg *metrics_pb.MetricsMessage) {
+	// controller metrics are polled at scrape time and messages which another controller has already
+	// propagated are skipped, the same as the other metrics event handlers
+	if msg.DoNotPropagate || msg.SourceId == self.controllerId {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.sources[msg.SourceId] = &reportedMetrics{
+		msg:      msg,
+		received: time.Now(),
+	}
+}
+
+func (self *Collector) AcceptServiceEvent(evt *event.ServiceEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	counters, found := self.serviceCounters[evt.EventType]
+	if !found {
+		counters = map[string]uint64{}
+		self.serviceCounters[evt.EventType] = counters
+	}
+	counters[evt.ServiceId] += evt.Count
+}
+
+// ServiceDeleted drops the counters of a deleted service, so they aren't reported for the lifetime of the controller
+func (self *Collector) ServiceDeleted(serviceId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for eventType, counters := range self.serviceCounters {
+		delete(counters, serviceId)
+		if len(counters) == 0 {
+			delete(self.serviceCounters, eventType)
+		}
+	}
+}
+
+// WriteTo renders the collected metrics in the Prometheus text exposition format, or the OpenMetrics
+// format if openMetrics is set. Router reports older than maxAge are left out, so routers which have
+// gone away stop being reported. A maxAge of zero disables the check.
+func (self *Collector) WriteTo(w io.Writer, openMetrics bool, maxAge time.Duration) error {
+	output := newExposition(openMetrics)
+
+	adapter := self.dispatcher.NewFilteredMetricsAdapter(nil, nil, event.MetricsEventHandlerF(func(evt *event.MetricsEvent) {
+		output.addMetricsEvent(evt, self.getSourceLabel(evt))
+	}))
+
+	if msg := self.registry.Poll(); msg != nil {
+		adapter.AcceptMetricsMsg(msg)
+	}
+
+	for _, msg := range self.getReportedMessages(maxAge) {
+		adapter.AcceptMetricsMsg(msg)
+	}
+
+	self.lock.Lock()
+	for eventType, counters := range self.serviceCounters {
+		for serviceId, count := range counters {
+			output.addCounter(eventType, count, label{name: "service_id", value: serviceId})
+		}
+	}
+	self.lock.Unlock()
+
+	return output.writeTo(w)
+}
+
+func (self *Collector) getReportedMessages(maxAge time.Duration) []*metrics_pb.MetricsMessage {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*metrics_pb.MetricsMessage
+	for _, reported := range self.sources {
+		if maxAge == 0 || time.Since(reported.received) <= maxAge {
+			result = append(result, reported.msg)
+		}
+	}
+	return result
+}
+
+func (self *Collector) getSourceLabel(evt *event.MetricsEvent) label {
+	if evt.SourceAppId == self.controllerId {
+		return label{name: "controller_id", value: evt.SourceAppId}
+	}
+	return label{name: "router_id", value: evt.SourceAppId}
+}
+
+// getEntityLabelName returns the label to use for the entity id embedded in the metric name
+func getEntityLabelName(metric string) string {
+	if strings.HasPrefix(metric, "link.") {
+		return "link_id"
+	}
+	if strings.HasPrefix(metric, "ctrl.") {
+		return "router_id"
+	}
+	if strings.HasPrefix(metric, "peer.") {
+		return "peer_id"
+	}
+	return "entity_id"
+}
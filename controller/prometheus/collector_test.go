@@ -0,0 +1,144 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"bytes"
+	"github.com/openziti/fabric/controller/event"
+	"github.com/openziti/fabric/controller/events"
+	"github.com/openziti/metrics"
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestCollector(t *testing.T) (*Collector, metrics.Registry) {
+	closeNotify := make(chan struct{})
+	t.Cleanup(func() { close(closeNotify) })
+
+	dispatcher := events.NewDispatcher(closeNotify)
+	dispatcher.AddMetricsMapper(func(_ *metrics_pb.MetricsMessage, evt *event.MetricsEvent) {
+		if strings.HasPrefix(evt.Metric, "link.") {
+			evt.Metric, evt.SourceEntityId = events.ExtractId(evt.Metric, "link.", 1)
+		}
+	})
+
+	registry := metrics.NewRegistry("ctrl1", nil)
+	return NewCollector("ctrl1", registry, dispatcher), registry
+}
+
+func scrape(t *testing.T, collector *Collector, openMetrics bool, maxAge time.Duration) []string {
+	buf := &bytes.Buffer{}
+	require.NoError(t, collector.WriteTo(buf, openMetrics, maxAge))
+	return strings.Split(strings.TrimSpace(buf.String()), "\n")
+}
+
+func TestScrapeLabels(t *testing.T) {
+	req := require.New(t)
+	collector, registry := newTestCollector(t)
+
+	registry.Meter("ctrl.tx.msgrate:router1").Mark(5)
+	registry.Histogram("peer.latency:ctrl2").Update(100)
+
+	collector.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+		SourceId: "router1",
+		Tags:     map[string]string{"region": "us-east"},
+		IntValues: map[string]int64{
+			"xgress.tx_queue.size": 3,
+		},
+		Histograms: map[string]*metrics_pb.MetricsMessage_Histogram{
+			"link.link1.latency": {Count: 2, P50: 10, P99: 20},
+		},
+	})
+
+	collector.AcceptServiceEvent(&event.ServiceEvent{EventType: "service.dial.success", ServiceId: "svc1", Count: 2})
+	collector.AcceptServiceEvent(&event.ServiceEvent{EventType: "service.dial.success", ServiceId: "svc1", TerminatorId: "t1", Count: 3})
+
+	lines := scrape(t, collector, false, 0)
+
+	req.Contains(lines, `# TYPE ziti_ctrl_tx_msgrate_total counter`)
+	req.Contains(lines, `ziti_ctrl_tx_msgrate_total{controller_id="ctrl1",router_id="router1"} 5`)
+	req.Contains(lines, `# TYPE ziti_ctrl_tx_msgrate_m1_rate gauge`)
+	req.Contains(lines, `ziti_peer_latency_count{controller_id="ctrl1",peer_id="ctrl2"} 1`)
+
+	req.Contains(lines, `ziti_xgress_tx_queue_size{region="us-east",router_id="router1"} 3`)
+
+	req.Contains(lines, `# TYPE ziti_link_latency summary`)
+	req.Contains(lines, `ziti_link_latency{link_id="link1",region="us-east",router_id="router1",quantile="0.5"} 10`)
+	req.Contains(lines, `ziti_link_latency{link_id="link1",region="us-east",router_id="router1",quantile="0.99"} 20`)
+	req.Contains(lines, `ziti_link_latency_count{link_id="link1",region="us-east",router_id="router1"} 2`)
+
+	req.Contains(lines, `# TYPE ziti_service_dial_success_total counter`)
+	req.Contains(lines, `ziti_service_dial_success_total{service_id="svc1"} 5`)
+
+	// counters of deleted services are dropped
+	collector.ServiceDeleted("svc1")
+	lines = scrape(t, collector, false, 0)
+	req.NotContains(lines, `ziti_service_dial_success_total{service_id="svc1"} 5`)
+
+	// each family is written once, with all of its samples grouped under it
+	seen := map[string]bool{}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "# TYPE ") {
+			name := strings.Fields(line)[2]
+			req.False(seen[name], "duplicate family %v", name)
+			seen[name] = true
+		}
+	}
+}
+
+func TestScrapeOpenMetrics(t *testing.T) {
+	req := require.New(t)
+	collector, _ := newTestCollector(t)
+
+	collector.AcceptServiceEvent(&event.ServiceEvent{EventType: "service.dial.fail", ServiceId: "svc1", Count: 1})
+
+	lines := scrape(t, collector, true, 0)
+	req.Equal([]string{
+		`# TYPE ziti_service_dial_fail counter`,
+		`ziti_service_dial_fail_total{service_id="svc1"} 1`,
+		`# EOF`,
+	}, lines)
+}
+
+func TestScrapeSkipsStaleAndPropagatedReports(t *testing.T) {
+	req := require.New(t)
+	collector, _ := newTestCollector(t)
+
+	collector.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+		SourceId:  "router1",
+		IntValues: map[string]int64{"stale": 1},
+	})
+	collector.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+		SourceId:       "router2",
+		IntValues:      map[string]int64{"propagated": 1},
+		DoNotPropagate: true,
+	})
+
+	time.Sleep(100 * time.Millisecond)
+	collector.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+		SourceId:  "router3",
+		IntValues: map[string]int64{"current": 1},
+	})
+
+	output := strings.Join(scrape(t, collector, false, 50*time.Millisecond), "\n")
+	req.Contains(output, `ziti_current{router_id="router3"} 1`)
+	req.NotContains(output, "ziti_stale")
+	req.NotContains(output, "ziti_propagated")
+}
@@ -0,0 +1,260 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/openziti/fabric/controller/event"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	typeCounter = "counter"
+	typeGauge   = "gauge"
+	typeSummary = "summary"
+)
+
+var quantiles = []struct {
+	key      string
+	quantile string
+}{
+	{"p50", "0.5"},
+	{"p75", "0.75"},
+	{"p95", "0.95"},
+	{"p99", "0.99"},
+	{"p999", "0.999"},
+	{"p9999", "0.9999"},
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	suffix string
+	extra  *label
+	value  float64
+}
+
+type series struct {
+	labels  []label
+	samples []sample
+}
+
+type family struct {
+	name   string
+	typ    string
+	series map[string]*series
+}
+
+// exposition groups samples into metric families, since every sample of a family has to be written
+// together, under a single TYPE line
+type exposition struct {
+	openMetrics bool
+	families    map[string]*family
+}
+
+func newExposition(openMetrics bool) *exposition {
+	return &exposition{
+		openMetrics: openMetrics,
+		families:    map[string]*family{},
+	}
+}
+
+func (self *exposition) addMetricsEvent(evt *event.MetricsEvent, source label) {
+	metric := evt.Metric
+	entityId := evt.SourceEntityId
+	if entityId == "" {
+		if idx := strings.LastIndex(metric, ":"); idx > 0 {
+			metric, entityId = metric[:idx], metric[idx+1:]
+		}
+	}
+
+	labels := []label{source}
+	if entityId != "" {
+		labels = append(labels, label{name: getEntityLabelName(metric), value: entityId})
+	}
+	for k, v := range evt.Tags {
+		labels = append(labels, label{name: getLabelName(k), value: v})
+	}
+
+	switch evt.MetricType {
+	case "intValue", "floatValue":
+		self.add(metric, typeGauge, labels, sample{value: toFloat(evt.Metrics["value"])})
+	case "meter":
+		self.add(metric+".m1_rate", typeGauge, labels, sample{value: toFloat(evt.Metrics["m1_rate"])})
+		self.add(metric, typeCounter, labels, sample{suffix: "_total", value: toFloat(evt.Metrics["count"])})
+	case "histogram", "timer":
+		var samples []sample
+		for _, q := range quantiles {
+			samples = append(samples, sample{
+				extra: &label{name: "quantile", value: q.quantile},
+				value: toFloat(evt.Metrics[q.key]),
+			})
+		}
+		samples = append(samples, sample{suffix: "_count", value: toFloat(evt.Metrics["count"])})
+		self.add(metric, typeSummary, labels, samples...)
+	}
+}
+
+func (self *exposition) addCounter(metric string, value uint64, labels ...label) {
+	self.add(metric, typeCounter, labels, sample{suffix: "_total", value: float64(value)})
+}
+
+func (self *exposition) add(metric string, typ string, labels []label, samples ...sample) {
+	name := getMetricName(metric)
+
+	f, found := self.families[name]
+	if !found {
+		f = &family{
+			name:   name,
+			typ:    typ,
+			series: map[string]*series{},
+		}
+		self.families[name] = f
+	} else if f.typ != typ {
+		// a metric name may only have one type, first one wins
+		return
+	}
+
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].name < labels[j].name
+	})
+	seriesKey := formatLabels(labels, nil)
+	s, found := f.series[seriesKey]
+	if !found {
+		s = &series{labels: labels}
+		f.series[seriesKey] = s
+	}
+	s.samples = samples
+}
+
+func (self *exposition) writeTo(w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	var keys []string
+	for key := range self.families {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		f := self.families[key]
+		typeName := f.name
+		if f.typ == typeCounter && !self.openMetrics {
+			typeName += "_total"
+		}
+		if _, err := fmt.Fprintf(out, "# TYPE %s %s\n", typeName, f.typ); err != nil {
+			return err
+		}
+
+		var seriesKeys []string
+		for seriesKey := range f.series {
+			seriesKeys = append(seriesKeys, seriesKey)
+		}
+		sort.Strings(seriesKeys)
+
+		for _, seriesKey := range seriesKeys {
+			s := f.series[seriesKey]
+			for _, smpl := range s.samples {
+				_, err := fmt.Fprintf(out, "%s%s%s %s\n", f.name, smpl.suffix, formatLabels(s.labels, smpl.extra),
+					strconv.FormatFloat(smpl.value, 'g', -1, 64))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if self.openMetrics {
+		if _, err := out.WriteString("# EOF\n"); err != nil {
+			return err
+		}
+	}
+
+	return out.Flush()
+}
+
+func formatLabels(labels []label, extra *label) string {
+	if extra != nil {
+		labels = append(labels[:len(labels):len(labels)], *extra)
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for _, l := range labels {
+		parts = append(parts, l.name+`="`+escapeLabelValue(l.value)+`"`)
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+// getMetricName follows the naming used by the prometheus metrics event formatter, so that the same
+// metric has the same name on both endpoints
+func getMetricName(metric string) string {
+	name := "ziti_" + sanitize(metric)
+
+	// Prometheus complains about metrics ending in _count, so "fix" that.
+	if strings.HasSuffix(name, "_count") {
+		name = strings.TrimSuffix(name, "_count") + "_c"
+	}
+	return name
+}
+
+func getLabelName(name string) string {
+	result := sanitize(name)
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = "_" + result
+	}
+	return result
+}
+
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func toFloat(val interface{}) float64 {
+	switch v := val.(type) {
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
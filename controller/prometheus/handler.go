@@ -0,0 +1,124 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/xweb/v2"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	Binding = "prometheus"
+
+	DefaultMaxAge = 5 * time.Minute
+
+	contentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+var _ xweb.ApiHandlerFactory = &ScrapeApiFactory{}
+
+func NewScrapeApiFactory(network *network.Network) *ScrapeApiFactory {
+	return &ScrapeApiFactory{
+		network: network,
+	}
+}
+
+type ScrapeApiFactory struct {
+	network   *network.Network
+	once      sync.Once
+	collector *Collector
+}
+
+func (factory *ScrapeApiFactory) Validate(*xweb.InstanceConfig) error {
+	return nil
+}
+
+func (factory *ScrapeApiFactory) Binding() string {
+	return Binding
+}
+
+func (factory *ScrapeApiFactory) New(_ *xweb.ServerConfig, options map[interface{}]interface{}) (xweb.ApiHandler, error) {
+	maxAge := DefaultMaxAge
+	if val, found := options["maxAge"]; found {
+		str, ok := val.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid %v maxAge value '%v', must be a duration", Binding, val)
+		}
+		var err error
+		if maxAge, err = time.ParseDuration(str); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v maxAge value '%v'", Binding, val)
+		}
+	}
+
+	// the collector is only registered once a binding is configured, so there's no overhead otherwise
+	factory.once.Do(func() {
+		dispatcher := factory.network.GetEventDispatcher()
+		factory.collector = NewCollector(factory.network.GetAppId(), factory.network.GetMetricsRegistry(), dispatcher)
+		dispatcher.AddMetricsMessageHandler(factory.collector)
+		dispatcher.AddServiceEventHandler(factory.collector)
+		factory.network.GetStores().Service.AddEntityIdListener(factory.collector.ServiceDeleted, boltz.EntityDeleted)
+	})
+
+	return &ScrapeApiHandler{
+		options:   options,
+		collector: factory.collector,
+		maxAge:    maxAge,
+	}, nil
+}
+
+type ScrapeApiHandler struct {
+	options   map[interface{}]interface{}
+	collector *Collector
+	maxAge    time.Duration
+}
+
+func (self *ScrapeApiHandler) Binding() string {
+	return Binding
+}
+
+func (self *ScrapeApiHandler) Options() map[interface{}]interface{} {
+	return self.options
+}
+
+func (self *ScrapeApiHandler) RootPath() string {
+	return "/prometheus"
+}
+
+func (self *ScrapeApiHandler) IsHandler(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, self.RootPath())
+}
+
+func (self *ScrapeApiHandler) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	openMetrics := strings.Contains(request.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", contentTypeOpenMetrics)
+	} else {
+		w.Header().Set("Content-Type", contentTypeText)
+	}
+
+	if err := self.collector.WriteTo(w, openMetrics, self.maxAge); err != nil {
+		logrus.WithError(err).Error("failure writing prometheus metrics")
+	}
+}
@@ -0,0 +1,266 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package journal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListJournalEventsParams creates a new ListJournalEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListJournalEventsParams() *ListJournalEventsParams {
+	return &ListJournalEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListJournalEventsParamsWithTimeout creates a new ListJournalEventsParams object
+// with the ability to set a timeout on a request.
+func NewListJournalEventsParamsWithTimeout(timeout time.Duration) *ListJournalEventsParams {
+	return &ListJournalEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListJournalEventsParamsWithContext creates a new ListJournalEventsParams object
+// with the ability to set a context for a request.
+func NewListJournalEventsParamsWithContext(ctx context.Context) *ListJournalEventsParams {
+	return &ListJournalEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewListJournalEventsParamsWithHTTPClient creates a new ListJournalEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListJournalEventsParamsWithHTTPClient(client *http.Client) *ListJournalEventsParams {
+	return &ListJournalEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListJournalEventsParams contains all the parameters to send to the API endpoint
+
+	for the list journal events operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListJournalEventsParams struct {
+
+	/* EventTypes.
+
+	   If set, only events of the given types, such as circuit or usage.v3, are returned
+	*/
+	EventTypes []string
+
+	/* FromSequence.
+
+	   The sequence number to start reading from
+
+	   Format: int64
+	*/
+	FromSequence *int64
+
+	// Limit.
+	Limit *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list journal events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListJournalEventsParams) WithDefaults() *ListJournalEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list journal events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListJournalEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list journal events params
+func (o *ListJournalEventsParams) WithTimeout(timeout time.Duration) *ListJournalEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list journal events params
+func (o *ListJournalEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list journal events params
+func (o *ListJournalEventsParams) WithContext(ctx context.Context) *ListJournalEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list journal events params
+func (o *ListJournalEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list journal events params
+func (o *ListJournalEventsParams) WithHTTPClient(client *http.Client) *ListJournalEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list journal events params
+func (o *ListJournalEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEventTypes adds the eventTypes to the list journal events params
+func (o *ListJournalEventsParams) WithEventTypes(eventTypes []string) *ListJournalEventsParams {
+	o.SetEventTypes(eventTypes)
+	return o
+}
+
+// SetEventTypes adds the eventTypes to the list journal events params
+func (o *ListJournalEventsParams) SetEventTypes(eventTypes []string) {
+	o.EventTypes = eventTypes
+}
+
+// WithFromSequence adds the fromSequence to the list journal events params
+func (o *ListJournalEventsParams) WithFromSequence(fromSequence *int64) *ListJournalEventsParams {
+	o.SetFromSequence(fromSequence)
+	return o
+}
+
+// SetFromSequence adds the fromSequence to the list journal events params
+func (o *ListJournalEventsParams) SetFromSequence(fromSequence *int64) {
+	o.FromSequence = fromSequence
+}
+
+// WithLimit adds the limit to the list journal events params
+func (o *ListJournalEventsParams) WithLimit(limit *int64) *ListJournalEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list journal events params
+func (o *ListJournalEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListJournalEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.EventTypes != nil {
+
+		// binding items for eventTypes
+		joinedEventTypes := o.bindParamEventTypes(reg)
+
+		// query array param eventTypes
+		if err := r.SetQueryParam("eventTypes", joinedEventTypes...); err != nil {
+			return err
+		}
+	}
+
+	if o.FromSequence != nil {
+
+		// query param fromSequence
+		var qrFromSequence int64
+
+		if o.FromSequence != nil {
+			qrFromSequence = *o.FromSequence
+		}
+		qFromSequence := swag.FormatInt64(qrFromSequence)
+		if qFromSequence != "" {
+
+			if err := r.SetQueryParam("fromSequence", qFromSequence); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamListJournalEvents binds the parameter eventTypes
+func (o *ListJournalEventsParams) bindParamEventTypes(formats strfmt.Registry) []string {
+	eventTypesIR := o.EventTypes
+
+	var eventTypesIC []string
+	for _, eventTypesIIR := range eventTypesIR { // explode []string
+
+		eventTypesIIV := eventTypesIIR // string as string
+		eventTypesIC = append(eventTypesIC, eventTypesIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	eventTypesIS := swag.JoinByFormat(eventTypesIC, "csv")
+
+	return eventTypesIS
+}
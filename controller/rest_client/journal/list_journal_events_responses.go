@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package journal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// ListJournalEventsReader is a Reader for the ListJournalEvents structure.
+type ListJournalEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListJournalEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListJournalEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListJournalEventsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListJournalEventsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /events/journal] listJournalEvents", response, response.Code())
+	}
+}
+
+// NewListJournalEventsOK creates a ListJournalEventsOK with default headers values
+func NewListJournalEventsOK() *ListJournalEventsOK {
+	return &ListJournalEventsOK{}
+}
+
+/*
+ListJournalEventsOK describes a response with status code 200, with default header values.
+
+A page of events read from the event journal
+*/
+type ListJournalEventsOK struct {
+	Payload *rest_model.ListJournalEventsEnvelope
+}
+
+// IsSuccess returns true when this list journal events o k response has a 2xx status code
+func (o *ListJournalEventsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list journal events o k response has a 3xx status code
+func (o *ListJournalEventsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list journal events o k response has a 4xx status code
+func (o *ListJournalEventsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list journal events o k response has a 5xx status code
+func (o *ListJournalEventsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list journal events o k response a status code equal to that given
+func (o *ListJournalEventsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list journal events o k response
+func (o *ListJournalEventsOK) Code() int {
+	return 200
+}
+
+func (o *ListJournalEventsOK) Error() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListJournalEventsOK) String() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListJournalEventsOK) GetPayload() *rest_model.ListJournalEventsEnvelope {
+	return o.Payload
+}
+
+func (o *ListJournalEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.ListJournalEventsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListJournalEventsUnauthorized creates a ListJournalEventsUnauthorized with default headers values
+func NewListJournalEventsUnauthorized() *ListJournalEventsUnauthorized {
+	return &ListJournalEventsUnauthorized{}
+}
+
+/*
+ListJournalEventsUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type ListJournalEventsUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this list journal events unauthorized response has a 2xx status code
+func (o *ListJournalEventsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list journal events unauthorized response has a 3xx status code
+func (o *ListJournalEventsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list journal events unauthorized response has a 4xx status code
+func (o *ListJournalEventsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list journal events unauthorized response has a 5xx status code
+func (o *ListJournalEventsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list journal events unauthorized response a status code equal to that given
+func (o *ListJournalEventsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the list journal events unauthorized response
+func (o *ListJournalEventsUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ListJournalEventsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListJournalEventsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListJournalEventsUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListJournalEventsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListJournalEventsNotFound creates a ListJournalEventsNotFound with default headers values
+func NewListJournalEventsNotFound() *ListJournalEventsNotFound {
+	return &ListJournalEventsNotFound{}
+}
+
+/*
+ListJournalEventsNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type ListJournalEventsNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this list journal events not found response has a 2xx status code
+func (o *ListJournalEventsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list journal events not found response has a 3xx status code
+func (o *ListJournalEventsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list journal events not found response has a 4xx status code
+func (o *ListJournalEventsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list journal events not found response has a 5xx status code
+func (o *ListJournalEventsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list journal events not found response a status code equal to that given
+func (o *ListJournalEventsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the list journal events not found response
+func (o *ListJournalEventsNotFound) Code() int {
+	return 404
+}
+
+func (o *ListJournalEventsNotFound) Error() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListJournalEventsNotFound) String() string {
+	return fmt.Sprintf("[GET /events/journal][%d] listJournalEventsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListJournalEventsNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListJournalEventsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
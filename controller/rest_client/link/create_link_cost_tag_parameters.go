@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// NewCreateLinkCostTagParams creates a new CreateLinkCostTagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateLinkCostTagParams() *CreateLinkCostTagParams {
+	return &CreateLinkCostTagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateLinkCostTagParamsWithTimeout creates a new CreateLinkCostTagParams object
+// with the ability to set a timeout on a request.
+func NewCreateLinkCostTagParamsWithTimeout(timeout time.Duration) *CreateLinkCostTagParams {
+	return &CreateLinkCostTagParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateLinkCostTagParamsWithContext creates a new CreateLinkCostTagParams object
+// with the ability to set a context for a request.
+func NewCreateLinkCostTagParamsWithContext(ctx context.Context) *CreateLinkCostTagParams {
+	return &CreateLinkCostTagParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateLinkCostTagParamsWithHTTPClient creates a new CreateLinkCostTagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateLinkCostTagParamsWithHTTPClient(client *http.Client) *CreateLinkCostTagParams {
+	return &CreateLinkCostTagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateLinkCostTagParams contains all the parameters to send to the API endpoint
+
+	for the create link cost tag operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateLinkCostTagParams struct {
+
+	/* LinkCostTag.
+
+	   A link cost tag to create
+	*/
+	LinkCostTag *rest_model.LinkCostTagCreate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateLinkCostTagParams) WithDefaults() *CreateLinkCostTagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateLinkCostTagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create link cost tag params
+func (o *CreateLinkCostTagParams) WithTimeout(timeout time.Duration) *CreateLinkCostTagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create link cost tag params
+func (o *CreateLinkCostTagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create link cost tag params
+func (o *CreateLinkCostTagParams) WithContext(ctx context.Context) *CreateLinkCostTagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create link cost tag params
+func (o *CreateLinkCostTagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create link cost tag params
+func (o *CreateLinkCostTagParams) WithHTTPClient(client *http.Client) *CreateLinkCostTagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create link cost tag params
+func (o *CreateLinkCostTagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLinkCostTag adds the linkCostTag to the create link cost tag params
+func (o *CreateLinkCostTagParams) WithLinkCostTag(linkCostTag *rest_model.LinkCostTagCreate) *CreateLinkCostTagParams {
+	o.SetLinkCostTag(linkCostTag)
+	return o
+}
+
+// SetLinkCostTag adds the linkCostTag to the create link cost tag params
+func (o *CreateLinkCostTagParams) SetLinkCostTag(linkCostTag *rest_model.LinkCostTagCreate) {
+	o.LinkCostTag = linkCostTag
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateLinkCostTagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.LinkCostTag != nil {
+		if err := r.SetBodyParam(o.LinkCostTag); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
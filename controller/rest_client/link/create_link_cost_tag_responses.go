@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// CreateLinkCostTagReader is a Reader for the CreateLinkCostTag structure.
+type CreateLinkCostTagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateLinkCostTagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateLinkCostTagCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateLinkCostTagBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewCreateLinkCostTagUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /link-cost-tags] createLinkCostTag", response, response.Code())
+	}
+}
+
+// NewCreateLinkCostTagCreated creates a CreateLinkCostTagCreated with default headers values
+func NewCreateLinkCostTagCreated() *CreateLinkCostTagCreated {
+	return &CreateLinkCostTagCreated{}
+}
+
+/*
+CreateLinkCostTagCreated describes a response with status code 201, with default header values.
+
+The create request was successful and the resource has been added at the following location
+*/
+type CreateLinkCostTagCreated struct {
+	Payload *rest_model.CreateEnvelope
+}
+
+// IsSuccess returns true when this create link cost tag created response has a 2xx status code
+func (o *CreateLinkCostTagCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create link cost tag created response has a 3xx status code
+func (o *CreateLinkCostTagCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link cost tag created response has a 4xx status code
+func (o *CreateLinkCostTagCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create link cost tag created response has a 5xx status code
+func (o *CreateLinkCostTagCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link cost tag created response a status code equal to that given
+func (o *CreateLinkCostTagCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+// Code gets the status code for the create link cost tag created response
+func (o *CreateLinkCostTagCreated) Code() int {
+	return 201
+}
+
+func (o *CreateLinkCostTagCreated) Error() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateLinkCostTagCreated) String() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateLinkCostTagCreated) GetPayload() *rest_model.CreateEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkCostTagCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.CreateEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateLinkCostTagBadRequest creates a CreateLinkCostTagBadRequest with default headers values
+func NewCreateLinkCostTagBadRequest() *CreateLinkCostTagBadRequest {
+	return &CreateLinkCostTagBadRequest{}
+}
+
+/*
+CreateLinkCostTagBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type CreateLinkCostTagBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this create link cost tag bad request response has a 2xx status code
+func (o *CreateLinkCostTagBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create link cost tag bad request response has a 3xx status code
+func (o *CreateLinkCostTagBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link cost tag bad request response has a 4xx status code
+func (o *CreateLinkCostTagBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create link cost tag bad request response has a 5xx status code
+func (o *CreateLinkCostTagBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link cost tag bad request response a status code equal to that given
+func (o *CreateLinkCostTagBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create link cost tag bad request response
+func (o *CreateLinkCostTagBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateLinkCostTagBadRequest) Error() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateLinkCostTagBadRequest) String() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateLinkCostTagBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkCostTagBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateLinkCostTagUnauthorized creates a CreateLinkCostTagUnauthorized with default headers values
+func NewCreateLinkCostTagUnauthorized() *CreateLinkCostTagUnauthorized {
+	return &CreateLinkCostTagUnauthorized{}
+}
+
+/*
+CreateLinkCostTagUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type CreateLinkCostTagUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this create link cost tag unauthorized response has a 2xx status code
+func (o *CreateLinkCostTagUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create link cost tag unauthorized response has a 3xx status code
+func (o *CreateLinkCostTagUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create link cost tag unauthorized response has a 4xx status code
+func (o *CreateLinkCostTagUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create link cost tag unauthorized response has a 5xx status code
+func (o *CreateLinkCostTagUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create link cost tag unauthorized response a status code equal to that given
+func (o *CreateLinkCostTagUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the create link cost tag unauthorized response
+func (o *CreateLinkCostTagUnauthorized) Code() int {
+	return 401
+}
+
+func (o *CreateLinkCostTagUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CreateLinkCostTagUnauthorized) String() string {
+	return fmt.Sprintf("[POST /link-cost-tags][%d] createLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CreateLinkCostTagUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateLinkCostTagUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
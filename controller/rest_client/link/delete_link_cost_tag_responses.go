@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DeleteLinkCostTagReader is a Reader for the DeleteLinkCostTag structure.
+type DeleteLinkCostTagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteLinkCostTagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteLinkCostTagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDeleteLinkCostTagBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewDeleteLinkCostTagUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /link-cost-tags/{id}] deleteLinkCostTag", response, response.Code())
+	}
+}
+
+// NewDeleteLinkCostTagOK creates a DeleteLinkCostTagOK with default headers values
+func NewDeleteLinkCostTagOK() *DeleteLinkCostTagOK {
+	return &DeleteLinkCostTagOK{}
+}
+
+/*
+DeleteLinkCostTagOK describes a response with status code 200, with default header values.
+
+The delete request was successful and the resource has been removed
+*/
+type DeleteLinkCostTagOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this delete link cost tag o k response has a 2xx status code
+func (o *DeleteLinkCostTagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete link cost tag o k response has a 3xx status code
+func (o *DeleteLinkCostTagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link cost tag o k response has a 4xx status code
+func (o *DeleteLinkCostTagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete link cost tag o k response has a 5xx status code
+func (o *DeleteLinkCostTagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link cost tag o k response a status code equal to that given
+func (o *DeleteLinkCostTagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the delete link cost tag o k response
+func (o *DeleteLinkCostTagOK) Code() int {
+	return 200
+}
+
+func (o *DeleteLinkCostTagOK) Error() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteLinkCostTagOK) String() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteLinkCostTagOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *DeleteLinkCostTagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteLinkCostTagBadRequest creates a DeleteLinkCostTagBadRequest with default headers values
+func NewDeleteLinkCostTagBadRequest() *DeleteLinkCostTagBadRequest {
+	return &DeleteLinkCostTagBadRequest{}
+}
+
+/*
+DeleteLinkCostTagBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type DeleteLinkCostTagBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this delete link cost tag bad request response has a 2xx status code
+func (o *DeleteLinkCostTagBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete link cost tag bad request response has a 3xx status code
+func (o *DeleteLinkCostTagBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link cost tag bad request response has a 4xx status code
+func (o *DeleteLinkCostTagBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete link cost tag bad request response has a 5xx status code
+func (o *DeleteLinkCostTagBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link cost tag bad request response a status code equal to that given
+func (o *DeleteLinkCostTagBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the delete link cost tag bad request response
+func (o *DeleteLinkCostTagBadRequest) Code() int {
+	return 400
+}
+
+func (o *DeleteLinkCostTagBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeleteLinkCostTagBadRequest) String() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DeleteLinkCostTagBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteLinkCostTagBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteLinkCostTagUnauthorized creates a DeleteLinkCostTagUnauthorized with default headers values
+func NewDeleteLinkCostTagUnauthorized() *DeleteLinkCostTagUnauthorized {
+	return &DeleteLinkCostTagUnauthorized{}
+}
+
+/*
+DeleteLinkCostTagUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DeleteLinkCostTagUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this delete link cost tag unauthorized response has a 2xx status code
+func (o *DeleteLinkCostTagUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete link cost tag unauthorized response has a 3xx status code
+func (o *DeleteLinkCostTagUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete link cost tag unauthorized response has a 4xx status code
+func (o *DeleteLinkCostTagUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete link cost tag unauthorized response has a 5xx status code
+func (o *DeleteLinkCostTagUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete link cost tag unauthorized response a status code equal to that given
+func (o *DeleteLinkCostTagUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the delete link cost tag unauthorized response
+func (o *DeleteLinkCostTagUnauthorized) Code() int {
+	return 401
+}
+
+func (o *DeleteLinkCostTagUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeleteLinkCostTagUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /link-cost-tags/{id}][%d] deleteLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DeleteLinkCostTagUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteLinkCostTagUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDetailLinkCostTagParams creates a new DetailLinkCostTagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDetailLinkCostTagParams() *DetailLinkCostTagParams {
+	return &DetailLinkCostTagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDetailLinkCostTagParamsWithTimeout creates a new DetailLinkCostTagParams object
+// with the ability to set a timeout on a request.
+func NewDetailLinkCostTagParamsWithTimeout(timeout time.Duration) *DetailLinkCostTagParams {
+	return &DetailLinkCostTagParams{
+		timeout: timeout,
+	}
+}
+
+// NewDetailLinkCostTagParamsWithContext creates a new DetailLinkCostTagParams object
+// with the ability to set a context for a request.
+func NewDetailLinkCostTagParamsWithContext(ctx context.Context) *DetailLinkCostTagParams {
+	return &DetailLinkCostTagParams{
+		Context: ctx,
+	}
+}
+
+// NewDetailLinkCostTagParamsWithHTTPClient creates a new DetailLinkCostTagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDetailLinkCostTagParamsWithHTTPClient(client *http.Client) *DetailLinkCostTagParams {
+	return &DetailLinkCostTagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DetailLinkCostTagParams contains all the parameters to send to the API endpoint
+
+	for the detail link cost tag operation.
+
+	Typically these are written to a http.Request.
+*/
+type DetailLinkCostTagParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the detail link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DetailLinkCostTagParams) WithDefaults() *DetailLinkCostTagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the detail link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DetailLinkCostTagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the detail link cost tag params
+func (o *DetailLinkCostTagParams) WithTimeout(timeout time.Duration) *DetailLinkCostTagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the detail link cost tag params
+func (o *DetailLinkCostTagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the detail link cost tag params
+func (o *DetailLinkCostTagParams) WithContext(ctx context.Context) *DetailLinkCostTagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the detail link cost tag params
+func (o *DetailLinkCostTagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the detail link cost tag params
+func (o *DetailLinkCostTagParams) WithHTTPClient(client *http.Client) *DetailLinkCostTagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the detail link cost tag params
+func (o *DetailLinkCostTagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the detail link cost tag params
+func (o *DetailLinkCostTagParams) WithID(id string) *DetailLinkCostTagParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the detail link cost tag params
+func (o *DetailLinkCostTagParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DetailLinkCostTagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DetailLinkCostTagReader is a Reader for the DetailLinkCostTag structure.
+type DetailLinkCostTagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DetailLinkCostTagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDetailLinkCostTagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDetailLinkCostTagUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDetailLinkCostTagNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /link-cost-tags/{id}] detailLinkCostTag", response, response.Code())
+	}
+}
+
+// NewDetailLinkCostTagOK creates a DetailLinkCostTagOK with default headers values
+func NewDetailLinkCostTagOK() *DetailLinkCostTagOK {
+	return &DetailLinkCostTagOK{}
+}
+
+/*
+DetailLinkCostTagOK describes a response with status code 200, with default header values.
+
+A single link cost tag
+*/
+type DetailLinkCostTagOK struct {
+	Payload *rest_model.DetailLinkCostTagEnvelope
+}
+
+// IsSuccess returns true when this detail link cost tag o k response has a 2xx status code
+func (o *DetailLinkCostTagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this detail link cost tag o k response has a 3xx status code
+func (o *DetailLinkCostTagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link cost tag o k response has a 4xx status code
+func (o *DetailLinkCostTagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this detail link cost tag o k response has a 5xx status code
+func (o *DetailLinkCostTagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link cost tag o k response a status code equal to that given
+func (o *DetailLinkCostTagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the detail link cost tag o k response
+func (o *DetailLinkCostTagOK) Code() int {
+	return 200
+}
+
+func (o *DetailLinkCostTagOK) Error() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *DetailLinkCostTagOK) String() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *DetailLinkCostTagOK) GetPayload() *rest_model.DetailLinkCostTagEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkCostTagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.DetailLinkCostTagEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDetailLinkCostTagUnauthorized creates a DetailLinkCostTagUnauthorized with default headers values
+func NewDetailLinkCostTagUnauthorized() *DetailLinkCostTagUnauthorized {
+	return &DetailLinkCostTagUnauthorized{}
+}
+
+/*
+DetailLinkCostTagUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DetailLinkCostTagUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this detail link cost tag unauthorized response has a 2xx status code
+func (o *DetailLinkCostTagUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this detail link cost tag unauthorized response has a 3xx status code
+func (o *DetailLinkCostTagUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link cost tag unauthorized response has a 4xx status code
+func (o *DetailLinkCostTagUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this detail link cost tag unauthorized response has a 5xx status code
+func (o *DetailLinkCostTagUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link cost tag unauthorized response a status code equal to that given
+func (o *DetailLinkCostTagUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the detail link cost tag unauthorized response
+func (o *DetailLinkCostTagUnauthorized) Code() int {
+	return 401
+}
+
+func (o *DetailLinkCostTagUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DetailLinkCostTagUnauthorized) String() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DetailLinkCostTagUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkCostTagUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDetailLinkCostTagNotFound creates a DetailLinkCostTagNotFound with default headers values
+func NewDetailLinkCostTagNotFound() *DetailLinkCostTagNotFound {
+	return &DetailLinkCostTagNotFound{}
+}
+
+/*
+DetailLinkCostTagNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type DetailLinkCostTagNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this detail link cost tag not found response has a 2xx status code
+func (o *DetailLinkCostTagNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this detail link cost tag not found response has a 3xx status code
+func (o *DetailLinkCostTagNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this detail link cost tag not found response has a 4xx status code
+func (o *DetailLinkCostTagNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this detail link cost tag not found response has a 5xx status code
+func (o *DetailLinkCostTagNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this detail link cost tag not found response a status code equal to that given
+func (o *DetailLinkCostTagNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the detail link cost tag not found response
+func (o *DetailLinkCostTagNotFound) Code() int {
+	return 404
+}
+
+func (o *DetailLinkCostTagNotFound) Error() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DetailLinkCostTagNotFound) String() string {
+	return fmt.Sprintf("[GET /link-cost-tags/{id}][%d] detailLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DetailLinkCostTagNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DetailLinkCostTagNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -54,17 +54,71 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	CreateLinkCostTag(params *CreateLinkCostTagParams, opts ...ClientOption) (*CreateLinkCostTagCreated, error)
+
 	DeleteLink(params *DeleteLinkParams, opts ...ClientOption) (*DeleteLinkOK, error)
 
+	DeleteLinkCostTag(params *DeleteLinkCostTagParams, opts ...ClientOption) (*DeleteLinkCostTagOK, error)
+
 	DetailLink(params *DetailLinkParams, opts ...ClientOption) (*DetailLinkOK, error)
 
+	DetailLinkCostTag(params *DetailLinkCostTagParams, opts ...ClientOption) (*DetailLinkCostTagOK, error)
+
+	ListLinkCostTags(params *ListLinkCostTagsParams, opts ...ClientOption) (*ListLinkCostTagsOK, error)
+
 	ListLinks(params *ListLinksParams, opts ...ClientOption) (*ListLinksOK, error)
 
 	PatchLink(params *PatchLinkParams, opts ...ClientOption) (*PatchLinkOK, error)
 
+	PatchLinkCostTag(params *PatchLinkCostTagParams, opts ...ClientOption) (*PatchLinkCostTagOK, error)
+
+	UpdateLinkCostTag(params *UpdateLinkCostTagParams, opts ...ClientOption) (*UpdateLinkCostTagOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+	CreateLinkCostTag creates a link cost tag resource
+
+	Create a link cost tag resource. Links dialed on listeners with the named cost tag have their cost adjusted
+
+accordingly. Requires admin access.
+*/
+func (a *Client) CreateLinkCostTag(params *CreateLinkCostTagParams, opts ...ClientOption) (*CreateLinkCostTagCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateLinkCostTagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createLinkCostTag",
+		Method:             "POST",
+		PathPattern:        "/link-cost-tags",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateLinkCostTagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateLinkCostTagCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createLinkCostTag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DeleteLink deletes a link
 
@@ -105,6 +159,46 @@ func (a *Client) DeleteLink(params *DeleteLinkParams, opts ...ClientOption) (*De
 	panic(msg)
 }
 
+/*
+DeleteLinkCostTag deletes a link cost tag
+
+Delete a link cost tag by id. Requires admin access.
+*/
+func (a *Client) DeleteLinkCostTag(params *DeleteLinkCostTagParams, opts ...ClientOption) (*DeleteLinkCostTagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteLinkCostTagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteLinkCostTag",
+		Method:             "DELETE",
+		PathPattern:        "/link-cost-tags/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteLinkCostTagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteLinkCostTagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteLinkCostTag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   DetailLink retrieves a single link
 
@@ -145,6 +239,86 @@ func (a *Client) DetailLink(params *DetailLinkParams, opts ...ClientOption) (*De
 	panic(msg)
 }
 
+/*
+DetailLinkCostTag retrieves a single link cost tag
+
+Retrieves a single link cost tag by id. Requires admin access.
+*/
+func (a *Client) DetailLinkCostTag(params *DetailLinkCostTagParams, opts ...ClientOption) (*DetailLinkCostTagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDetailLinkCostTagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "detailLinkCostTag",
+		Method:             "GET",
+		PathPattern:        "/link-cost-tags/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DetailLinkCostTagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DetailLinkCostTagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for detailLinkCostTag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListLinkCostTags lists link cost tags
+
+Retrieves a list of link cost tag resources; supports filtering, sorting, and pagination. Requires admin access.
+*/
+func (a *Client) ListLinkCostTags(params *ListLinkCostTagsParams, opts ...ClientOption) (*ListLinkCostTagsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListLinkCostTagsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listLinkCostTags",
+		Method:             "GET",
+		PathPattern:        "/link-cost-tags",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListLinkCostTagsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListLinkCostTagsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listLinkCostTags: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListLinks lists links
 
@@ -226,6 +400,86 @@ func (a *Client) PatchLink(params *PatchLinkParams, opts ...ClientOption) (*Patc
 	panic(msg)
 }
 
+/*
+PatchLinkCostTag updates the supplied fields on a link cost tag
+
+Update the supplied fields on a link cost tag. Requires admin access.
+*/
+func (a *Client) PatchLinkCostTag(params *PatchLinkCostTagParams, opts ...ClientOption) (*PatchLinkCostTagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchLinkCostTagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "patchLinkCostTag",
+		Method:             "PATCH",
+		PathPattern:        "/link-cost-tags/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PatchLinkCostTagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchLinkCostTagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for patchLinkCostTag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateLinkCostTag updates all fields on a link cost tag
+
+Update all fields on a link cost tag by id. Requires admin access.
+*/
+func (a *Client) UpdateLinkCostTag(params *UpdateLinkCostTagParams, opts ...ClientOption) (*UpdateLinkCostTagOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateLinkCostTagParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateLinkCostTag",
+		Method:             "PUT",
+		PathPattern:        "/link-cost-tags/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UpdateLinkCostTagReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateLinkCostTagOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for updateLinkCostTag: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
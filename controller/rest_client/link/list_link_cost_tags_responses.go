@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// ListLinkCostTagsReader is a Reader for the ListLinkCostTags structure.
+type ListLinkCostTagsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListLinkCostTagsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListLinkCostTagsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListLinkCostTagsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /link-cost-tags] listLinkCostTags", response, response.Code())
+	}
+}
+
+// NewListLinkCostTagsOK creates a ListLinkCostTagsOK with default headers values
+func NewListLinkCostTagsOK() *ListLinkCostTagsOK {
+	return &ListLinkCostTagsOK{}
+}
+
+/*
+ListLinkCostTagsOK describes a response with status code 200, with default header values.
+
+A list of link cost tags
+*/
+type ListLinkCostTagsOK struct {
+	Payload *rest_model.ListLinkCostTagsEnvelope
+}
+
+// IsSuccess returns true when this list link cost tags o k response has a 2xx status code
+func (o *ListLinkCostTagsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list link cost tags o k response has a 3xx status code
+func (o *ListLinkCostTagsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list link cost tags o k response has a 4xx status code
+func (o *ListLinkCostTagsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list link cost tags o k response has a 5xx status code
+func (o *ListLinkCostTagsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list link cost tags o k response a status code equal to that given
+func (o *ListLinkCostTagsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list link cost tags o k response
+func (o *ListLinkCostTagsOK) Code() int {
+	return 200
+}
+
+func (o *ListLinkCostTagsOK) Error() string {
+	return fmt.Sprintf("[GET /link-cost-tags][%d] listLinkCostTagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListLinkCostTagsOK) String() string {
+	return fmt.Sprintf("[GET /link-cost-tags][%d] listLinkCostTagsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListLinkCostTagsOK) GetPayload() *rest_model.ListLinkCostTagsEnvelope {
+	return o.Payload
+}
+
+func (o *ListLinkCostTagsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.ListLinkCostTagsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListLinkCostTagsUnauthorized creates a ListLinkCostTagsUnauthorized with default headers values
+func NewListLinkCostTagsUnauthorized() *ListLinkCostTagsUnauthorized {
+	return &ListLinkCostTagsUnauthorized{}
+}
+
+/*
+ListLinkCostTagsUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type ListLinkCostTagsUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this list link cost tags unauthorized response has a 2xx status code
+func (o *ListLinkCostTagsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list link cost tags unauthorized response has a 3xx status code
+func (o *ListLinkCostTagsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list link cost tags unauthorized response has a 4xx status code
+func (o *ListLinkCostTagsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list link cost tags unauthorized response has a 5xx status code
+func (o *ListLinkCostTagsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list link cost tags unauthorized response a status code equal to that given
+func (o *ListLinkCostTagsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the list link cost tags unauthorized response
+func (o *ListLinkCostTagsUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ListLinkCostTagsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /link-cost-tags][%d] listLinkCostTagsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListLinkCostTagsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /link-cost-tags][%d] listLinkCostTagsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ListLinkCostTagsUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListLinkCostTagsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// NewPatchLinkCostTagParams creates a new PatchLinkCostTagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPatchLinkCostTagParams() *PatchLinkCostTagParams {
+	return &PatchLinkCostTagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPatchLinkCostTagParamsWithTimeout creates a new PatchLinkCostTagParams object
+// with the ability to set a timeout on a request.
+func NewPatchLinkCostTagParamsWithTimeout(timeout time.Duration) *PatchLinkCostTagParams {
+	return &PatchLinkCostTagParams{
+		timeout: timeout,
+	}
+}
+
+// NewPatchLinkCostTagParamsWithContext creates a new PatchLinkCostTagParams object
+// with the ability to set a context for a request.
+func NewPatchLinkCostTagParamsWithContext(ctx context.Context) *PatchLinkCostTagParams {
+	return &PatchLinkCostTagParams{
+		Context: ctx,
+	}
+}
+
+// NewPatchLinkCostTagParamsWithHTTPClient creates a new PatchLinkCostTagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPatchLinkCostTagParamsWithHTTPClient(client *http.Client) *PatchLinkCostTagParams {
+	return &PatchLinkCostTagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PatchLinkCostTagParams contains all the parameters to send to the API endpoint
+
+	for the patch link cost tag operation.
+
+	Typically these are written to a http.Request.
+*/
+type PatchLinkCostTagParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	/* LinkCostTag.
+
+	   A link cost tag patch object
+	*/
+	LinkCostTag *rest_model.LinkCostTagPatch
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the patch link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchLinkCostTagParams) WithDefaults() *PatchLinkCostTagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the patch link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchLinkCostTagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the patch link cost tag params
+func (o *PatchLinkCostTagParams) WithTimeout(timeout time.Duration) *PatchLinkCostTagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the patch link cost tag params
+func (o *PatchLinkCostTagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the patch link cost tag params
+func (o *PatchLinkCostTagParams) WithContext(ctx context.Context) *PatchLinkCostTagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the patch link cost tag params
+func (o *PatchLinkCostTagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the patch link cost tag params
+func (o *PatchLinkCostTagParams) WithHTTPClient(client *http.Client) *PatchLinkCostTagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the patch link cost tag params
+func (o *PatchLinkCostTagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the patch link cost tag params
+func (o *PatchLinkCostTagParams) WithID(id string) *PatchLinkCostTagParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the patch link cost tag params
+func (o *PatchLinkCostTagParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLinkCostTag adds the linkCostTag to the patch link cost tag params
+func (o *PatchLinkCostTagParams) WithLinkCostTag(linkCostTag *rest_model.LinkCostTagPatch) *PatchLinkCostTagParams {
+	o.SetLinkCostTag(linkCostTag)
+	return o
+}
+
+// SetLinkCostTag adds the linkCostTag to the patch link cost tag params
+func (o *PatchLinkCostTagParams) SetLinkCostTag(linkCostTag *rest_model.LinkCostTagPatch) {
+	o.LinkCostTag = linkCostTag
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PatchLinkCostTagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+	if o.LinkCostTag != nil {
+		if err := r.SetBodyParam(o.LinkCostTag); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
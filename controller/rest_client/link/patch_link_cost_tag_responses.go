@@ -0,0 +1,349 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// PatchLinkCostTagReader is a Reader for the PatchLinkCostTag structure.
+type PatchLinkCostTagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PatchLinkCostTagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPatchLinkCostTagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPatchLinkCostTagBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewPatchLinkCostTagUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPatchLinkCostTagNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /link-cost-tags/{id}] patchLinkCostTag", response, response.Code())
+	}
+}
+
+// NewPatchLinkCostTagOK creates a PatchLinkCostTagOK with default headers values
+func NewPatchLinkCostTagOK() *PatchLinkCostTagOK {
+	return &PatchLinkCostTagOK{}
+}
+
+/*
+PatchLinkCostTagOK describes a response with status code 200, with default header values.
+
+The patch request was successful and the resource has been altered
+*/
+type PatchLinkCostTagOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this patch link cost tag o k response has a 2xx status code
+func (o *PatchLinkCostTagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this patch link cost tag o k response has a 3xx status code
+func (o *PatchLinkCostTagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link cost tag o k response has a 4xx status code
+func (o *PatchLinkCostTagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this patch link cost tag o k response has a 5xx status code
+func (o *PatchLinkCostTagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link cost tag o k response a status code equal to that given
+func (o *PatchLinkCostTagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the patch link cost tag o k response
+func (o *PatchLinkCostTagOK) Code() int {
+	return 200
+}
+
+func (o *PatchLinkCostTagOK) Error() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *PatchLinkCostTagOK) String() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *PatchLinkCostTagOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *PatchLinkCostTagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkCostTagBadRequest creates a PatchLinkCostTagBadRequest with default headers values
+func NewPatchLinkCostTagBadRequest() *PatchLinkCostTagBadRequest {
+	return &PatchLinkCostTagBadRequest{}
+}
+
+/*
+PatchLinkCostTagBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type PatchLinkCostTagBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link cost tag bad request response has a 2xx status code
+func (o *PatchLinkCostTagBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link cost tag bad request response has a 3xx status code
+func (o *PatchLinkCostTagBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link cost tag bad request response has a 4xx status code
+func (o *PatchLinkCostTagBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link cost tag bad request response has a 5xx status code
+func (o *PatchLinkCostTagBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link cost tag bad request response a status code equal to that given
+func (o *PatchLinkCostTagBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the patch link cost tag bad request response
+func (o *PatchLinkCostTagBadRequest) Code() int {
+	return 400
+}
+
+func (o *PatchLinkCostTagBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PatchLinkCostTagBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *PatchLinkCostTagBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkCostTagBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkCostTagUnauthorized creates a PatchLinkCostTagUnauthorized with default headers values
+func NewPatchLinkCostTagUnauthorized() *PatchLinkCostTagUnauthorized {
+	return &PatchLinkCostTagUnauthorized{}
+}
+
+/*
+PatchLinkCostTagUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type PatchLinkCostTagUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link cost tag unauthorized response has a 2xx status code
+func (o *PatchLinkCostTagUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link cost tag unauthorized response has a 3xx status code
+func (o *PatchLinkCostTagUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link cost tag unauthorized response has a 4xx status code
+func (o *PatchLinkCostTagUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link cost tag unauthorized response has a 5xx status code
+func (o *PatchLinkCostTagUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link cost tag unauthorized response a status code equal to that given
+func (o *PatchLinkCostTagUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the patch link cost tag unauthorized response
+func (o *PatchLinkCostTagUnauthorized) Code() int {
+	return 401
+}
+
+func (o *PatchLinkCostTagUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PatchLinkCostTagUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PatchLinkCostTagUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkCostTagUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchLinkCostTagNotFound creates a PatchLinkCostTagNotFound with default headers values
+func NewPatchLinkCostTagNotFound() *PatchLinkCostTagNotFound {
+	return &PatchLinkCostTagNotFound{}
+}
+
+/*
+PatchLinkCostTagNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type PatchLinkCostTagNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this patch link cost tag not found response has a 2xx status code
+func (o *PatchLinkCostTagNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch link cost tag not found response has a 3xx status code
+func (o *PatchLinkCostTagNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch link cost tag not found response has a 4xx status code
+func (o *PatchLinkCostTagNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch link cost tag not found response has a 5xx status code
+func (o *PatchLinkCostTagNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch link cost tag not found response a status code equal to that given
+func (o *PatchLinkCostTagNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the patch link cost tag not found response
+func (o *PatchLinkCostTagNotFound) Code() int {
+	return 404
+}
+
+func (o *PatchLinkCostTagNotFound) Error() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PatchLinkCostTagNotFound) String() string {
+	return fmt.Sprintf("[PATCH /link-cost-tags/{id}][%d] patchLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *PatchLinkCostTagNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchLinkCostTagNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
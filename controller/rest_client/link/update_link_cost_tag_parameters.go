@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// NewUpdateLinkCostTagParams creates a new UpdateLinkCostTagParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateLinkCostTagParams() *UpdateLinkCostTagParams {
+	return &UpdateLinkCostTagParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateLinkCostTagParamsWithTimeout creates a new UpdateLinkCostTagParams object
+// with the ability to set a timeout on a request.
+func NewUpdateLinkCostTagParamsWithTimeout(timeout time.Duration) *UpdateLinkCostTagParams {
+	return &UpdateLinkCostTagParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateLinkCostTagParamsWithContext creates a new UpdateLinkCostTagParams object
+// with the ability to set a context for a request.
+func NewUpdateLinkCostTagParamsWithContext(ctx context.Context) *UpdateLinkCostTagParams {
+	return &UpdateLinkCostTagParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateLinkCostTagParamsWithHTTPClient creates a new UpdateLinkCostTagParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateLinkCostTagParamsWithHTTPClient(client *http.Client) *UpdateLinkCostTagParams {
+	return &UpdateLinkCostTagParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+UpdateLinkCostTagParams contains all the parameters to send to the API endpoint
+
+	for the update link cost tag operation.
+
+	Typically these are written to a http.Request.
+*/
+type UpdateLinkCostTagParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	/* LinkCostTag.
+
+	   A link cost tag update object
+	*/
+	LinkCostTag *rest_model.LinkCostTagUpdate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateLinkCostTagParams) WithDefaults() *UpdateLinkCostTagParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update link cost tag params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateLinkCostTagParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update link cost tag params
+func (o *UpdateLinkCostTagParams) WithTimeout(timeout time.Duration) *UpdateLinkCostTagParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update link cost tag params
+func (o *UpdateLinkCostTagParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update link cost tag params
+func (o *UpdateLinkCostTagParams) WithContext(ctx context.Context) *UpdateLinkCostTagParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update link cost tag params
+func (o *UpdateLinkCostTagParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update link cost tag params
+func (o *UpdateLinkCostTagParams) WithHTTPClient(client *http.Client) *UpdateLinkCostTagParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update link cost tag params
+func (o *UpdateLinkCostTagParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the update link cost tag params
+func (o *UpdateLinkCostTagParams) WithID(id string) *UpdateLinkCostTagParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update link cost tag params
+func (o *UpdateLinkCostTagParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLinkCostTag adds the linkCostTag to the update link cost tag params
+func (o *UpdateLinkCostTagParams) WithLinkCostTag(linkCostTag *rest_model.LinkCostTagUpdate) *UpdateLinkCostTagParams {
+	o.SetLinkCostTag(linkCostTag)
+	return o
+}
+
+// SetLinkCostTag adds the linkCostTag to the update link cost tag params
+func (o *UpdateLinkCostTagParams) SetLinkCostTag(linkCostTag *rest_model.LinkCostTagUpdate) {
+	o.LinkCostTag = linkCostTag
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateLinkCostTagParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+	if o.LinkCostTag != nil {
+		if err := r.SetBodyParam(o.LinkCostTag); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// UpdateLinkCostTagReader is a Reader for the UpdateLinkCostTag structure.
+type UpdateLinkCostTagReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateLinkCostTagReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateLinkCostTagOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateLinkCostTagBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewUpdateLinkCostTagUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateLinkCostTagNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /link-cost-tags/{id}] updateLinkCostTag", response, response.Code())
+	}
+}
+
+// NewUpdateLinkCostTagOK creates a UpdateLinkCostTagOK with default headers values
+func NewUpdateLinkCostTagOK() *UpdateLinkCostTagOK {
+	return &UpdateLinkCostTagOK{}
+}
+
+/*
+UpdateLinkCostTagOK describes a response with status code 200, with default header values.
+
+The update request was successful and the resource has been altered
+*/
+type UpdateLinkCostTagOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this update link cost tag o k response has a 2xx status code
+func (o *UpdateLinkCostTagOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update link cost tag o k response has a 3xx status code
+func (o *UpdateLinkCostTagOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link cost tag o k response has a 4xx status code
+func (o *UpdateLinkCostTagOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update link cost tag o k response has a 5xx status code
+func (o *UpdateLinkCostTagOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link cost tag o k response a status code equal to that given
+func (o *UpdateLinkCostTagOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update link cost tag o k response
+func (o *UpdateLinkCostTagOK) Code() int {
+	return 200
+}
+
+func (o *UpdateLinkCostTagOK) Error() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateLinkCostTagOK) String() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateLinkCostTagOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *UpdateLinkCostTagOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkCostTagBadRequest creates a UpdateLinkCostTagBadRequest with default headers values
+func NewUpdateLinkCostTagBadRequest() *UpdateLinkCostTagBadRequest {
+	return &UpdateLinkCostTagBadRequest{}
+}
+
+/*
+UpdateLinkCostTagBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type UpdateLinkCostTagBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link cost tag bad request response has a 2xx status code
+func (o *UpdateLinkCostTagBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link cost tag bad request response has a 3xx status code
+func (o *UpdateLinkCostTagBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link cost tag bad request response has a 4xx status code
+func (o *UpdateLinkCostTagBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link cost tag bad request response has a 5xx status code
+func (o *UpdateLinkCostTagBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link cost tag bad request response a status code equal to that given
+func (o *UpdateLinkCostTagBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the update link cost tag bad request response
+func (o *UpdateLinkCostTagBadRequest) Code() int {
+	return 400
+}
+
+func (o *UpdateLinkCostTagBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateLinkCostTagBadRequest) String() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *UpdateLinkCostTagBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkCostTagBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkCostTagUnauthorized creates a UpdateLinkCostTagUnauthorized with default headers values
+func NewUpdateLinkCostTagUnauthorized() *UpdateLinkCostTagUnauthorized {
+	return &UpdateLinkCostTagUnauthorized{}
+}
+
+/*
+UpdateLinkCostTagUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type UpdateLinkCostTagUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link cost tag unauthorized response has a 2xx status code
+func (o *UpdateLinkCostTagUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link cost tag unauthorized response has a 3xx status code
+func (o *UpdateLinkCostTagUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link cost tag unauthorized response has a 4xx status code
+func (o *UpdateLinkCostTagUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link cost tag unauthorized response has a 5xx status code
+func (o *UpdateLinkCostTagUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link cost tag unauthorized response a status code equal to that given
+func (o *UpdateLinkCostTagUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the update link cost tag unauthorized response
+func (o *UpdateLinkCostTagUnauthorized) Code() int {
+	return 401
+}
+
+func (o *UpdateLinkCostTagUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateLinkCostTagUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateLinkCostTagUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkCostTagUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLinkCostTagNotFound creates a UpdateLinkCostTagNotFound with default headers values
+func NewUpdateLinkCostTagNotFound() *UpdateLinkCostTagNotFound {
+	return &UpdateLinkCostTagNotFound{}
+}
+
+/*
+UpdateLinkCostTagNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type UpdateLinkCostTagNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this update link cost tag not found response has a 2xx status code
+func (o *UpdateLinkCostTagNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update link cost tag not found response has a 3xx status code
+func (o *UpdateLinkCostTagNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update link cost tag not found response has a 4xx status code
+func (o *UpdateLinkCostTagNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update link cost tag not found response has a 5xx status code
+func (o *UpdateLinkCostTagNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update link cost tag not found response a status code equal to that given
+func (o *UpdateLinkCostTagNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update link cost tag not found response
+func (o *UpdateLinkCostTagNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateLinkCostTagNotFound) Error() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateLinkCostTagNotFound) String() string {
+	return fmt.Sprintf("[PUT /link-cost-tags/{id}][%d] updateLinkCostTagNotFound  %+v", 404, o.Payload)
+}
+
+func (o *UpdateLinkCostTagNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateLinkCostTagNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// EndRouterMaintenanceReader is a Reader for the EndRouterMaintenance structure.
+type EndRouterMaintenanceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EndRouterMaintenanceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewEndRouterMaintenanceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewEndRouterMaintenanceUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewEndRouterMaintenanceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /routers/{id}/maintenance] endRouterMaintenance", response, response.Code())
+	}
+}
+
+// NewEndRouterMaintenanceOK creates a EndRouterMaintenanceOK with default headers values
+func NewEndRouterMaintenanceOK() *EndRouterMaintenanceOK {
+	return &EndRouterMaintenanceOK{}
+}
+
+/*
+EndRouterMaintenanceOK describes a response with status code 200, with default header values.
+
+Base empty response
+*/
+type EndRouterMaintenanceOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this end router maintenance o k response has a 2xx status code
+func (o *EndRouterMaintenanceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this end router maintenance o k response has a 3xx status code
+func (o *EndRouterMaintenanceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this end router maintenance o k response has a 4xx status code
+func (o *EndRouterMaintenanceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this end router maintenance o k response has a 5xx status code
+func (o *EndRouterMaintenanceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this end router maintenance o k response a status code equal to that given
+func (o *EndRouterMaintenanceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the end router maintenance o k response
+func (o *EndRouterMaintenanceOK) Code() int {
+	return 200
+}
+
+func (o *EndRouterMaintenanceOK) Error() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceOK  %+v", 200, o.Payload)
+}
+
+func (o *EndRouterMaintenanceOK) String() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceOK  %+v", 200, o.Payload)
+}
+
+func (o *EndRouterMaintenanceOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *EndRouterMaintenanceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEndRouterMaintenanceUnauthorized creates a EndRouterMaintenanceUnauthorized with default headers values
+func NewEndRouterMaintenanceUnauthorized() *EndRouterMaintenanceUnauthorized {
+	return &EndRouterMaintenanceUnauthorized{}
+}
+
+/*
+EndRouterMaintenanceUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type EndRouterMaintenanceUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this end router maintenance unauthorized response has a 2xx status code
+func (o *EndRouterMaintenanceUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this end router maintenance unauthorized response has a 3xx status code
+func (o *EndRouterMaintenanceUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this end router maintenance unauthorized response has a 4xx status code
+func (o *EndRouterMaintenanceUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this end router maintenance unauthorized response has a 5xx status code
+func (o *EndRouterMaintenanceUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this end router maintenance unauthorized response a status code equal to that given
+func (o *EndRouterMaintenanceUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the end router maintenance unauthorized response
+func (o *EndRouterMaintenanceUnauthorized) Code() int {
+	return 401
+}
+
+func (o *EndRouterMaintenanceUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *EndRouterMaintenanceUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *EndRouterMaintenanceUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *EndRouterMaintenanceUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEndRouterMaintenanceNotFound creates a EndRouterMaintenanceNotFound with default headers values
+func NewEndRouterMaintenanceNotFound() *EndRouterMaintenanceNotFound {
+	return &EndRouterMaintenanceNotFound{}
+}
+
+/*
+EndRouterMaintenanceNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type EndRouterMaintenanceNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this end router maintenance not found response has a 2xx status code
+func (o *EndRouterMaintenanceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this end router maintenance not found response has a 3xx status code
+func (o *EndRouterMaintenanceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this end router maintenance not found response has a 4xx status code
+func (o *EndRouterMaintenanceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this end router maintenance not found response has a 5xx status code
+func (o *EndRouterMaintenanceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this end router maintenance not found response a status code equal to that given
+func (o *EndRouterMaintenanceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the end router maintenance not found response
+func (o *EndRouterMaintenanceNotFound) Code() int {
+	return 404
+}
+
+func (o *EndRouterMaintenanceNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *EndRouterMaintenanceNotFound) String() string {
+	return fmt.Sprintf("[DELETE /routers/{id}/maintenance][%d] endRouterMaintenanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *EndRouterMaintenanceNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *EndRouterMaintenanceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
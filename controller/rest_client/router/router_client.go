@@ -60,12 +60,16 @@ type ClientService interface {
 
 	DetailRouter(params *DetailRouterParams, opts ...ClientOption) (*DetailRouterOK, error)
 
+	EndRouterMaintenance(params *EndRouterMaintenanceParams, opts ...ClientOption) (*EndRouterMaintenanceOK, error)
+
 	ListRouterTerminators(params *ListRouterTerminatorsParams, opts ...ClientOption) (*ListRouterTerminatorsOK, error)
 
 	ListRouters(params *ListRoutersParams, opts ...ClientOption) (*ListRoutersOK, error)
 
 	PatchRouter(params *PatchRouterParams, opts ...ClientOption) (*PatchRouterOK, error)
 
+	StartRouterMaintenance(params *StartRouterMaintenanceParams, opts ...ClientOption) (*StartRouterMaintenanceOK, error)
+
 	UpdateRouter(params *UpdateRouterParams, opts ...ClientOption) (*UpdateRouterOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -191,6 +195,46 @@ func (a *Client) DetailRouter(params *DetailRouterParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+  EndRouterMaintenance ends router maintenance
+
+  Returns a router in maintenance to service, so it can be used as transit again. Requires admin access.
+*/
+func (a *Client) EndRouterMaintenance(params *EndRouterMaintenanceParams, opts ...ClientOption) (*EndRouterMaintenanceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEndRouterMaintenanceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "endRouterMaintenance",
+		Method:             "DELETE",
+		PathPattern:        "/routers/{id}/maintenance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EndRouterMaintenanceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EndRouterMaintenanceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for endRouterMaintenance: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListRouterTerminators lists of terminators assigned to a router
 
@@ -313,6 +357,49 @@ func (a *Client) PatchRouter(params *PatchRouterParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+  StartRouterMaintenance starts router maintenance
+
+  Stops the router being used as transit for new paths and reroutes circuits which transit it. Router events
+  report the number of circuits still transiting the router until it reaches zero, at which point the router is
+  safe to stop. Requires admin access.
+
+*/
+func (a *Client) StartRouterMaintenance(params *StartRouterMaintenanceParams, opts ...ClientOption) (*StartRouterMaintenanceOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStartRouterMaintenanceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "startRouterMaintenance",
+		Method:             "POST",
+		PathPattern:        "/routers/{id}/maintenance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &StartRouterMaintenanceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StartRouterMaintenanceOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for startRouterMaintenance: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   UpdateRouter updates all fields on a router
 
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewStartRouterMaintenanceParams creates a new StartRouterMaintenanceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStartRouterMaintenanceParams() *StartRouterMaintenanceParams {
+	return &StartRouterMaintenanceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStartRouterMaintenanceParamsWithTimeout creates a new StartRouterMaintenanceParams object
+// with the ability to set a timeout on a request.
+func NewStartRouterMaintenanceParamsWithTimeout(timeout time.Duration) *StartRouterMaintenanceParams {
+	return &StartRouterMaintenanceParams{
+		timeout: timeout,
+	}
+}
+
+// NewStartRouterMaintenanceParamsWithContext creates a new StartRouterMaintenanceParams object
+// with the ability to set a context for a request.
+func NewStartRouterMaintenanceParamsWithContext(ctx context.Context) *StartRouterMaintenanceParams {
+	return &StartRouterMaintenanceParams{
+		Context: ctx,
+	}
+}
+
+// NewStartRouterMaintenanceParamsWithHTTPClient creates a new StartRouterMaintenanceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStartRouterMaintenanceParamsWithHTTPClient(client *http.Client) *StartRouterMaintenanceParams {
+	return &StartRouterMaintenanceParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StartRouterMaintenanceParams contains all the parameters to send to the API endpoint
+
+	for the start router maintenance operation.
+
+	Typically these are written to a http.Request.
+*/
+type StartRouterMaintenanceParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the start router maintenance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StartRouterMaintenanceParams) WithDefaults() *StartRouterMaintenanceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the start router maintenance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StartRouterMaintenanceParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the start router maintenance params
+func (o *StartRouterMaintenanceParams) WithTimeout(timeout time.Duration) *StartRouterMaintenanceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the start router maintenance params
+func (o *StartRouterMaintenanceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the start router maintenance params
+func (o *StartRouterMaintenanceParams) WithContext(ctx context.Context) *StartRouterMaintenanceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the start router maintenance params
+func (o *StartRouterMaintenanceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the start router maintenance params
+func (o *StartRouterMaintenanceParams) WithHTTPClient(client *http.Client) *StartRouterMaintenanceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the start router maintenance params
+func (o *StartRouterMaintenanceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the start router maintenance params
+func (o *StartRouterMaintenanceParams) WithID(id string) *StartRouterMaintenanceParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the start router maintenance params
+func (o *StartRouterMaintenanceParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StartRouterMaintenanceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// StartRouterMaintenanceReader is a Reader for the StartRouterMaintenance structure.
+type StartRouterMaintenanceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StartRouterMaintenanceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStartRouterMaintenanceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewStartRouterMaintenanceUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewStartRouterMaintenanceNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /routers/{id}/maintenance] startRouterMaintenance", response, response.Code())
+	}
+}
+
+// NewStartRouterMaintenanceOK creates a StartRouterMaintenanceOK with default headers values
+func NewStartRouterMaintenanceOK() *StartRouterMaintenanceOK {
+	return &StartRouterMaintenanceOK{}
+}
+
+/*
+StartRouterMaintenanceOK describes a response with status code 200, with default header values.
+
+Base empty response
+*/
+type StartRouterMaintenanceOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this start router maintenance o k response has a 2xx status code
+func (o *StartRouterMaintenanceOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this start router maintenance o k response has a 3xx status code
+func (o *StartRouterMaintenanceOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this start router maintenance o k response has a 4xx status code
+func (o *StartRouterMaintenanceOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this start router maintenance o k response has a 5xx status code
+func (o *StartRouterMaintenanceOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this start router maintenance o k response a status code equal to that given
+func (o *StartRouterMaintenanceOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the start router maintenance o k response
+func (o *StartRouterMaintenanceOK) Code() int {
+	return 200
+}
+
+func (o *StartRouterMaintenanceOK) Error() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceOK  %+v", 200, o.Payload)
+}
+
+func (o *StartRouterMaintenanceOK) String() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceOK  %+v", 200, o.Payload)
+}
+
+func (o *StartRouterMaintenanceOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *StartRouterMaintenanceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStartRouterMaintenanceUnauthorized creates a StartRouterMaintenanceUnauthorized with default headers values
+func NewStartRouterMaintenanceUnauthorized() *StartRouterMaintenanceUnauthorized {
+	return &StartRouterMaintenanceUnauthorized{}
+}
+
+/*
+StartRouterMaintenanceUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type StartRouterMaintenanceUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this start router maintenance unauthorized response has a 2xx status code
+func (o *StartRouterMaintenanceUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this start router maintenance unauthorized response has a 3xx status code
+func (o *StartRouterMaintenanceUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this start router maintenance unauthorized response has a 4xx status code
+func (o *StartRouterMaintenanceUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this start router maintenance unauthorized response has a 5xx status code
+func (o *StartRouterMaintenanceUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this start router maintenance unauthorized response a status code equal to that given
+func (o *StartRouterMaintenanceUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the start router maintenance unauthorized response
+func (o *StartRouterMaintenanceUnauthorized) Code() int {
+	return 401
+}
+
+func (o *StartRouterMaintenanceUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *StartRouterMaintenanceUnauthorized) String() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *StartRouterMaintenanceUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *StartRouterMaintenanceUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStartRouterMaintenanceNotFound creates a StartRouterMaintenanceNotFound with default headers values
+func NewStartRouterMaintenanceNotFound() *StartRouterMaintenanceNotFound {
+	return &StartRouterMaintenanceNotFound{}
+}
+
+/*
+StartRouterMaintenanceNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type StartRouterMaintenanceNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this start router maintenance not found response has a 2xx status code
+func (o *StartRouterMaintenanceNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this start router maintenance not found response has a 3xx status code
+func (o *StartRouterMaintenanceNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this start router maintenance not found response has a 4xx status code
+func (o *StartRouterMaintenanceNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this start router maintenance not found response has a 5xx status code
+func (o *StartRouterMaintenanceNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this start router maintenance not found response a status code equal to that given
+func (o *StartRouterMaintenanceNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the start router maintenance not found response
+func (o *StartRouterMaintenanceNotFound) Code() int {
+	return 404
+}
+
+func (o *StartRouterMaintenanceNotFound) Error() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StartRouterMaintenanceNotFound) String() string {
+	return fmt.Sprintf("[POST /routers/{id}/maintenance][%d] startRouterMaintenanceNotFound  %+v", 404, o.Payload)
+}
+
+func (o *StartRouterMaintenanceNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *StartRouterMaintenanceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
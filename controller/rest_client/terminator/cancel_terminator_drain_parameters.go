@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCancelTerminatorDrainParams creates a new CancelTerminatorDrainParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCancelTerminatorDrainParams() *CancelTerminatorDrainParams {
+	return &CancelTerminatorDrainParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCancelTerminatorDrainParamsWithTimeout creates a new CancelTerminatorDrainParams object
+// with the ability to set a timeout on a request.
+func NewCancelTerminatorDrainParamsWithTimeout(timeout time.Duration) *CancelTerminatorDrainParams {
+	return &CancelTerminatorDrainParams{
+		timeout: timeout,
+	}
+}
+
+// NewCancelTerminatorDrainParamsWithContext creates a new CancelTerminatorDrainParams object
+// with the ability to set a context for a request.
+func NewCancelTerminatorDrainParamsWithContext(ctx context.Context) *CancelTerminatorDrainParams {
+	return &CancelTerminatorDrainParams{
+		Context: ctx,
+	}
+}
+
+// NewCancelTerminatorDrainParamsWithHTTPClient creates a new CancelTerminatorDrainParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCancelTerminatorDrainParamsWithHTTPClient(client *http.Client) *CancelTerminatorDrainParams {
+	return &CancelTerminatorDrainParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CancelTerminatorDrainParams contains all the parameters to send to the API endpoint
+
+	for the cancel terminator drain operation.
+
+	Typically these are written to a http.Request.
+*/
+type CancelTerminatorDrainParams struct {
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the cancel terminator drain params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelTerminatorDrainParams) WithDefaults() *CancelTerminatorDrainParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the cancel terminator drain params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CancelTerminatorDrainParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) WithTimeout(timeout time.Duration) *CancelTerminatorDrainParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) WithContext(ctx context.Context) *CancelTerminatorDrainParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) WithHTTPClient(client *http.Client) *CancelTerminatorDrainParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) WithID(id string) *CancelTerminatorDrainParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the cancel terminator drain params
+func (o *CancelTerminatorDrainParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CancelTerminatorDrainParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
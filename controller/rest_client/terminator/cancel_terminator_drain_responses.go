@@ -0,0 +1,275 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// CancelTerminatorDrainReader is a Reader for the CancelTerminatorDrain structure.
+type CancelTerminatorDrainReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CancelTerminatorDrainReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCancelTerminatorDrainOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewCancelTerminatorDrainUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewCancelTerminatorDrainNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /terminators/{id}/drain] cancelTerminatorDrain", response, response.Code())
+	}
+}
+
+// NewCancelTerminatorDrainOK creates a CancelTerminatorDrainOK with default headers values
+func NewCancelTerminatorDrainOK() *CancelTerminatorDrainOK {
+	return &CancelTerminatorDrainOK{}
+}
+
+/*
+CancelTerminatorDrainOK describes a response with status code 200, with default header values.
+
+Base empty response
+*/
+type CancelTerminatorDrainOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this cancel terminator drain o k response has a 2xx status code
+func (o *CancelTerminatorDrainOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this cancel terminator drain o k response has a 3xx status code
+func (o *CancelTerminatorDrainOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel terminator drain o k response has a 4xx status code
+func (o *CancelTerminatorDrainOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this cancel terminator drain o k response has a 5xx status code
+func (o *CancelTerminatorDrainOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel terminator drain o k response a status code equal to that given
+func (o *CancelTerminatorDrainOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the cancel terminator drain o k response
+func (o *CancelTerminatorDrainOK) Code() int {
+	return 200
+}
+
+func (o *CancelTerminatorDrainOK) Error() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainOK  %+v", 200, o.Payload)
+}
+
+func (o *CancelTerminatorDrainOK) String() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainOK  %+v", 200, o.Payload)
+}
+
+func (o *CancelTerminatorDrainOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *CancelTerminatorDrainOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCancelTerminatorDrainUnauthorized creates a CancelTerminatorDrainUnauthorized with default headers values
+func NewCancelTerminatorDrainUnauthorized() *CancelTerminatorDrainUnauthorized {
+	return &CancelTerminatorDrainUnauthorized{}
+}
+
+/*
+CancelTerminatorDrainUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type CancelTerminatorDrainUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this cancel terminator drain unauthorized response has a 2xx status code
+func (o *CancelTerminatorDrainUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cancel terminator drain unauthorized response has a 3xx status code
+func (o *CancelTerminatorDrainUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel terminator drain unauthorized response has a 4xx status code
+func (o *CancelTerminatorDrainUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cancel terminator drain unauthorized response has a 5xx status code
+func (o *CancelTerminatorDrainUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel terminator drain unauthorized response a status code equal to that given
+func (o *CancelTerminatorDrainUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the cancel terminator drain unauthorized response
+func (o *CancelTerminatorDrainUnauthorized) Code() int {
+	return 401
+}
+
+func (o *CancelTerminatorDrainUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CancelTerminatorDrainUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *CancelTerminatorDrainUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CancelTerminatorDrainUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCancelTerminatorDrainNotFound creates a CancelTerminatorDrainNotFound with default headers values
+func NewCancelTerminatorDrainNotFound() *CancelTerminatorDrainNotFound {
+	return &CancelTerminatorDrainNotFound{}
+}
+
+/*
+CancelTerminatorDrainNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type CancelTerminatorDrainNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this cancel terminator drain not found response has a 2xx status code
+func (o *CancelTerminatorDrainNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this cancel terminator drain not found response has a 3xx status code
+func (o *CancelTerminatorDrainNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this cancel terminator drain not found response has a 4xx status code
+func (o *CancelTerminatorDrainNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this cancel terminator drain not found response has a 5xx status code
+func (o *CancelTerminatorDrainNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this cancel terminator drain not found response a status code equal to that given
+func (o *CancelTerminatorDrainNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the cancel terminator drain not found response
+func (o *CancelTerminatorDrainNotFound) Code() int {
+	return 404
+}
+
+func (o *CancelTerminatorDrainNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CancelTerminatorDrainNotFound) String() string {
+	return fmt.Sprintf("[DELETE /terminators/{id}/drain][%d] cancelTerminatorDrainNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CancelTerminatorDrainNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CancelTerminatorDrainNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// NewDrainTerminatorParams creates a new DrainTerminatorParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDrainTerminatorParams() *DrainTerminatorParams {
+	return &DrainTerminatorParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDrainTerminatorParamsWithTimeout creates a new DrainTerminatorParams object
+// with the ability to set a timeout on a request.
+func NewDrainTerminatorParamsWithTimeout(timeout time.Duration) *DrainTerminatorParams {
+	return &DrainTerminatorParams{
+		timeout: timeout,
+	}
+}
+
+// NewDrainTerminatorParamsWithContext creates a new DrainTerminatorParams object
+// with the ability to set a context for a request.
+func NewDrainTerminatorParamsWithContext(ctx context.Context) *DrainTerminatorParams {
+	return &DrainTerminatorParams{
+		Context: ctx,
+	}
+}
+
+// NewDrainTerminatorParamsWithHTTPClient creates a new DrainTerminatorParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDrainTerminatorParamsWithHTTPClient(client *http.Client) *DrainTerminatorParams {
+	return &DrainTerminatorParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+DrainTerminatorParams contains all the parameters to send to the API endpoint
+
+	for the drain terminator operation.
+
+	Typically these are written to a http.Request.
+*/
+type DrainTerminatorParams struct {
+
+	/* Drain.
+
+	   drain options
+	*/
+	Drain *rest_model.TerminatorDrain
+
+	/* ID.
+
+	   The id of the requested resource
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the drain terminator params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DrainTerminatorParams) WithDefaults() *DrainTerminatorParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the drain terminator params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DrainTerminatorParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the drain terminator params
+func (o *DrainTerminatorParams) WithTimeout(timeout time.Duration) *DrainTerminatorParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the drain terminator params
+func (o *DrainTerminatorParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the drain terminator params
+func (o *DrainTerminatorParams) WithContext(ctx context.Context) *DrainTerminatorParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the drain terminator params
+func (o *DrainTerminatorParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the drain terminator params
+func (o *DrainTerminatorParams) WithHTTPClient(client *http.Client) *DrainTerminatorParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the drain terminator params
+func (o *DrainTerminatorParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDrain adds the drain to the drain terminator params
+func (o *DrainTerminatorParams) WithDrain(drain *rest_model.TerminatorDrain) *DrainTerminatorParams {
+	o.SetDrain(drain)
+	return o
+}
+
+// SetDrain adds the drain to the drain terminator params
+func (o *DrainTerminatorParams) SetDrain(drain *rest_model.TerminatorDrain) {
+	o.Drain = drain
+}
+
+// WithID adds the id to the drain terminator params
+func (o *DrainTerminatorParams) WithID(id string) *DrainTerminatorParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the drain terminator params
+func (o *DrainTerminatorParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DrainTerminatorParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Drain != nil {
+		if err := r.SetBodyParam(o.Drain); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DrainTerminatorReader is a Reader for the DrainTerminator structure.
+type DrainTerminatorReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DrainTerminatorReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDrainTerminatorOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDrainTerminatorBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewDrainTerminatorUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDrainTerminatorNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /terminators/{id}/drain] drainTerminator", response, response.Code())
+	}
+}
+
+// NewDrainTerminatorOK creates a DrainTerminatorOK with default headers values
+func NewDrainTerminatorOK() *DrainTerminatorOK {
+	return &DrainTerminatorOK{}
+}
+
+/*
+DrainTerminatorOK describes a response with status code 200, with default header values.
+
+Base empty response
+*/
+type DrainTerminatorOK struct {
+	Payload *rest_model.Empty
+}
+
+// IsSuccess returns true when this drain terminator o k response has a 2xx status code
+func (o *DrainTerminatorOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this drain terminator o k response has a 3xx status code
+func (o *DrainTerminatorOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this drain terminator o k response has a 4xx status code
+func (o *DrainTerminatorOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this drain terminator o k response has a 5xx status code
+func (o *DrainTerminatorOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this drain terminator o k response a status code equal to that given
+func (o *DrainTerminatorOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the drain terminator o k response
+func (o *DrainTerminatorOK) Code() int {
+	return 200
+}
+
+func (o *DrainTerminatorOK) Error() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorOK  %+v", 200, o.Payload)
+}
+
+func (o *DrainTerminatorOK) String() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorOK  %+v", 200, o.Payload)
+}
+
+func (o *DrainTerminatorOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *DrainTerminatorOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDrainTerminatorBadRequest creates a DrainTerminatorBadRequest with default headers values
+func NewDrainTerminatorBadRequest() *DrainTerminatorBadRequest {
+	return &DrainTerminatorBadRequest{}
+}
+
+/*
+DrainTerminatorBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type DrainTerminatorBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this drain terminator bad request response has a 2xx status code
+func (o *DrainTerminatorBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this drain terminator bad request response has a 3xx status code
+func (o *DrainTerminatorBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this drain terminator bad request response has a 4xx status code
+func (o *DrainTerminatorBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this drain terminator bad request response has a 5xx status code
+func (o *DrainTerminatorBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this drain terminator bad request response a status code equal to that given
+func (o *DrainTerminatorBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the drain terminator bad request response
+func (o *DrainTerminatorBadRequest) Code() int {
+	return 400
+}
+
+func (o *DrainTerminatorBadRequest) Error() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DrainTerminatorBadRequest) String() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *DrainTerminatorBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DrainTerminatorBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDrainTerminatorUnauthorized creates a DrainTerminatorUnauthorized with default headers values
+func NewDrainTerminatorUnauthorized() *DrainTerminatorUnauthorized {
+	return &DrainTerminatorUnauthorized{}
+}
+
+/*
+DrainTerminatorUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DrainTerminatorUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this drain terminator unauthorized response has a 2xx status code
+func (o *DrainTerminatorUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this drain terminator unauthorized response has a 3xx status code
+func (o *DrainTerminatorUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this drain terminator unauthorized response has a 4xx status code
+func (o *DrainTerminatorUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this drain terminator unauthorized response has a 5xx status code
+func (o *DrainTerminatorUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this drain terminator unauthorized response a status code equal to that given
+func (o *DrainTerminatorUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the drain terminator unauthorized response
+func (o *DrainTerminatorUnauthorized) Code() int {
+	return 401
+}
+
+func (o *DrainTerminatorUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DrainTerminatorUnauthorized) String() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *DrainTerminatorUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DrainTerminatorUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDrainTerminatorNotFound creates a DrainTerminatorNotFound with default headers values
+func NewDrainTerminatorNotFound() *DrainTerminatorNotFound {
+	return &DrainTerminatorNotFound{}
+}
+
+/*
+DrainTerminatorNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type DrainTerminatorNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+// IsSuccess returns true when this drain terminator not found response has a 2xx status code
+func (o *DrainTerminatorNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this drain terminator not found response has a 3xx status code
+func (o *DrainTerminatorNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this drain terminator not found response has a 4xx status code
+func (o *DrainTerminatorNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this drain terminator not found response has a 5xx status code
+func (o *DrainTerminatorNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this drain terminator not found response a status code equal to that given
+func (o *DrainTerminatorNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the drain terminator not found response
+func (o *DrainTerminatorNotFound) Code() int {
+	return 404
+}
+
+func (o *DrainTerminatorNotFound) Error() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DrainTerminatorNotFound) String() string {
+	return fmt.Sprintf("[POST /terminators/{id}/drain][%d] drainTerminatorNotFound  %+v", 404, o.Payload)
+}
+
+func (o *DrainTerminatorNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DrainTerminatorNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
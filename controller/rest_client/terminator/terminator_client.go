@@ -54,12 +54,16 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	CancelTerminatorDrain(params *CancelTerminatorDrainParams, opts ...ClientOption) (*CancelTerminatorDrainOK, error)
+
 	CreateTerminator(params *CreateTerminatorParams, opts ...ClientOption) (*CreateTerminatorCreated, error)
 
 	DeleteTerminator(params *DeleteTerminatorParams, opts ...ClientOption) (*DeleteTerminatorOK, error)
 
 	DetailTerminator(params *DetailTerminatorParams, opts ...ClientOption) (*DetailTerminatorOK, error)
 
+	DrainTerminator(params *DrainTerminatorParams, opts ...ClientOption) (*DrainTerminatorOK, error)
+
 	ListTerminators(params *ListTerminatorsParams, opts ...ClientOption) (*ListTerminatorsOK, error)
 
 	PatchTerminator(params *PatchTerminatorParams, opts ...ClientOption) (*PatchTerminatorOK, error)
@@ -69,6 +73,46 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  CancelTerminatorDrain cancels a terminator drain
+
+  Returns a draining terminator to service, so it can receive new circuits again. Requires admin access.
+*/
+func (a *Client) CancelTerminatorDrain(params *CancelTerminatorDrainParams, opts ...ClientOption) (*CancelTerminatorDrainOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelTerminatorDrainParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cancelTerminatorDrain",
+		Method:             "DELETE",
+		PathPattern:        "/terminators/{id}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CancelTerminatorDrainReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CancelTerminatorDrainOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cancelTerminatorDrain: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   CreateTerminator creates a terminator resource
 
@@ -189,6 +233,49 @@ func (a *Client) DetailTerminator(params *DetailTerminatorParams, opts ...Client
 	panic(msg)
 }
 
+/*
+  DrainTerminator drains a terminator
+
+  Stops new circuits from using a terminator, leaving existing circuits to finish. Terminator events report the
+  number of circuits still using the terminator until it reaches zero. If forceCloseAfter is given, circuits
+  still using the terminator after that many seconds are closed. Requires admin access.
+
+*/
+func (a *Client) DrainTerminator(params *DrainTerminatorParams, opts ...ClientOption) (*DrainTerminatorOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDrainTerminatorParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "drainTerminator",
+		Method:             "POST",
+		PathPattern:        "/terminators/{id}/drain",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DrainTerminatorReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DrainTerminatorOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for drainTerminator: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListTerminators lists terminators
 
@@ -37,6 +37,7 @@ import (
 	"github.com/openziti/fabric/controller/rest_client/circuit"
 	"github.com/openziti/fabric/controller/rest_client/database"
 	"github.com/openziti/fabric/controller/rest_client/inspect"
+	"github.com/openziti/fabric/controller/rest_client/journal"
 	"github.com/openziti/fabric/controller/rest_client/link"
 	"github.com/openziti/fabric/controller/rest_client/raft"
 	"github.com/openziti/fabric/controller/rest_client/router"
@@ -89,6 +90,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiFabric
 	cli.Circuit = circuit.New(transport, formats)
 	cli.Database = database.New(transport, formats)
 	cli.Inspect = inspect.New(transport, formats)
+	cli.Journal = journal.New(transport, formats)
 	cli.Link = link.New(transport, formats)
 	cli.Raft = raft.New(transport, formats)
 	cli.Router = router.New(transport, formats)
@@ -144,6 +146,8 @@ type ZitiFabric struct {
 
 	Inspect inspect.ClientService
 
+	Journal journal.ClientService
+
 	Link link.ClientService
 
 	Raft raft.ClientService
@@ -163,6 +167,7 @@ func (c *ZitiFabric) SetTransport(transport runtime.ClientTransport) {
 	c.Circuit.SetTransport(transport)
 	c.Database.SetTransport(transport)
 	c.Inspect.SetTransport(transport)
+	c.Journal.SetTransport(transport)
 	c.Link.SetTransport(transport)
 	c.Raft.SetTransport(transport)
 	c.Router.SetTransport(transport)
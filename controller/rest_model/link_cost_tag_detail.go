@@ -0,0 +1,206 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LinkCostTagDetail link cost tag detail
+//
+// swagger:model linkCostTagDetail
+type LinkCostTagDetail struct {
+	BaseEntity
+
+	// additive cost
+	// Required: true
+	AdditiveCost *int64 `json:"additiveCost"`
+
+	// cost multiplier
+	// Required: true
+	CostMultiplier *float64 `json:"costMultiplier"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+}
+
+// UnmarshalJSON unmarshals this object from a JSON structure
+func (m *LinkCostTagDetail) UnmarshalJSON(raw []byte) error {
+	// AO0
+	var aO0 BaseEntity
+	if err := swag.ReadJSON(raw, &aO0); err != nil {
+		return err
+	}
+	m.BaseEntity = aO0
+
+	// AO1
+	var dataAO1 struct {
+		AdditiveCost *int64 `json:"additiveCost"`
+
+		CostMultiplier *float64 `json:"costMultiplier"`
+
+		Name *string `json:"name"`
+	}
+	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
+		return err
+	}
+
+	m.AdditiveCost = dataAO1.AdditiveCost
+
+	m.CostMultiplier = dataAO1.CostMultiplier
+
+	m.Name = dataAO1.Name
+
+	return nil
+}
+
+// MarshalJSON marshals this object to a JSON structure
+func (m LinkCostTagDetail) MarshalJSON() ([]byte, error) {
+	_parts := make([][]byte, 0, 2)
+
+	aO0, err := swag.WriteJSON(m.BaseEntity)
+	if err != nil {
+		return nil, err
+	}
+	_parts = append(_parts, aO0)
+	var dataAO1 struct {
+		AdditiveCost *int64 `json:"additiveCost"`
+
+		CostMultiplier *float64 `json:"costMultiplier"`
+
+		Name *string `json:"name"`
+	}
+
+	dataAO1.AdditiveCost = m.AdditiveCost
+
+	dataAO1.CostMultiplier = m.CostMultiplier
+
+	dataAO1.Name = m.Name
+
+	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
+	if errAO1 != nil {
+		return nil, errAO1
+	}
+	_parts = append(_parts, jsonDataAO1)
+	return swag.ConcatJSON(_parts...), nil
+}
+
+// Validate validates this link cost tag detail
+func (m *LinkCostTagDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with BaseEntity
+	if err := m.BaseEntity.Validate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAdditiveCost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCostMultiplier(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LinkCostTagDetail) validateAdditiveCost(formats strfmt.Registry) error {
+
+	if err := validate.Required("additiveCost", "body", m.AdditiveCost); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LinkCostTagDetail) validateCostMultiplier(formats strfmt.Registry) error {
+
+	if err := validate.Required("costMultiplier", "body", m.CostMultiplier); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LinkCostTagDetail) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this link cost tag detail based on the context it is used
+func (m *LinkCostTagDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	// validation for a type composition with BaseEntity
+	if err := m.BaseEntity.ContextValidate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LinkCostTagDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LinkCostTagDetail) UnmarshalBinary(b []byte) error {
+	var res LinkCostTagDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -47,6 +47,9 @@ type LinkDetail struct {
 	// Required: true
 	Cost *int64 `json:"cost"`
 
+	// cost tags
+	CostTags []string `json:"costTags"`
+
 	// dest latency
 	// Required: true
 	DestLatency *int64 `json:"destLatency"`
@@ -67,6 +70,9 @@ type LinkDetail struct {
 	// Required: true
 	Protocol *string `json:"protocol"`
 
+	// quality cost
+	QualityCost int64 `json:"qualityCost,omitempty"`
+
 	// source latency
 	// Required: true
 	SourceLatency *int64 `json:"sourceLatency"`
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// MultipathMode multipath mode
+//
+// swagger:model multipathMode
+type MultipathMode string
+
+func NewMultipathMode(value MultipathMode) *MultipathMode {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated MultipathMode.
+func (m MultipathMode) Pointer() *MultipathMode {
+	return &m
+}
+
+const (
+
+	// MultipathModeNone captures enum value "none"
+	MultipathModeNone MultipathMode = "none"
+
+	// MultipathModeStripe captures enum value "stripe"
+	MultipathModeStripe MultipathMode = "stripe"
+
+	// MultipathModeDuplicate captures enum value "duplicate"
+	MultipathModeDuplicate MultipathMode = "duplicate"
+)
+
+// for schema
+var multipathModeEnum []interface{}
+
+func init() {
+	var res []MultipathMode
+	if err := json.Unmarshal([]byte(`["none","stripe","duplicate"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		multipathModeEnum = append(multipathModeEnum, v)
+	}
+}
+
+func (m MultipathMode) validateMultipathModeEnum(path, location string, value MultipathMode) error {
+	if err := validate.EnumCase(path, location, value, multipathModeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this multipath mode
+func (m MultipathMode) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateMultipathModeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this multipath mode based on context it is used
+func (m MultipathMode) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
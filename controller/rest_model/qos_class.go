@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// QosClass qos class
+//
+// swagger:model qosClass
+type QosClass string
+
+func NewQosClass(value QosClass) *QosClass {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated QosClass.
+func (m QosClass) Pointer() *QosClass {
+	return &m
+}
+
+const (
+
+	// QosClassInteractive captures enum value "interactive"
+	QosClassInteractive QosClass = "interactive"
+
+	// QosClassDefault captures enum value "default"
+	QosClassDefault QosClass = "default"
+
+	// QosClassBulk captures enum value "bulk"
+	QosClassBulk QosClass = "bulk"
+)
+
+// for schema
+var qosClassEnum []interface{}
+
+func init() {
+	var res []QosClass
+	if err := json.Unmarshal([]byte(`["interactive","default","bulk"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		qosClassEnum = append(qosClassEnum, v)
+	}
+}
+
+func (m QosClass) validateQosClassEnum(path, location string, value QosClass) error {
+	if err := validate.EnumCase(path, location, value, qosClassEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this qos class
+func (m QosClass) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateQosClassEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this qos class based on context it is used
+func (m QosClass) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
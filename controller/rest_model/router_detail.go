@@ -66,6 +66,9 @@ type RouterDetail struct {
 	// listener addresses
 	ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
+	// maintenance
+	Maintenance bool `json:"maintenance,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
@@ -74,8 +77,17 @@ type RouterDetail struct {
 	// Required: true
 	NoTraversal *bool `json:"noTraversal"`
 
+	// provider
+	Provider string `json:"provider,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
 	// version info
 	VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
+
+	// zone
+	Zone string `json:"zone,omitempty"`
 }
 
 // UnmarshalJSON unmarshals this object from a JSON structure
@@ -99,11 +111,19 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 
 		ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
+		Maintenance bool `json:"maintenance,omitempty"`
+
 		Name *string `json:"name"`
 
 		NoTraversal *bool `json:"noTraversal"`
 
+		Provider string `json:"provider,omitempty"`
+
+		Region string `json:"region,omitempty"`
+
 		VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
+
+		Zone string `json:"zone,omitempty"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
@@ -119,12 +139,20 @@ func (m *RouterDetail) UnmarshalJSON(raw []byte) error {
 
 	m.ListenerAddresses = dataAO1.ListenerAddresses
 
+	m.Maintenance = dataAO1.Maintenance
+
 	m.Name = dataAO1.Name
 
 	m.NoTraversal = dataAO1.NoTraversal
 
+	m.Provider = dataAO1.Provider
+
+	m.Region = dataAO1.Region
+
 	m.VersionInfo = dataAO1.VersionInfo
 
+	m.Zone = dataAO1.Zone
+
 	return nil
 }
 
@@ -148,11 +176,19 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 
 		ListenerAddresses []*RouterListener `json:"listenerAddresses"`
 
+		Maintenance bool `json:"maintenance,omitempty"`
+
 		Name *string `json:"name"`
 
 		NoTraversal *bool `json:"noTraversal"`
 
+		Provider string `json:"provider,omitempty"`
+
+		Region string `json:"region,omitempty"`
+
 		VersionInfo *VersionInfo `json:"versionInfo,omitempty"`
+
+		Zone string `json:"zone,omitempty"`
 	}
 
 	dataAO1.Connected = m.Connected
@@ -165,12 +201,20 @@ func (m RouterDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.ListenerAddresses = m.ListenerAddresses
 
+	dataAO1.Maintenance = m.Maintenance
+
 	dataAO1.Name = m.Name
 
 	dataAO1.NoTraversal = m.NoTraversal
 
+	dataAO1.Provider = m.Provider
+
+	dataAO1.Region = m.Region
+
 	dataAO1.VersionInfo = m.VersionInfo
 
+	dataAO1.Zone = m.Zone
+
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
 	if errAO1 != nil {
 		return nil, errAO1
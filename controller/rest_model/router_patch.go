@@ -60,8 +60,17 @@ type RouterPatch struct {
 	// no traversal
 	NoTraversal *bool `json:"noTraversal,omitempty"`
 
+	// provider
+	Provider string `json:"provider,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
+
+	// zone
+	Zone string `json:"zone,omitempty"`
 }
 
 // Validate validates this router patch
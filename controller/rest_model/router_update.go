@@ -64,8 +64,17 @@ type RouterUpdate struct {
 	// Required: true
 	NoTraversal *bool `json:"noTraversal"`
 
+	// provider
+	Provider string `json:"provider,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
+
+	// zone
+	Zone string `json:"zone,omitempty"`
 }
 
 // Validate validates this router update
@@ -43,10 +43,22 @@ import (
 // swagger:model serviceCreate
 type ServiceCreate struct {
 
+	// multipath mode
+	MultipathMode MultipathMode `json:"multipathMode,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// qos class
+	QosClass QosClass `json:"qosClass,omitempty"`
+
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// routing policy
+	RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
@@ -58,10 +70,26 @@ type ServiceCreate struct {
 func (m *ServiceCreate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateMultipathMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateQosClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoutingPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +100,23 @@ func (m *ServiceCreate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceCreate) validateMultipathMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceCreate) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -81,6 +126,61 @@ func (m *ServiceCreate) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceCreate) validateQosClass(formats strfmt.Registry) error {
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) validateRateLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) validateRoutingPolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoutingPolicy) { // not required
+		return nil
+	}
+
+	if m.RoutingPolicy != nil {
+		if err := m.RoutingPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceCreate) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -104,6 +204,22 @@ func (m *ServiceCreate) validateTags(formats strfmt.Registry) error {
 func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateMultipathMode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQosClass(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoutingPolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -114,6 +230,84 @@ func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *ServiceCreate) contextValidateMultipathMode(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) contextValidateQosClass(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) contextValidateRoutingPolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoutingPolicy != nil {
+
+		if swag.IsZero(m.RoutingPolicy) { // not required
+			return nil
+		}
+
+		if err := m.RoutingPolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceCreate) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
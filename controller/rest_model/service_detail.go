@@ -44,10 +44,22 @@ import (
 type ServiceDetail struct {
 	BaseEntity
 
+	// multipath mode
+	MultipathMode MultipathMode `json:"multipathMode,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// qos class
+	QosClass QosClass `json:"qosClass,omitempty"`
+
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// routing policy
+	RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+
 	// terminator strategy
 	// Required: true
 	TerminatorStrategy *string `json:"terminatorStrategy"`
@@ -64,16 +76,32 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		MultipathMode MultipathMode `json:"multipathMode,omitempty"`
+
 		Name *string `json:"name"`
 
+		QosClass QosClass `json:"qosClass,omitempty"`
+
+		RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+		RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
 	}
 
+	m.MultipathMode = dataAO1.MultipathMode
+
 	m.Name = dataAO1.Name
 
+	m.QosClass = dataAO1.QosClass
+
+	m.RateLimit = dataAO1.RateLimit
+
+	m.RoutingPolicy = dataAO1.RoutingPolicy
+
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
 
 	return nil
@@ -89,13 +117,29 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		MultipathMode MultipathMode `json:"multipathMode,omitempty"`
+
 		Name *string `json:"name"`
 
+		QosClass QosClass `json:"qosClass,omitempty"`
+
+		RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+		RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 
+	dataAO1.MultipathMode = m.MultipathMode
+
 	dataAO1.Name = m.Name
 
+	dataAO1.QosClass = m.QosClass
+
+	dataAO1.RateLimit = m.RateLimit
+
+	dataAO1.RoutingPolicy = m.RoutingPolicy
+
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
 
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
@@ -115,10 +159,26 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultipathMode(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateQosClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoutingPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTerminatorStrategy(formats); err != nil {
 		res = append(res, err)
 	}
@@ -129,6 +189,24 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceDetail) validateMultipathMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceDetail) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -138,6 +216,64 @@ func (m *ServiceDetail) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceDetail) validateQosClass(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateRateLimit(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateRoutingPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RoutingPolicy) { // not required
+		return nil
+	}
+
+	if m.RoutingPolicy != nil {
+		if err := m.RoutingPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceDetail) validateTerminatorStrategy(formats strfmt.Registry) error {
 
 	if err := validate.Required("terminatorStrategy", "body", m.TerminatorStrategy); err != nil {
@@ -156,12 +292,106 @@ func (m *ServiceDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMultipathMode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQosClass(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoutingPolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ServiceDetail) contextValidateMultipathMode(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateQosClass(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateRoutingPolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoutingPolicy != nil {
+
+		if swag.IsZero(m.RoutingPolicy) { // not required
+			return nil
+		}
+
+		if err := m.RoutingPolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -42,9 +42,21 @@ import (
 // swagger:model servicePatch
 type ServicePatch struct {
 
+	// multipath mode
+	MultipathMode MultipathMode `json:"multipathMode,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
+	// qos class
+	QosClass QosClass `json:"qosClass,omitempty"`
+
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// routing policy
+	RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
@@ -56,6 +68,22 @@ type ServicePatch struct {
 func (m *ServicePatch) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateMultipathMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQosClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoutingPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -66,6 +94,78 @@ func (m *ServicePatch) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePatch) validateMultipathMode(formats strfmt.Registry) error {
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) validateQosClass(formats strfmt.Registry) error {
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) validateRateLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) validateRoutingPolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.RoutingPolicy) { // not required
+		return nil
+	}
+
+	if m.RoutingPolicy != nil {
+		if err := m.RoutingPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServicePatch) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -89,6 +189,22 @@ func (m *ServicePatch) validateTags(formats strfmt.Registry) error {
 func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateMultipathMode(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQosClass(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoutingPolicy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -99,6 +215,84 @@ func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *ServicePatch) contextValidateMultipathMode(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultipathMode) { // not required
+		return nil
+	}
+
+	if err := m.MultipathMode.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multipathMode")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multipathMode")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) contextValidateQosClass(ctx context.Context, formats strfmt.Registry) error {
+
+	if swag.IsZero(m.QosClass) { // not required
+		return nil
+	}
+
+	if err := m.QosClass.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("qosClass")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("qosClass")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) contextValidateRoutingPolicy(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RoutingPolicy != nil {
+
+		if swag.IsZero(m.RoutingPolicy) { // not required
+			return nil
+		}
+
+		if err := m.RoutingPolicy.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("routingPolicy")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("routingPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServicePatch) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
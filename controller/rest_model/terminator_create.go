@@ -54,6 +54,9 @@ type TerminatorCreate struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -67,6 +70,9 @@ type TerminatorCreate struct {
 	// precedence
 	Precedence TerminatorPrecedence `json:"precedence,omitempty"`
 
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 	// router
 	// Required: true
 	Router *string `json:"router"`
@@ -95,10 +101,18 @@ func (m *TerminatorCreate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRouter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -154,6 +168,25 @@ func (m *TerminatorCreate) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorCreate) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -171,6 +204,25 @@ func (m *TerminatorCreate) validatePrecedence(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorCreate) validateRateLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) validateRouter(formats strfmt.Registry) error {
 
 	if err := validate.Required("router", "body", m.Router); err != nil {
@@ -216,10 +268,18 @@ func (m *TerminatorCreate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -246,6 +306,27 @@ func (m *TerminatorCreate) contextValidateCost(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorCreate) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+
+		if swag.IsZero(m.HealthCheck) { // not required
+			return nil
+		}
+
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
@@ -260,6 +341,27 @@ func (m *TerminatorCreate) contextValidatePrecedence(ctx context.Context, format
 	return nil
 }
 
+func (m *TerminatorCreate) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorCreate) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
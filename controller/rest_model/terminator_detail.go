@@ -56,10 +56,23 @@ type TerminatorDetail struct {
 	// Required: true
 	Cost *TerminatorCost `json:"cost"`
 
+	// when circuits still using a draining terminator will be closed, if set
+	// Format: date-time
+	DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+	// draining terminators don't receive new circuits
+	Draining bool `json:"draining,omitempty"`
+
 	// dynamic cost
 	// Required: true
 	DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+	// set while the terminator is failing its health check. While set, the terminator's precedence is reported as failed. The configured precedence applies again once the health check passes
+	HealthFailed bool `json:"healthFailed,omitempty"`
+
 	// host Id
 	// Required: true
 	HostID *string `json:"hostId"`
@@ -72,6 +85,9 @@ type TerminatorDetail struct {
 	// Required: true
 	Precedence *TerminatorPrecedence `json:"precedence"`
 
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 	// router
 	// Required: true
 	Router *EntityRef `json:"router"`
@@ -106,14 +122,24 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+		Draining bool `json:"draining,omitempty"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+		HealthFailed bool `json:"healthFailed,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
 
 		Precedence *TerminatorPrecedence `json:"precedence"`
 
+		RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 		Router *EntityRef `json:"router"`
 
 		RouterID *string `json:"routerId"`
@@ -132,14 +158,24 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 	m.Cost = dataAO1.Cost
 
+	m.DrainDeadline = dataAO1.DrainDeadline
+
+	m.Draining = dataAO1.Draining
+
 	m.DynamicCost = dataAO1.DynamicCost
 
+	m.HealthCheck = dataAO1.HealthCheck
+
+	m.HealthFailed = dataAO1.HealthFailed
+
 	m.HostID = dataAO1.HostID
 
 	m.InstanceID = dataAO1.InstanceID
 
 	m.Precedence = dataAO1.Precedence
 
+	m.RateLimit = dataAO1.RateLimit
+
 	m.Router = dataAO1.Router
 
 	m.RouterID = dataAO1.RouterID
@@ -167,14 +203,24 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
+		Draining bool `json:"draining,omitempty"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
+		HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
+		HealthFailed bool `json:"healthFailed,omitempty"`
+
 		HostID *string `json:"hostId"`
 
 		InstanceID *string `json:"instanceId"`
 
 		Precedence *TerminatorPrecedence `json:"precedence"`
 
+		RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 		Router *EntityRef `json:"router"`
 
 		RouterID *string `json:"routerId"`
@@ -190,14 +236,24 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Cost = m.Cost
 
+	dataAO1.DrainDeadline = m.DrainDeadline
+
+	dataAO1.Draining = m.Draining
+
 	dataAO1.DynamicCost = m.DynamicCost
 
+	dataAO1.HealthCheck = m.HealthCheck
+
+	dataAO1.HealthFailed = m.HealthFailed
+
 	dataAO1.HostID = m.HostID
 
 	dataAO1.InstanceID = m.InstanceID
 
 	dataAO1.Precedence = m.Precedence
 
+	dataAO1.RateLimit = m.RateLimit
+
 	dataAO1.Router = m.Router
 
 	dataAO1.RouterID = m.RouterID
@@ -235,10 +291,18 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainDeadline(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDynamicCost(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHostID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -251,6 +315,10 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRouter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -315,6 +383,19 @@ func (m *TerminatorDetail) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateDrainDeadline(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DrainDeadline) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("drainDeadline", "body", "date-time", m.DrainDeadline.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 
 	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
@@ -339,6 +420,26 @@ func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateHealthCheck(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateHostID(formats strfmt.Registry) error {
 
 	if err := validate.Required("hostId", "body", m.HostID); err != nil {
@@ -381,6 +482,26 @@ func (m *TerminatorDetail) validatePrecedence(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateRateLimit(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateRouter(formats strfmt.Registry) error {
 
 	if err := validate.Required("router", "body", m.Router); err != nil {
@@ -456,10 +577,18 @@ func (m *TerminatorDetail) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRouter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -506,6 +635,27 @@ func (m *TerminatorDetail) contextValidateDynamicCost(ctx context.Context, forma
 	return nil
 }
 
+func (m *TerminatorDetail) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+
+		if swag.IsZero(m.HealthCheck) { // not required
+			return nil
+		}
+
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Precedence != nil {
@@ -522,6 +672,27 @@ func (m *TerminatorDetail) contextValidatePrecedence(ctx context.Context, format
 	return nil
 }
 
+func (m *TerminatorDetail) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) contextValidateRouter(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Router != nil {
@@ -51,12 +51,18 @@ type TerminatorPatch struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
 	// precedence
 	Precedence TerminatorPrecedence `json:"precedence,omitempty"`
 
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 	// router
 	Router string `json:"router,omitempty"`
 
@@ -75,10 +81,18 @@ func (m *TerminatorPatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +122,25 @@ func (m *TerminatorPatch) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorPatch) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -125,6 +158,25 @@ func (m *TerminatorPatch) validatePrecedence(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorPatch) validateRateLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -152,10 +204,18 @@ func (m *TerminatorPatch) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +242,27 @@ func (m *TerminatorPatch) contextValidateCost(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *TerminatorPatch) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+
+		if swag.IsZero(m.HealthCheck) { // not required
+			return nil
+		}
+
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
@@ -196,6 +277,27 @@ func (m *TerminatorPatch) contextValidatePrecedence(ctx context.Context, formats
 	return nil
 }
 
+func (m *TerminatorPatch) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
@@ -54,12 +54,18 @@ type TerminatorUpdate struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// health check
+	HealthCheck *TerminatorHealthCheck `json:"healthCheck,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
 	// precedence
 	Precedence TerminatorPrecedence `json:"precedence,omitempty"`
 
+	// rate limit
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
 	// router
 	// Required: true
 	Router *string `json:"router"`
@@ -88,10 +94,18 @@ func (m *TerminatorUpdate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHealthCheck(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRouter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -147,6 +161,25 @@ func (m *TerminatorUpdate) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorUpdate) validateHealthCheck(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthCheck) { // not required
+		return nil
+	}
+
+	if m.HealthCheck != nil {
+		if err := m.HealthCheck.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -164,6 +197,25 @@ func (m *TerminatorUpdate) validatePrecedence(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorUpdate) validateRateLimit(formats strfmt.Registry) error {
+	if swag.IsZero(m.RateLimit) { // not required
+		return nil
+	}
+
+	if m.RateLimit != nil {
+		if err := m.RateLimit.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) validateRouter(formats strfmt.Registry) error {
 
 	if err := validate.Required("router", "body", m.Router); err != nil {
@@ -209,10 +261,18 @@ func (m *TerminatorUpdate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateHealthCheck(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePrecedence(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRateLimit(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -239,6 +299,27 @@ func (m *TerminatorUpdate) contextValidateCost(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorUpdate) contextValidateHealthCheck(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthCheck != nil {
+
+		if swag.IsZero(m.HealthCheck) { // not required
+			return nil
+		}
+
+		if err := m.HealthCheck.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("healthCheck")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("healthCheck")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) contextValidatePrecedence(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Precedence.ContextValidate(ctx, formats); err != nil {
@@ -253,6 +334,27 @@ func (m *TerminatorUpdate) contextValidatePrecedence(ctx context.Context, format
 	return nil
 }
 
+func (m *TerminatorUpdate) contextValidateRateLimit(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.RateLimit != nil {
+
+		if swag.IsZero(m.RateLimit) { // not required
+			return nil
+		}
+
+		if err := m.RateLimit.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rateLimit")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rateLimit")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
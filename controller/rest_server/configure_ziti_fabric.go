@@ -38,6 +38,7 @@ import (
 	"github.com/openziti/fabric/controller/rest_server/operations/circuit"
 	"github.com/openziti/fabric/controller/rest_server/operations/database"
 	"github.com/openziti/fabric/controller/rest_server/operations/inspect"
+	"github.com/openziti/fabric/controller/rest_server/operations/journal"
 	"github.com/openziti/fabric/controller/rest_server/operations/link"
 	"github.com/openziti/fabric/controller/rest_server/operations/raft"
 	"github.com/openziti/fabric/controller/rest_server/operations/router"
@@ -69,6 +70,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	if api.TerminatorCancelTerminatorDrainHandler == nil {
+		api.TerminatorCancelTerminatorDrainHandler = terminator.CancelTerminatorDrainHandlerFunc(func(params terminator.CancelTerminatorDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.CancelTerminatorDrain has not yet been implemented")
+		})
+	}
 	if api.DatabaseCheckDataIntegrityHandler == nil {
 		api.DatabaseCheckDataIntegrityHandler = database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
@@ -84,6 +90,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshotWithPath has not yet been implemented")
 		})
 	}
+	if api.LinkCreateLinkCostTagHandler == nil {
+		api.LinkCreateLinkCostTagHandler = link.CreateLinkCostTagHandlerFunc(func(params link.CreateLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.CreateLinkCostTag has not yet been implemented")
+		})
+	}
 	if api.RouterCreateRouterHandler == nil {
 		api.RouterCreateRouterHandler = router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
@@ -114,6 +125,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		})
 	}
+	if api.LinkDeleteLinkCostTagHandler == nil {
+		api.LinkDeleteLinkCostTagHandler = link.DeleteLinkCostTagHandlerFunc(func(params link.DeleteLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.DeleteLinkCostTag has not yet been implemented")
+		})
+	}
 	if api.RouterDeleteRouterHandler == nil {
 		api.RouterDeleteRouterHandler = router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
@@ -139,6 +155,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		})
 	}
+	if api.LinkDetailLinkCostTagHandler == nil {
+		api.LinkDetailLinkCostTagHandler = link.DetailLinkCostTagHandlerFunc(func(params link.DetailLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.DetailLinkCostTag has not yet been implemented")
+		})
+	}
 	if api.RouterDetailRouterHandler == nil {
 		api.RouterDetailRouterHandler = router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
@@ -154,6 +175,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		})
 	}
+	if api.TerminatorDrainTerminatorHandler == nil {
+		api.TerminatorDrainTerminatorHandler = terminator.DrainTerminatorHandlerFunc(func(params terminator.DrainTerminatorParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.DrainTerminator has not yet been implemented")
+		})
+	}
+	if api.RouterEndRouterMaintenanceHandler == nil {
+		api.RouterEndRouterMaintenanceHandler = router.EndRouterMaintenanceHandlerFunc(func(params router.EndRouterMaintenanceParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.EndRouterMaintenance has not yet been implemented")
+		})
+	}
 	if api.DatabaseFixDataIntegrityHandler == nil {
 		api.DatabaseFixDataIntegrityHandler = database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
@@ -169,6 +200,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		})
 	}
+	if api.JournalListJournalEventsHandler == nil {
+		api.JournalListJournalEventsHandler = journal.ListJournalEventsHandlerFunc(func(params journal.ListJournalEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation journal.ListJournalEvents has not yet been implemented")
+		})
+	}
+	if api.LinkListLinkCostTagsHandler == nil {
+		api.LinkListLinkCostTagsHandler = link.ListLinkCostTagsHandlerFunc(func(params link.ListLinkCostTagsParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.ListLinkCostTags has not yet been implemented")
+		})
+	}
 	if api.LinkListLinksHandler == nil {
 		api.LinkListLinksHandler = link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
@@ -204,6 +245,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		})
 	}
+	if api.LinkPatchLinkCostTagHandler == nil {
+		api.LinkPatchLinkCostTagHandler = link.PatchLinkCostTagHandlerFunc(func(params link.PatchLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.PatchLinkCostTag has not yet been implemented")
+		})
+	}
 	if api.RouterPatchRouterHandler == nil {
 		api.RouterPatchRouterHandler = router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
@@ -224,6 +270,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation raft.RaftListMembers has not yet been implemented")
 		})
 	}
+	if api.RouterStartRouterMaintenanceHandler == nil {
+		api.RouterStartRouterMaintenanceHandler = router.StartRouterMaintenanceHandlerFunc(func(params router.StartRouterMaintenanceParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.StartRouterMaintenance has not yet been implemented")
+		})
+	}
+	if api.LinkUpdateLinkCostTagHandler == nil {
+		api.LinkUpdateLinkCostTagHandler = link.UpdateLinkCostTagHandlerFunc(func(params link.UpdateLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.UpdateLinkCostTag has not yet been implemented")
+		})
+	}
 	if api.RouterUpdateRouterHandler == nil {
 		api.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
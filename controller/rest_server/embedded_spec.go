@@ -278,6 +278,49 @@ func init() {
         }
       }
     },
+    "/events/journal": {
+      "get": {
+        "description": "Returns events recorded in the event journal, starting at the given sequence number. To continue reading, pass\nthe returned nextSequence as the fromSequence of the next request. If the requested events have already been\nremoved by retention, events are returned starting with the oldest retained event. Returns not found if the\ncontroller isn't configured with an event journal. Requires admin access.\n",
+        "tags": [
+          "Journal"
+        ],
+        "summary": "Read events from the event journal",
+        "operationId": "listJournalEvents",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The sequence number to start reading from",
+            "name": "fromSequence",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "If set, only events of the given types, such as circuit or usage.v3, are returned",
+            "name": "eventTypes",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listJournalEvents"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      }
+    },
     "/inspections": {
       "post": {
         "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
@@ -307,6 +350,176 @@ func init() {
         }
       }
     },
+    "/link-cost-tags": {
+      "get": {
+        "description": "Retrieves a list of link cost tag resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "tags": [
+          "Link"
+        ],
+        "summary": "List link cost tags",
+        "operationId": "listLinkCostTags",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listLinkCostTags"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "Create a link cost tag resource. Links dialed on listeners with the named cost tag have their cost adjusted\naccordingly. Requires admin access.\n",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Create a link cost tag resource",
+        "operationId": "createLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag to create",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/link-cost-tags/{id}": {
+      "get": {
+        "description": "Retrieves a single link cost tag by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Retrieves a single link cost tag",
+        "operationId": "detailLinkCostTag",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailLinkCostTag"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a link cost tag by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update all fields on a link cost tag",
+        "operationId": "updateLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag update object",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/updateResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link cost tag by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Delete a link cost tag",
+        "operationId": "deleteLinkCostTag",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link cost tag. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link cost tag",
+        "operationId": "patchLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag patch object",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/patchResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/links": {
       "get": {
         "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
@@ -594,6 +807,51 @@ func init() {
         }
       ]
     },
+    "/routers/{id}/maintenance": {
+      "post": {
+        "description": "Stops the router being used as transit for new paths and reroutes circuits which transit it. Router events\nreport the number of circuits still transiting the router until it reaches zero, at which point the router is\nsafe to stop. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Start router maintenance",
+        "operationId": "startRouterMaintenance",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "Returns a router in maintenance to service, so it can be used as transit again. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "End router maintenance",
+        "operationId": "endRouterMaintenance",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
     "/routers/{id}/terminators": {
       "get": {
         "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
@@ -1016,6 +1274,64 @@ func init() {
           "$ref": "#/parameters/id"
         }
       ]
+    },
+    "/terminators/{id}/drain": {
+      "post": {
+        "description": "Stops new circuits from using a terminator, leaving existing circuits to finish. Terminator events report the\nnumber of circuits still using the terminator until it reaches zero. If forceCloseAfter is given, circuits\nstill using the terminator after that many seconds are closed. Requires admin access.\n",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Drain a terminator",
+        "operationId": "drainTerminator",
+        "parameters": [
+          {
+            "description": "drain options",
+            "name": "drain",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/terminatorDrain"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "Returns a draining terminator to service, so it can receive new circuits again. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Cancel a terminator drain",
+        "operationId": "cancelTerminatorDrain",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
     }
   },
   "definitions": {
@@ -1329,7 +1645,7 @@ func init() {
         }
       }
     },
-    "detailLinkEnvelope": {
+    "detailLinkCostTagEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -1337,7 +1653,22 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/linkDetail"
+          "$ref": "#/definitions/linkCostTagDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailLinkEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkDetail"
         },
         "meta": {
           "$ref": "#/definitions/meta"
@@ -1488,6 +1819,60 @@ func init() {
         }
       }
     },
+    "journalEvent": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "timestamp",
+        "eventType",
+        "event"
+      ],
+      "properties": {
+        "event": {
+          "type": "object"
+        },
+        "eventType": {
+          "type": "string"
+        },
+        "sequence": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "journalEventList": {
+      "type": "object",
+      "required": [
+        "journalId",
+        "firstSequence",
+        "nextSequence",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/journalEvent"
+          }
+        },
+        "firstSequence": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "journalId": {
+          "description": "Identifies the journal. Sequences are only comparable between reads which return the same journal id",
+          "type": "string"
+        },
+        "nextSequence": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "link": {
       "description": "A link to another resource",
       "type": "object",
@@ -1507,6 +1892,104 @@ func init() {
         }
       }
     },
+    "linkCostTagCreate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkCostTagDetail": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
+        },
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "additiveCost",
+            "costMultiplier"
+          ],
+          "properties": {
+            "additiveCost": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "costMultiplier": {
+              "type": "number",
+              "format": "double"
+            },
+            "name": {
+              "type": "string"
+            }
+          }
+        }
+      ]
+    },
+    "linkCostTagList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkCostTagDetail"
+      }
+    },
+    "linkCostTagPatch": {
+      "type": "object",
+      "properties": {
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
+    "linkCostTagUpdate": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
     "linkDetail": {
       "type": "object",
       "required": [
@@ -1525,6 +2008,12 @@ func init() {
         "cost": {
           "type": "integer"
         },
+        "costTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "destLatency": {
           "type": "integer"
         },
@@ -1540,6 +2029,9 @@ func init() {
         "protocol": {
           "type": "string"
         },
+        "qualityCost": {
+          "type": "integer"
+        },
         "sourceLatency": {
           "type": "integer"
         },
@@ -1594,6 +2086,36 @@ func init() {
         }
       }
     },
+    "listJournalEventsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/journalEventList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listLinkCostTagsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkCostTagList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listLinksEnvelope": {
       "type": "object",
       "required": [
@@ -1675,6 +2197,14 @@ func init() {
         }
       }
     },
+    "multipathMode": {
+      "type": "string",
+      "enum": [
+        "none",
+        "stripe",
+        "duplicate"
+      ]
+    },
     "pagination": {
       "type": "object",
       "required": [
@@ -1697,6 +2227,14 @@ func init() {
         }
       }
     },
+    "qosClass": {
+      "type": "string",
+      "enum": [
+        "interactive",
+        "default",
+        "bulk"
+      ]
+    },
     "raftMemberListRequest": {
       "type": "object"
     },
@@ -1742,6 +2280,23 @@ func init() {
         }
       }
     },
+    "rateLimit": {
+      "type": "object",
+      "required": [
+        "bytesPerSecond"
+      ],
+      "properties": {
+        "burstBytes": {
+          "description": "maximum number of bytes which may be sent in a burst. Defaults to one second of throughput",
+          "type": "integer"
+        },
+        "bytesPerSecond": {
+          "description": "maximum throughput, in bytes per second, for each direction of a circuit",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
     "routerCreate": {
       "type": "object",
       "required": [
@@ -1772,8 +2327,17 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
         }
       }
     },
@@ -1813,14 +2377,26 @@ func init() {
                 "$ref": "#/definitions/routerListener"
               }
             },
+            "maintenance": {
+              "type": "boolean"
+            },
             "name": {
               "type": "string"
             },
             "noTraversal": {
               "type": "boolean"
             },
+            "provider": {
+              "type": "string"
+            },
+            "region": {
+              "type": "string"
+            },
             "versionInfo": {
               "$ref": "#/definitions/versionInfo"
+            },
+            "zone": {
+              "type": "string"
             }
           }
         }
@@ -1870,8 +2446,17 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
         }
       }
     },
@@ -1901,8 +2486,37 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
+        }
+      }
+    },
+    "routingPolicy": {
+      "description": "restricts which routers circuits for a service may traverse, including the ingress and egress routers",
+      "type": "object",
+      "properties": {
+        "allowedRouters": {
+          "description": "router ids or router selectors. If set, every router on a circuit path must match at least one entry",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "deniedRouters": {
+          "description": "router ids or router selectors. Routers matching any entry are never used by circuits for the service",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -1912,9 +2526,21 @@ func init() {
         "name"
       ],
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -1936,9 +2562,21 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "multipathMode": {
+              "$ref": "#/definitions/multipathMode"
+            },
             "name": {
               "type": "string"
             },
+            "qosClass": {
+              "$ref": "#/definitions/qosClass"
+            },
+            "rateLimit": {
+              "$ref": "#/definitions/rateLimit"
+            },
+            "routingPolicy": {
+              "$ref": "#/definitions/routingPolicy"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -1955,9 +2593,21 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -1972,9 +2622,21 @@ func init() {
         "name"
       ],
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -2026,6 +2688,9 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -2039,6 +2704,9 @@ func init() {
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -2081,9 +2749,25 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "description": "when circuits still using a draining terminator will be closed, if set",
+              "type": "string",
+              "format": "date-time"
+            },
+            "draining": {
+              "description": "draining terminators don't receive new circuits",
+              "type": "boolean"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "healthCheck": {
+              "$ref": "#/definitions/terminatorHealthCheck"
+            },
+            "healthFailed": {
+              "description": "set while the terminator is failing its health check. While set, the terminator's precedence is reported as failed. The configured precedence applies again once the health check passes",
+              "type": "boolean"
+            },
             "hostId": {
               "type": "string"
             },
@@ -2093,6 +2777,9 @@ func init() {
             "precedence": {
               "$ref": "#/definitions/terminatorPrecedence"
             },
+            "rateLimit": {
+              "$ref": "#/definitions/rateLimit"
+            },
             "router": {
               "$ref": "#/definitions/entityRef"
             },
@@ -2109,30 +2796,86 @@ func init() {
         }
       ]
     },
-    "terminatorList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/terminatorDetail"
+    "terminatorDrain": {
+      "type": "object",
+      "properties": {
+        "forceCloseAfter": {
+          "description": "seconds after which circuits still using the terminator are closed. Zero leaves circuits to finish on their own",
+          "type": "integer"
+        }
       }
     },
-    "terminatorPatch": {
+    "terminatorHealthCheck": {
       "type": "object",
+      "required": [
+        "type"
+      ],
       "properties": {
         "address": {
+          "description": "the address to check, if different to the terminator address. Required for http checks",
           "type": "string"
         },
-        "binding": {
-          "type": "string"
+        "failureThreshold": {
+          "description": "consecutive failures before the terminator is marked failed",
+          "type": "integer",
+          "minimum": 1
         },
-        "cost": {
+        "interval": {
+          "description": "seconds between checks",
+          "type": "integer",
+          "minimum": 1
+        },
+        "successThreshold": {
+          "description": "consecutive successes before a failed terminator is restored",
+          "type": "integer",
+          "minimum": 1
+        },
+        "timeout": {
+          "description": "seconds to wait for a check to complete",
+          "type": "integer",
+          "minimum": 1
+        },
+        "type": {
+          "description": "checks are run by the router hosting the terminator. router checks are delegated to the terminator's binding",
+          "type": "string",
+          "enum": [
+            "tcp",
+            "http",
+            "router"
+          ]
+        }
+      }
+    },
+    "terminatorList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/terminatorDetail"
+      }
+    },
+    "terminatorPatch": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -2176,12 +2919,18 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -2338,6 +3087,12 @@ func init() {
         "$ref": "#/definitions/detailLinkEnvelope"
       }
     },
+    "detailLinkCostTag": {
+      "description": "A single link cost tag",
+      "schema": {
+        "$ref": "#/definitions/detailLinkCostTagEnvelope"
+      }
+    },
     "detailRouter": {
       "description": "A single router",
       "schema": {
@@ -2398,6 +3153,18 @@ func init() {
         "$ref": "#/definitions/listCircuitsEnvelope"
       }
     },
+    "listJournalEvents": {
+      "description": "A page of events read from the event journal",
+      "schema": {
+        "$ref": "#/definitions/listJournalEventsEnvelope"
+      }
+    },
+    "listLinkCostTags": {
+      "description": "A list of link cost tags",
+      "schema": {
+        "$ref": "#/definitions/listLinkCostTagsEnvelope"
+      }
+    },
     "listLinks": {
       "description": "A list of links",
       "schema": {
@@ -3107,30 +3874,43 @@ func init() {
         }
       }
     },
-    "/inspections": {
-      "post": {
-        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
+    "/events/journal": {
+      "get": {
+        "description": "Returns events recorded in the event journal, starting at the given sequence number. To continue reading, pass\nthe returned nextSequence as the fromSequence of the next request. If the requested events have already been\nremoved by retention, events are returned starting with the oldest retained event. Returns not found if the\ncontroller isn't configured with an event journal. Requires admin access.\n",
         "tags": [
-          "Inspect"
+          "Journal"
         ],
-        "summary": "Inspect system values",
-        "operationId": "inspect",
+        "summary": "Read events from the event journal",
+        "operationId": "listJournalEvents",
         "parameters": [
           {
-            "description": "An inspect request",
-            "name": "request",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/inspectRequest"
-            }
+            "type": "integer",
+            "format": "int64",
+            "description": "The sequence number to start reading from",
+            "name": "fromSequence",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "If set, only events of the given types, such as circuit or usage.v3, are returned",
+            "name": "eventTypes",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A response to an inspect request",
+            "description": "A page of events read from the event journal",
             "schema": {
-              "$ref": "#/definitions/inspectResponse"
+              "$ref": "#/definitions/listJournalEventsEnvelope"
             }
           },
           "401": {
@@ -3156,23 +3936,60 @@ func init() {
                 }
               }
             }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       }
     },
-    "/links": {
-      "get": {
-        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+    "/inspections": {
+      "post": {
+        "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
         "tags": [
-          "Link"
+          "Inspect"
+        ],
+        "summary": "Inspect system values",
+        "operationId": "inspect",
+        "parameters": [
+          {
+            "description": "An inspect request",
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/inspectRequest"
+            }
+          }
         ],
-        "summary": "List links",
-        "operationId": "listLinks",
         "responses": {
           "200": {
-            "description": "A list of links",
+            "description": "A response to an inspect request",
             "schema": {
-              "$ref": "#/definitions/listLinksEnvelope"
+              "$ref": "#/definitions/inspectResponse"
             }
           },
           "401": {
@@ -3202,19 +4019,36 @@ func init() {
         }
       }
     },
-    "/links/{id}": {
+    "/link-cost-tags": {
       "get": {
-        "description": "Retrieves a single link by id. Requires admin access.",
+        "description": "Retrieves a list of link cost tag resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
           "Link"
         ],
-        "summary": "Retrieves a single link",
-        "operationId": "detailLink",
+        "summary": "List link cost tags",
+        "operationId": "listLinkCostTags",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "A single link",
+            "description": "A list of link cost tags",
             "schema": {
-              "$ref": "#/definitions/detailLinkEnvelope"
+              "$ref": "#/definitions/listLinkCostTagsEnvelope"
             }
           },
           "401": {
@@ -3240,47 +4074,32 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
       },
-      "delete": {
-        "description": "Delete a link by id. Requires admin access.",
+      "post": {
+        "description": "Create a link cost tag resource. Links dialed on listeners with the named cost tag have their cost adjusted\naccordingly. Requires admin access.\n",
         "tags": [
           "Link"
         ],
-        "summary": "Delete a link",
-        "operationId": "deleteLink",
+        "summary": "Create a link cost tag resource",
+        "operationId": "createLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag to create",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagCreate"
+            }
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "The delete request was successful and the resource has been removed",
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/createEnvelope"
             }
           },
           "400": {
@@ -3345,67 +4164,21 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a link. Requires admin access.",
+      }
+    },
+    "/link-cost-tags/{id}": {
+      "get": {
+        "description": "Retrieves a single link cost tag by id. Requires admin access.",
         "tags": [
           "Link"
         ],
-        "summary": "Update the supplied fields on a link",
-        "operationId": "patchLink",
-        "parameters": [
-          {
-            "description": "A link patch object",
-            "name": "link",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/linkPatch"
-            }
-          }
-        ],
+        "summary": "Retrieves a single link cost tag",
+        "operationId": "detailLinkCostTag",
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
-          },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "description": "A single link cost tag",
             "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
+              "$ref": "#/definitions/detailLinkCostTagEnvelope"
             }
           },
           "401": {
@@ -3460,15 +4233,630 @@ func init() {
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      "put": {
+        "description": "Update all fields on a link cost tag by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update all fields on a link cost tag",
+        "operationId": "updateLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag update object",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link cost tag by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Delete a link cost tag",
+        "operationId": "deleteLinkCostTag",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link cost tag. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link cost tag",
+        "operationId": "patchLinkCostTag",
+        "parameters": [
+          {
+            "description": "A link cost tag patch object",
+            "name": "linkCostTag",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkCostTagPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/links": {
+      "get": {
+        "description": "Retrieves a list of link resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
+        "tags": [
+          "Link"
+        ],
+        "summary": "List links",
+        "operationId": "listLinks",
+        "responses": {
+          "200": {
+            "description": "A list of links",
+            "schema": {
+              "$ref": "#/definitions/listLinksEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/links/{id}": {
+      "get": {
+        "description": "Retrieves a single link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Retrieves a single link",
+        "operationId": "detailLink",
+        "responses": {
+          "200": {
+            "description": "A single link",
+            "schema": {
+              "$ref": "#/definitions/detailLinkEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Delete a link",
+        "operationId": "deleteLink",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link",
+        "operationId": "patchLink",
+        "parameters": [
+          {
+            "description": "A link patch object",
+            "name": "link",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
     "/raft/list-members": {
       "get": {
@@ -3967,8 +5355,146 @@ func init() {
               "$ref": "#/definitions/empty"
             }
           },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/routers/{id}/maintenance": {
+      "post": {
+        "description": "Stops the router being used as transit for new paths and reroutes circuits which transit it. Router events\nreport the number of circuits still transiting the router until it reaches zero, at which point the router is\nsafe to stop. Requires admin access.\n",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Start router maintenance",
+        "operationId": "startRouterMaintenance",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -3976,26 +5502,15 @@ func init() {
               "application/json": {
                 "error": {
                   "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -4003,6 +5518,22 @@ func init() {
                 }
               }
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Returns a router in maintenance to service, so it can be used as transit again. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "End router maintenance",
+        "operationId": "endRouterMaintenance",
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
           },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
@@ -5053,8 +6584,225 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The requested resource does not exist",
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update all fields on a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Update all fields on a terminator",
+        "operationId": "updateTerminator",
+        "parameters": [
+          {
+            "description": "A terminator update object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorUpdate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The update request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a terminator by id. Requires admin access.",
+        "tags": [
+          "Terminator"
+        ],
+        "summary": "Delete a terminator",
+        "operationId": "deleteTerminator",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "409": {
+            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -5066,10 +6814,9 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
+                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
+                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
+                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -5081,27 +6828,27 @@ func init() {
           }
         }
       },
-      "put": {
-        "description": "Update all fields on a terminator by id. Requires admin access.",
+      "patch": {
+        "description": "Update the supplied fields on a terminator. Requires admin access.",
         "tags": [
           "Terminator"
         ],
-        "summary": "Update all fields on a terminator",
-        "operationId": "updateTerminator",
+        "summary": "Update the supplied fields on a terminator",
+        "operationId": "patchTerminator",
         "parameters": [
           {
-            "description": "A terminator update object",
+            "description": "A terminator patch object",
             "name": "terminator",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/terminatorUpdate"
+              "$ref": "#/definitions/terminatorPatch"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "The update request was successful and the resource has been altered",
+            "description": "The patch request was successful and the resource has been altered",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -5195,16 +6942,37 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete a terminator by id. Requires admin access.",
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/terminators/{id}/drain": {
+      "post": {
+        "description": "Stops new circuits from using a terminator, leaving existing circuits to finish. Terminator events report the\nnumber of circuits still using the terminator until it reaches zero. If forceCloseAfter is given, circuits\nstill using the terminator after that many seconds are closed. Requires admin access.\n",
         "tags": [
           "Terminator"
         ],
-        "summary": "Delete a terminator",
-        "operationId": "deleteTerminator",
+        "summary": "Drain a terminator",
+        "operationId": "drainTerminator",
+        "parameters": [
+          {
+            "description": "drain options",
+            "name": "drain",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/terminatorDrain"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "Base empty response",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -5270,8 +7038,8 @@ func init() {
               }
             }
           },
-          "409": {
-            "description": "The resource requested to be removed/altered cannot be as it is referenced by another object.",
+          "404": {
+            "description": "The requested resource does not exist",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -5283,9 +7051,10 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "causeMessage": "referenced by /some-resource/05f4f710-c155-4a74-86d5-77558eb9cb42",
-                  "code": "CONFLICT_CANNOT_MODIFY_REFERENCED",
-                  "message": "The resource cannot be deleted/modified. Remove all referencing resources first.",
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
                   "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
                 },
                 "meta": {
@@ -5297,68 +7066,20 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Update the supplied fields on a terminator. Requires admin access.",
+      "delete": {
+        "description": "Returns a draining terminator to service, so it can receive new circuits again. Requires admin access.",
         "tags": [
           "Terminator"
         ],
-        "summary": "Update the supplied fields on a terminator",
-        "operationId": "patchTerminator",
-        "parameters": [
-          {
-            "description": "A terminator patch object",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorPatch"
-            }
-          }
-        ],
+        "summary": "Cancel a terminator drain",
+        "operationId": "cancelTerminatorDrain",
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "Base empty response",
             "schema": {
               "$ref": "#/definitions/empty"
             }
           },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
-          },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
             "schema": {
@@ -5750,6 +7471,21 @@ func init() {
         }
       }
     },
+    "detailLinkCostTagEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkCostTagDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailLinkEnvelope": {
       "type": "object",
       "required": [
@@ -5839,92 +7575,244 @@ func init() {
         "id": {
           "type": "string"
         },
-        "name": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "inspectRequest": {
+      "type": "object",
+      "required": [
+        "appRegex",
+        "requestedValues"
+      ],
+      "properties": {
+        "appRegex": {
+          "type": "string"
+        },
+        "requestedValues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "inspectResponse": {
+      "type": "object",
+      "required": [
+        "success"
+      ],
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "success": {
+          "type": "boolean"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/inspectResponseValue"
+          }
+        }
+      }
+    },
+    "inspectResponseValue": {
+      "type": "object",
+      "required": [
+        "appId",
+        "name",
+        "value"
+      ],
+      "properties": {
+        "appId": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "value": {
+          "type": [
+            "object",
+            "string",
+            "number",
+            "boolean"
+          ]
+        }
+      }
+    },
+    "journalEvent": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "timestamp",
+        "eventType",
+        "event"
+      ],
+      "properties": {
+        "event": {
+          "type": "object"
+        },
+        "eventType": {
+          "type": "string"
+        },
+        "sequence": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "journalEventList": {
+      "type": "object",
+      "required": [
+        "journalId",
+        "firstSequence",
+        "nextSequence",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/journalEvent"
+          }
+        },
+        "firstSequence": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "journalId": {
+          "description": "Identifies the journal. Sequences are only comparable between reads which return the same journal id",
+          "type": "string"
+        },
+        "nextSequence": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "link": {
+      "description": "A link to another resource",
+      "type": "object",
+      "required": [
+        "href"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "href": {
+          "type": "string",
+          "format": "uri"
+        },
+        "method": {
           "type": "string"
         }
       }
     },
-    "inspectRequest": {
+    "linkCostTagCreate": {
       "type": "object",
       "required": [
-        "appRegex",
-        "requestedValues"
+        "name"
       ],
       "properties": {
-        "appRegex": {
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
+        },
+        "name": {
           "type": "string"
         },
-        "requestedValues": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "inspectResponse": {
+    "linkCostTagDetail": {
       "type": "object",
-      "required": [
-        "success"
-      ],
-      "properties": {
-        "errors": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "success": {
-          "type": "boolean"
+      "allOf": [
+        {
+          "$ref": "#/definitions/baseEntity"
         },
-        "values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/inspectResponseValue"
+        {
+          "type": "object",
+          "required": [
+            "name",
+            "additiveCost",
+            "costMultiplier"
+          ],
+          "properties": {
+            "additiveCost": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "costMultiplier": {
+              "type": "number",
+              "format": "double"
+            },
+            "name": {
+              "type": "string"
+            }
           }
         }
+      ]
+    },
+    "linkCostTagList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/linkCostTagDetail"
       }
     },
-    "inspectResponseValue": {
+    "linkCostTagPatch": {
       "type": "object",
-      "required": [
-        "appId",
-        "name",
-        "value"
-      ],
       "properties": {
-        "appId": {
-          "type": "string"
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
         },
         "name": {
           "type": "string"
         },
-        "value": {
-          "type": [
-            "object",
-            "string",
-            "number",
-            "boolean"
-          ]
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
-    "link": {
-      "description": "A link to another resource",
+    "linkCostTagUpdate": {
       "type": "object",
       "required": [
-        "href"
+        "name"
       ],
       "properties": {
-        "comment": {
-          "type": "string"
+        "additiveCost": {
+          "type": "integer",
+          "format": "int64"
         },
-        "href": {
-          "type": "string",
-          "format": "uri"
+        "costMultiplier": {
+          "type": "number",
+          "format": "double"
         },
-        "method": {
+        "name": {
           "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
         }
       }
     },
@@ -5946,6 +7834,12 @@ func init() {
         "cost": {
           "type": "integer"
         },
+        "costTags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "destLatency": {
           "type": "integer"
         },
@@ -5961,6 +7855,9 @@ func init() {
         "protocol": {
           "type": "string"
         },
+        "qualityCost": {
+          "type": "integer"
+        },
         "sourceLatency": {
           "type": "integer"
         },
@@ -6015,6 +7912,36 @@ func init() {
         }
       }
     },
+    "listJournalEventsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/journalEventList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listLinkCostTagsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkCostTagList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listLinksEnvelope": {
       "type": "object",
       "required": [
@@ -6096,6 +8023,14 @@ func init() {
         }
       }
     },
+    "multipathMode": {
+      "type": "string",
+      "enum": [
+        "none",
+        "stripe",
+        "duplicate"
+      ]
+    },
     "pagination": {
       "type": "object",
       "required": [
@@ -6118,6 +8053,14 @@ func init() {
         }
       }
     },
+    "qosClass": {
+      "type": "string",
+      "enum": [
+        "interactive",
+        "default",
+        "bulk"
+      ]
+    },
     "raftMemberListRequest": {
       "type": "object"
     },
@@ -6163,6 +8106,24 @@ func init() {
         }
       }
     },
+    "rateLimit": {
+      "type": "object",
+      "required": [
+        "bytesPerSecond"
+      ],
+      "properties": {
+        "burstBytes": {
+          "description": "maximum number of bytes which may be sent in a burst. Defaults to one second of throughput",
+          "type": "integer",
+          "minimum": 0
+        },
+        "bytesPerSecond": {
+          "description": "maximum throughput, in bytes per second, for each direction of a circuit",
+          "type": "integer",
+          "minimum": 1
+        }
+      }
+    },
     "routerCreate": {
       "type": "object",
       "required": [
@@ -6194,8 +8155,17 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
         }
       }
     },
@@ -6236,14 +8206,26 @@ func init() {
                 "$ref": "#/definitions/routerListener"
               }
             },
+            "maintenance": {
+              "type": "boolean"
+            },
             "name": {
               "type": "string"
             },
             "noTraversal": {
               "type": "boolean"
             },
+            "provider": {
+              "type": "string"
+            },
+            "region": {
+              "type": "string"
+            },
             "versionInfo": {
               "$ref": "#/definitions/versionInfo"
+            },
+            "zone": {
+              "type": "string"
             }
           }
         }
@@ -6294,8 +8276,17 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
         }
       }
     },
@@ -6326,8 +8317,37 @@ func init() {
         "noTraversal": {
           "type": "boolean"
         },
+        "provider": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "zone": {
+          "type": "string"
+        }
+      }
+    },
+    "routingPolicy": {
+      "description": "restricts which routers circuits for a service may traverse, including the ingress and egress routers",
+      "type": "object",
+      "properties": {
+        "allowedRouters": {
+          "description": "router ids or router selectors. If set, every router on a circuit path must match at least one entry",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "deniedRouters": {
+          "description": "router ids or router selectors. Routers matching any entry are never used by circuits for the service",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -6337,9 +8357,21 @@ func init() {
         "name"
       ],
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -6361,9 +8393,21 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "multipathMode": {
+              "$ref": "#/definitions/multipathMode"
+            },
             "name": {
               "type": "string"
             },
+            "qosClass": {
+              "$ref": "#/definitions/qosClass"
+            },
+            "rateLimit": {
+              "$ref": "#/definitions/rateLimit"
+            },
+            "routingPolicy": {
+              "$ref": "#/definitions/routingPolicy"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -6380,9 +8424,21 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -6397,9 +8453,21 @@ func init() {
         "name"
       ],
       "properties": {
+        "multipathMode": {
+          "$ref": "#/definitions/multipathMode"
+        },
         "name": {
           "type": "string"
         },
+        "qosClass": {
+          "$ref": "#/definitions/qosClass"
+        },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
+        "routingPolicy": {
+          "$ref": "#/definitions/routingPolicy"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -6452,6 +8520,9 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
@@ -6465,6 +8536,9 @@ func init() {
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -6507,9 +8581,25 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "description": "when circuits still using a draining terminator will be closed, if set",
+              "type": "string",
+              "format": "date-time"
+            },
+            "draining": {
+              "description": "draining terminators don't receive new circuits",
+              "type": "boolean"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "healthCheck": {
+              "$ref": "#/definitions/terminatorHealthCheck"
+            },
+            "healthFailed": {
+              "description": "set while the terminator is failing its health check. While set, the terminator's precedence is reported as failed. The configured precedence applies again once the health check passes",
+              "type": "boolean"
+            },
             "hostId": {
               "type": "string"
             },
@@ -6519,6 +8609,9 @@ func init() {
             "precedence": {
               "$ref": "#/definitions/terminatorPrecedence"
             },
+            "rateLimit": {
+              "$ref": "#/definitions/rateLimit"
+            },
             "router": {
               "$ref": "#/definitions/entityRef"
             },
@@ -6535,6 +8628,57 @@ func init() {
         }
       ]
     },
+    "terminatorDrain": {
+      "type": "object",
+      "properties": {
+        "forceCloseAfter": {
+          "description": "seconds after which circuits still using the terminator are closed. Zero leaves circuits to finish on their own",
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
+    "terminatorHealthCheck": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "address": {
+          "description": "the address to check, if different to the terminator address. Required for http checks",
+          "type": "string"
+        },
+        "failureThreshold": {
+          "description": "consecutive failures before the terminator is marked failed",
+          "type": "integer",
+          "minimum": 1
+        },
+        "interval": {
+          "description": "seconds between checks",
+          "type": "integer",
+          "minimum": 1
+        },
+        "successThreshold": {
+          "description": "consecutive successes before a failed terminator is restored",
+          "type": "integer",
+          "minimum": 1
+        },
+        "timeout": {
+          "description": "seconds to wait for a check to complete",
+          "type": "integer",
+          "minimum": 1
+        },
+        "type": {
+          "description": "checks are run by the router hosting the terminator. router checks are delegated to the terminator's binding",
+          "type": "string",
+          "enum": [
+            "tcp",
+            "http",
+            "router"
+          ]
+        }
+      }
+    },
     "terminatorList": {
       "type": "array",
       "items": {
@@ -6553,12 +8697,18 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -6602,12 +8752,18 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "healthCheck": {
+          "$ref": "#/definitions/terminatorHealthCheck"
+        },
         "hostId": {
           "type": "string"
         },
         "precedence": {
           "$ref": "#/definitions/terminatorPrecedence"
         },
+        "rateLimit": {
+          "$ref": "#/definitions/rateLimit"
+        },
         "router": {
           "type": "string"
         },
@@ -6764,6 +8920,12 @@ func init() {
         "$ref": "#/definitions/detailLinkEnvelope"
       }
     },
+    "detailLinkCostTag": {
+      "description": "A single link cost tag",
+      "schema": {
+        "$ref": "#/definitions/detailLinkCostTagEnvelope"
+      }
+    },
     "detailRouter": {
       "description": "A single router",
       "schema": {
@@ -6824,6 +8986,18 @@ func init() {
         "$ref": "#/definitions/listCircuitsEnvelope"
       }
     },
+    "listJournalEvents": {
+      "description": "A page of events read from the event journal",
+      "schema": {
+        "$ref": "#/definitions/listJournalEventsEnvelope"
+      }
+    },
+    "listLinkCostTags": {
+      "description": "A list of link cost tags",
+      "schema": {
+        "$ref": "#/definitions/listLinkCostTagsEnvelope"
+      }
+    },
     "listLinks": {
       "description": "A list of links",
       "schema": {
@@ -0,0 +1,176 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package journal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListJournalEventsParams creates a new ListJournalEventsParams object
+//
+// There are no default values defined in the spec.
+func NewListJournalEventsParams() ListJournalEventsParams {
+
+	return ListJournalEventsParams{}
+}
+
+// ListJournalEventsParams contains all the bound params for the list journal events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listJournalEvents
+type ListJournalEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*If set, only events of the given types, such as circuit or usage.v3, are returned
+	  In: query
+	  Collection Format: csv
+	*/
+	EventTypes []string
+	/*The sequence number to start reading from
+	  In: query
+	*/
+	FromSequence *int64
+	/*
+	  In: query
+	*/
+	Limit *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListJournalEventsParams() beforehand.
+func (o *ListJournalEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qEventTypes, qhkEventTypes, _ := qs.GetOK("eventTypes")
+	if err := o.bindEventTypes(qEventTypes, qhkEventTypes, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFromSequence, qhkFromSequence, _ := qs.GetOK("fromSequence")
+	if err := o.bindFromSequence(qFromSequence, qhkFromSequence, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindEventTypes binds and validates array parameter EventTypes from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *ListJournalEventsParams) bindEventTypes(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvEventTypes string
+	if len(rawData) > 0 {
+		qvEventTypes = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	eventTypesIC := swag.SplitByFormat(qvEventTypes, "csv")
+	if len(eventTypesIC) == 0 {
+		return nil
+	}
+
+	var eventTypesIR []string
+	for _, eventTypesIV := range eventTypesIC {
+		eventTypesI := eventTypesIV
+
+		eventTypesIR = append(eventTypesIR, eventTypesI)
+	}
+
+	o.EventTypes = eventTypesIR
+
+	return nil
+}
+
+// bindFromSequence binds and validates parameter FromSequence from query.
+func (o *ListJournalEventsParams) bindFromSequence(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("fromSequence", "query", "int64", raw)
+	}
+	o.FromSequence = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListJournalEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	return nil
+}
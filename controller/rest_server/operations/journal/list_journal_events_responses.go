@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package journal
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// ListJournalEventsOKCode is the HTTP code returned for type ListJournalEventsOK
+const ListJournalEventsOKCode int = 200
+
+/*
+ListJournalEventsOK A page of events read from the event journal
+
+swagger:response listJournalEventsOK
+*/
+type ListJournalEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.ListJournalEventsEnvelope `json:"body,omitempty"`
+}
+
+// NewListJournalEventsOK creates ListJournalEventsOK with default headers values
+func NewListJournalEventsOK() *ListJournalEventsOK {
+
+	return &ListJournalEventsOK{}
+}
+
+// WithPayload adds the payload to the list journal events o k response
+func (o *ListJournalEventsOK) WithPayload(payload *rest_model.ListJournalEventsEnvelope) *ListJournalEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list journal events o k response
+func (o *ListJournalEventsOK) SetPayload(payload *rest_model.ListJournalEventsEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListJournalEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListJournalEventsUnauthorizedCode is the HTTP code returned for type ListJournalEventsUnauthorized
+const ListJournalEventsUnauthorizedCode int = 401
+
+/*
+ListJournalEventsUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response listJournalEventsUnauthorized
+*/
+type ListJournalEventsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListJournalEventsUnauthorized creates ListJournalEventsUnauthorized with default headers values
+func NewListJournalEventsUnauthorized() *ListJournalEventsUnauthorized {
+
+	return &ListJournalEventsUnauthorized{}
+}
+
+// WithPayload adds the payload to the list journal events unauthorized response
+func (o *ListJournalEventsUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *ListJournalEventsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list journal events unauthorized response
+func (o *ListJournalEventsUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListJournalEventsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListJournalEventsNotFoundCode is the HTTP code returned for type ListJournalEventsNotFound
+const ListJournalEventsNotFoundCode int = 404
+
+/*
+ListJournalEventsNotFound The requested resource does not exist
+
+swagger:response listJournalEventsNotFound
+*/
+type ListJournalEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListJournalEventsNotFound creates ListJournalEventsNotFound with default headers values
+func NewListJournalEventsNotFound() *ListJournalEventsNotFound {
+
+	return &ListJournalEventsNotFound{}
+}
+
+// WithPayload adds the payload to the list journal events not found response
+func (o *ListJournalEventsNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *ListJournalEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list journal events not found response
+func (o *ListJournalEventsNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListJournalEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
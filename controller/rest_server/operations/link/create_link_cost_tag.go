@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateLinkCostTagHandlerFunc turns a function with the right signature into a create link cost tag handler
+type CreateLinkCostTagHandlerFunc func(CreateLinkCostTagParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateLinkCostTagHandlerFunc) Handle(params CreateLinkCostTagParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateLinkCostTagHandler interface for that can handle valid create link cost tag params
+type CreateLinkCostTagHandler interface {
+	Handle(CreateLinkCostTagParams) middleware.Responder
+}
+
+// NewCreateLinkCostTag creates a new http.Handler for the create link cost tag operation
+func NewCreateLinkCostTag(ctx *middleware.Context, handler CreateLinkCostTagHandler) *CreateLinkCostTag {
+	return &CreateLinkCostTag{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateLinkCostTag swagger:route POST /link-cost-tags Link createLinkCostTag
+
+# Create a link cost tag resource
+
+Create a link cost tag resource. Links dialed on listeners with the named cost tag have their cost adjusted
+accordingly. Requires admin access.
+*/
+type CreateLinkCostTag struct {
+	Context *middleware.Context
+	Handler CreateLinkCostTagHandler
+}
+
+func (o *CreateLinkCostTag) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateLinkCostTagParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// CreateLinkCostTagCreatedCode is the HTTP code returned for type CreateLinkCostTagCreated
+const CreateLinkCostTagCreatedCode int = 201
+
+/*
+CreateLinkCostTagCreated The create request was successful and the resource has been added at the following location
+
+swagger:response createLinkCostTagCreated
+*/
+type CreateLinkCostTagCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.CreateEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkCostTagCreated creates CreateLinkCostTagCreated with default headers values
+func NewCreateLinkCostTagCreated() *CreateLinkCostTagCreated {
+
+	return &CreateLinkCostTagCreated{}
+}
+
+// WithPayload adds the payload to the create link cost tag created response
+func (o *CreateLinkCostTagCreated) WithPayload(payload *rest_model.CreateEnvelope) *CreateLinkCostTagCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link cost tag created response
+func (o *CreateLinkCostTagCreated) SetPayload(payload *rest_model.CreateEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkCostTagCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLinkCostTagBadRequestCode is the HTTP code returned for type CreateLinkCostTagBadRequest
+const CreateLinkCostTagBadRequestCode int = 400
+
+/*
+CreateLinkCostTagBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response createLinkCostTagBadRequest
+*/
+type CreateLinkCostTagBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkCostTagBadRequest creates CreateLinkCostTagBadRequest with default headers values
+func NewCreateLinkCostTagBadRequest() *CreateLinkCostTagBadRequest {
+
+	return &CreateLinkCostTagBadRequest{}
+}
+
+// WithPayload adds the payload to the create link cost tag bad request response
+func (o *CreateLinkCostTagBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateLinkCostTagBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link cost tag bad request response
+func (o *CreateLinkCostTagBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkCostTagBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLinkCostTagUnauthorizedCode is the HTTP code returned for type CreateLinkCostTagUnauthorized
+const CreateLinkCostTagUnauthorizedCode int = 401
+
+/*
+CreateLinkCostTagUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response createLinkCostTagUnauthorized
+*/
+type CreateLinkCostTagUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateLinkCostTagUnauthorized creates CreateLinkCostTagUnauthorized with default headers values
+func NewCreateLinkCostTagUnauthorized() *CreateLinkCostTagUnauthorized {
+
+	return &CreateLinkCostTagUnauthorized{}
+}
+
+// WithPayload adds the payload to the create link cost tag unauthorized response
+func (o *CreateLinkCostTagUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateLinkCostTagUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create link cost tag unauthorized response
+func (o *CreateLinkCostTagUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLinkCostTagUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
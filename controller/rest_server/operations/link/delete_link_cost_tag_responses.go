@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DeleteLinkCostTagOKCode is the HTTP code returned for type DeleteLinkCostTagOK
+const DeleteLinkCostTagOKCode int = 200
+
+/*
+DeleteLinkCostTagOK The delete request was successful and the resource has been removed
+
+swagger:response deleteLinkCostTagOK
+*/
+type DeleteLinkCostTagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewDeleteLinkCostTagOK creates DeleteLinkCostTagOK with default headers values
+func NewDeleteLinkCostTagOK() *DeleteLinkCostTagOK {
+
+	return &DeleteLinkCostTagOK{}
+}
+
+// WithPayload adds the payload to the delete link cost tag o k response
+func (o *DeleteLinkCostTagOK) WithPayload(payload *rest_model.Empty) *DeleteLinkCostTagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link cost tag o k response
+func (o *DeleteLinkCostTagOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkCostTagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteLinkCostTagBadRequestCode is the HTTP code returned for type DeleteLinkCostTagBadRequest
+const DeleteLinkCostTagBadRequestCode int = 400
+
+/*
+DeleteLinkCostTagBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response deleteLinkCostTagBadRequest
+*/
+type DeleteLinkCostTagBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteLinkCostTagBadRequest creates DeleteLinkCostTagBadRequest with default headers values
+func NewDeleteLinkCostTagBadRequest() *DeleteLinkCostTagBadRequest {
+
+	return &DeleteLinkCostTagBadRequest{}
+}
+
+// WithPayload adds the payload to the delete link cost tag bad request response
+func (o *DeleteLinkCostTagBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteLinkCostTagBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link cost tag bad request response
+func (o *DeleteLinkCostTagBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkCostTagBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteLinkCostTagUnauthorizedCode is the HTTP code returned for type DeleteLinkCostTagUnauthorized
+const DeleteLinkCostTagUnauthorizedCode int = 401
+
+/*
+DeleteLinkCostTagUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response deleteLinkCostTagUnauthorized
+*/
+type DeleteLinkCostTagUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteLinkCostTagUnauthorized creates DeleteLinkCostTagUnauthorized with default headers values
+func NewDeleteLinkCostTagUnauthorized() *DeleteLinkCostTagUnauthorized {
+
+	return &DeleteLinkCostTagUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete link cost tag unauthorized response
+func (o *DeleteLinkCostTagUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteLinkCostTagUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete link cost tag unauthorized response
+func (o *DeleteLinkCostTagUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLinkCostTagUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DetailLinkCostTagOKCode is the HTTP code returned for type DetailLinkCostTagOK
+const DetailLinkCostTagOKCode int = 200
+
+/*
+DetailLinkCostTagOK A single link cost tag
+
+swagger:response detailLinkCostTagOK
+*/
+type DetailLinkCostTagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.DetailLinkCostTagEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkCostTagOK creates DetailLinkCostTagOK with default headers values
+func NewDetailLinkCostTagOK() *DetailLinkCostTagOK {
+
+	return &DetailLinkCostTagOK{}
+}
+
+// WithPayload adds the payload to the detail link cost tag o k response
+func (o *DetailLinkCostTagOK) WithPayload(payload *rest_model.DetailLinkCostTagEnvelope) *DetailLinkCostTagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link cost tag o k response
+func (o *DetailLinkCostTagOK) SetPayload(payload *rest_model.DetailLinkCostTagEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkCostTagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DetailLinkCostTagUnauthorizedCode is the HTTP code returned for type DetailLinkCostTagUnauthorized
+const DetailLinkCostTagUnauthorizedCode int = 401
+
+/*
+DetailLinkCostTagUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response detailLinkCostTagUnauthorized
+*/
+type DetailLinkCostTagUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkCostTagUnauthorized creates DetailLinkCostTagUnauthorized with default headers values
+func NewDetailLinkCostTagUnauthorized() *DetailLinkCostTagUnauthorized {
+
+	return &DetailLinkCostTagUnauthorized{}
+}
+
+// WithPayload adds the payload to the detail link cost tag unauthorized response
+func (o *DetailLinkCostTagUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DetailLinkCostTagUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link cost tag unauthorized response
+func (o *DetailLinkCostTagUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkCostTagUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DetailLinkCostTagNotFoundCode is the HTTP code returned for type DetailLinkCostTagNotFound
+const DetailLinkCostTagNotFoundCode int = 404
+
+/*
+DetailLinkCostTagNotFound The requested resource does not exist
+
+swagger:response detailLinkCostTagNotFound
+*/
+type DetailLinkCostTagNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDetailLinkCostTagNotFound creates DetailLinkCostTagNotFound with default headers values
+func NewDetailLinkCostTagNotFound() *DetailLinkCostTagNotFound {
+
+	return &DetailLinkCostTagNotFound{}
+}
+
+// WithPayload adds the payload to the detail link cost tag not found response
+func (o *DetailLinkCostTagNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *DetailLinkCostTagNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the detail link cost tag not found response
+func (o *DetailLinkCostTagNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DetailLinkCostTagNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PatchLinkCostTagHandlerFunc turns a function with the right signature into a patch link cost tag handler
+type PatchLinkCostTagHandlerFunc func(PatchLinkCostTagParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PatchLinkCostTagHandlerFunc) Handle(params PatchLinkCostTagParams) middleware.Responder {
+	return fn(params)
+}
+
+// PatchLinkCostTagHandler interface for that can handle valid patch link cost tag params
+type PatchLinkCostTagHandler interface {
+	Handle(PatchLinkCostTagParams) middleware.Responder
+}
+
+// NewPatchLinkCostTag creates a new http.Handler for the patch link cost tag operation
+func NewPatchLinkCostTag(ctx *middleware.Context, handler PatchLinkCostTagHandler) *PatchLinkCostTag {
+	return &PatchLinkCostTag{Context: ctx, Handler: handler}
+}
+
+/*
+	PatchLinkCostTag swagger:route PATCH /link-cost-tags/{id} Link patchLinkCostTag
+
+# Update the supplied fields on a link cost tag
+
+Update the supplied fields on a link cost tag. Requires admin access.
+*/
+type PatchLinkCostTag struct {
+	Context *middleware.Context
+	Handler PatchLinkCostTagHandler
+}
+
+func (o *PatchLinkCostTag) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPatchLinkCostTagParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// PatchLinkCostTagOKCode is the HTTP code returned for type PatchLinkCostTagOK
+const PatchLinkCostTagOKCode int = 200
+
+/*
+PatchLinkCostTagOK The patch request was successful and the resource has been altered
+
+swagger:response patchLinkCostTagOK
+*/
+type PatchLinkCostTagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewPatchLinkCostTagOK creates PatchLinkCostTagOK with default headers values
+func NewPatchLinkCostTagOK() *PatchLinkCostTagOK {
+
+	return &PatchLinkCostTagOK{}
+}
+
+// WithPayload adds the payload to the patch link cost tag o k response
+func (o *PatchLinkCostTagOK) WithPayload(payload *rest_model.Empty) *PatchLinkCostTagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link cost tag o k response
+func (o *PatchLinkCostTagOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkCostTagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkCostTagBadRequestCode is the HTTP code returned for type PatchLinkCostTagBadRequest
+const PatchLinkCostTagBadRequestCode int = 400
+
+/*
+PatchLinkCostTagBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response patchLinkCostTagBadRequest
+*/
+type PatchLinkCostTagBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkCostTagBadRequest creates PatchLinkCostTagBadRequest with default headers values
+func NewPatchLinkCostTagBadRequest() *PatchLinkCostTagBadRequest {
+
+	return &PatchLinkCostTagBadRequest{}
+}
+
+// WithPayload adds the payload to the patch link cost tag bad request response
+func (o *PatchLinkCostTagBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkCostTagBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link cost tag bad request response
+func (o *PatchLinkCostTagBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkCostTagBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkCostTagUnauthorizedCode is the HTTP code returned for type PatchLinkCostTagUnauthorized
+const PatchLinkCostTagUnauthorizedCode int = 401
+
+/*
+PatchLinkCostTagUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response patchLinkCostTagUnauthorized
+*/
+type PatchLinkCostTagUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkCostTagUnauthorized creates PatchLinkCostTagUnauthorized with default headers values
+func NewPatchLinkCostTagUnauthorized() *PatchLinkCostTagUnauthorized {
+
+	return &PatchLinkCostTagUnauthorized{}
+}
+
+// WithPayload adds the payload to the patch link cost tag unauthorized response
+func (o *PatchLinkCostTagUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkCostTagUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link cost tag unauthorized response
+func (o *PatchLinkCostTagUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkCostTagUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchLinkCostTagNotFoundCode is the HTTP code returned for type PatchLinkCostTagNotFound
+const PatchLinkCostTagNotFoundCode int = 404
+
+/*
+PatchLinkCostTagNotFound The requested resource does not exist
+
+swagger:response patchLinkCostTagNotFound
+*/
+type PatchLinkCostTagNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchLinkCostTagNotFound creates PatchLinkCostTagNotFound with default headers values
+func NewPatchLinkCostTagNotFound() *PatchLinkCostTagNotFound {
+
+	return &PatchLinkCostTagNotFound{}
+}
+
+// WithPayload adds the payload to the patch link cost tag not found response
+func (o *PatchLinkCostTagNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchLinkCostTagNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch link cost tag not found response
+func (o *PatchLinkCostTagNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchLinkCostTagNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
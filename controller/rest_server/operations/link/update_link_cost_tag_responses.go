@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package link
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// UpdateLinkCostTagOKCode is the HTTP code returned for type UpdateLinkCostTagOK
+const UpdateLinkCostTagOKCode int = 200
+
+/*
+UpdateLinkCostTagOK The update request was successful and the resource has been altered
+
+swagger:response updateLinkCostTagOK
+*/
+type UpdateLinkCostTagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewUpdateLinkCostTagOK creates UpdateLinkCostTagOK with default headers values
+func NewUpdateLinkCostTagOK() *UpdateLinkCostTagOK {
+
+	return &UpdateLinkCostTagOK{}
+}
+
+// WithPayload adds the payload to the update link cost tag o k response
+func (o *UpdateLinkCostTagOK) WithPayload(payload *rest_model.Empty) *UpdateLinkCostTagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link cost tag o k response
+func (o *UpdateLinkCostTagOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkCostTagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkCostTagBadRequestCode is the HTTP code returned for type UpdateLinkCostTagBadRequest
+const UpdateLinkCostTagBadRequestCode int = 400
+
+/*
+UpdateLinkCostTagBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response updateLinkCostTagBadRequest
+*/
+type UpdateLinkCostTagBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkCostTagBadRequest creates UpdateLinkCostTagBadRequest with default headers values
+func NewUpdateLinkCostTagBadRequest() *UpdateLinkCostTagBadRequest {
+
+	return &UpdateLinkCostTagBadRequest{}
+}
+
+// WithPayload adds the payload to the update link cost tag bad request response
+func (o *UpdateLinkCostTagBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkCostTagBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link cost tag bad request response
+func (o *UpdateLinkCostTagBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkCostTagBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkCostTagUnauthorizedCode is the HTTP code returned for type UpdateLinkCostTagUnauthorized
+const UpdateLinkCostTagUnauthorizedCode int = 401
+
+/*
+UpdateLinkCostTagUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response updateLinkCostTagUnauthorized
+*/
+type UpdateLinkCostTagUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkCostTagUnauthorized creates UpdateLinkCostTagUnauthorized with default headers values
+func NewUpdateLinkCostTagUnauthorized() *UpdateLinkCostTagUnauthorized {
+
+	return &UpdateLinkCostTagUnauthorized{}
+}
+
+// WithPayload adds the payload to the update link cost tag unauthorized response
+func (o *UpdateLinkCostTagUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkCostTagUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link cost tag unauthorized response
+func (o *UpdateLinkCostTagUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkCostTagUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateLinkCostTagNotFoundCode is the HTTP code returned for type UpdateLinkCostTagNotFound
+const UpdateLinkCostTagNotFoundCode int = 404
+
+/*
+UpdateLinkCostTagNotFound The requested resource does not exist
+
+swagger:response updateLinkCostTagNotFound
+*/
+type UpdateLinkCostTagNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateLinkCostTagNotFound creates UpdateLinkCostTagNotFound with default headers values
+func NewUpdateLinkCostTagNotFound() *UpdateLinkCostTagNotFound {
+
+	return &UpdateLinkCostTagNotFound{}
+}
+
+// WithPayload adds the payload to the update link cost tag not found response
+func (o *UpdateLinkCostTagNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateLinkCostTagNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update link cost tag not found response
+func (o *UpdateLinkCostTagNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateLinkCostTagNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// EndRouterMaintenanceHandlerFunc turns a function with the right signature into a end router maintenance handler
+type EndRouterMaintenanceHandlerFunc func(EndRouterMaintenanceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn EndRouterMaintenanceHandlerFunc) Handle(params EndRouterMaintenanceParams) middleware.Responder {
+	return fn(params)
+}
+
+// EndRouterMaintenanceHandler interface for that can handle valid end router maintenance params
+type EndRouterMaintenanceHandler interface {
+	Handle(EndRouterMaintenanceParams) middleware.Responder
+}
+
+// NewEndRouterMaintenance creates a new http.Handler for the end router maintenance operation
+func NewEndRouterMaintenance(ctx *middleware.Context, handler EndRouterMaintenanceHandler) *EndRouterMaintenance {
+	return &EndRouterMaintenance{Context: ctx, Handler: handler}
+}
+
+/*
+	EndRouterMaintenance swagger:route DELETE /routers/{id}/maintenance Router endRouterMaintenance
+
+# End router maintenance
+
+Returns a router in maintenance to service, so it can be used as transit again. Requires admin access.
+*/
+type EndRouterMaintenance struct {
+	Context *middleware.Context
+	Handler EndRouterMaintenanceHandler
+}
+
+func (o *EndRouterMaintenance) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewEndRouterMaintenanceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
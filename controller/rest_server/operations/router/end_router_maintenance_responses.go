@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// EndRouterMaintenanceOKCode is the HTTP code returned for type EndRouterMaintenanceOK
+const EndRouterMaintenanceOKCode int = 200
+
+/*
+EndRouterMaintenanceOK Base empty response
+
+swagger:response endRouterMaintenanceOK
+*/
+type EndRouterMaintenanceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewEndRouterMaintenanceOK creates EndRouterMaintenanceOK with default headers values
+func NewEndRouterMaintenanceOK() *EndRouterMaintenanceOK {
+
+	return &EndRouterMaintenanceOK{}
+}
+
+// WithPayload adds the payload to the end router maintenance o k response
+func (o *EndRouterMaintenanceOK) WithPayload(payload *rest_model.Empty) *EndRouterMaintenanceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the end router maintenance o k response
+func (o *EndRouterMaintenanceOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EndRouterMaintenanceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// EndRouterMaintenanceUnauthorizedCode is the HTTP code returned for type EndRouterMaintenanceUnauthorized
+const EndRouterMaintenanceUnauthorizedCode int = 401
+
+/*
+EndRouterMaintenanceUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response endRouterMaintenanceUnauthorized
+*/
+type EndRouterMaintenanceUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewEndRouterMaintenanceUnauthorized creates EndRouterMaintenanceUnauthorized with default headers values
+func NewEndRouterMaintenanceUnauthorized() *EndRouterMaintenanceUnauthorized {
+
+	return &EndRouterMaintenanceUnauthorized{}
+}
+
+// WithPayload adds the payload to the end router maintenance unauthorized response
+func (o *EndRouterMaintenanceUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *EndRouterMaintenanceUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the end router maintenance unauthorized response
+func (o *EndRouterMaintenanceUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EndRouterMaintenanceUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// EndRouterMaintenanceNotFoundCode is the HTTP code returned for type EndRouterMaintenanceNotFound
+const EndRouterMaintenanceNotFoundCode int = 404
+
+/*
+EndRouterMaintenanceNotFound The requested resource does not exist
+
+swagger:response endRouterMaintenanceNotFound
+*/
+type EndRouterMaintenanceNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewEndRouterMaintenanceNotFound creates EndRouterMaintenanceNotFound with default headers values
+func NewEndRouterMaintenanceNotFound() *EndRouterMaintenanceNotFound {
+
+	return &EndRouterMaintenanceNotFound{}
+}
+
+// WithPayload adds the payload to the end router maintenance not found response
+func (o *EndRouterMaintenanceNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *EndRouterMaintenanceNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the end router maintenance not found response
+func (o *EndRouterMaintenanceNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EndRouterMaintenanceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
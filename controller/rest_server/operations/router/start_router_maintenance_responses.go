@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package router
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// StartRouterMaintenanceOKCode is the HTTP code returned for type StartRouterMaintenanceOK
+const StartRouterMaintenanceOKCode int = 200
+
+/*
+StartRouterMaintenanceOK Base empty response
+
+swagger:response startRouterMaintenanceOK
+*/
+type StartRouterMaintenanceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewStartRouterMaintenanceOK creates StartRouterMaintenanceOK with default headers values
+func NewStartRouterMaintenanceOK() *StartRouterMaintenanceOK {
+
+	return &StartRouterMaintenanceOK{}
+}
+
+// WithPayload adds the payload to the start router maintenance o k response
+func (o *StartRouterMaintenanceOK) WithPayload(payload *rest_model.Empty) *StartRouterMaintenanceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start router maintenance o k response
+func (o *StartRouterMaintenanceOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartRouterMaintenanceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StartRouterMaintenanceUnauthorizedCode is the HTTP code returned for type StartRouterMaintenanceUnauthorized
+const StartRouterMaintenanceUnauthorizedCode int = 401
+
+/*
+StartRouterMaintenanceUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response startRouterMaintenanceUnauthorized
+*/
+type StartRouterMaintenanceUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewStartRouterMaintenanceUnauthorized creates StartRouterMaintenanceUnauthorized with default headers values
+func NewStartRouterMaintenanceUnauthorized() *StartRouterMaintenanceUnauthorized {
+
+	return &StartRouterMaintenanceUnauthorized{}
+}
+
+// WithPayload adds the payload to the start router maintenance unauthorized response
+func (o *StartRouterMaintenanceUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *StartRouterMaintenanceUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start router maintenance unauthorized response
+func (o *StartRouterMaintenanceUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartRouterMaintenanceUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StartRouterMaintenanceNotFoundCode is the HTTP code returned for type StartRouterMaintenanceNotFound
+const StartRouterMaintenanceNotFoundCode int = 404
+
+/*
+StartRouterMaintenanceNotFound The requested resource does not exist
+
+swagger:response startRouterMaintenanceNotFound
+*/
+type StartRouterMaintenanceNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewStartRouterMaintenanceNotFound creates StartRouterMaintenanceNotFound with default headers values
+func NewStartRouterMaintenanceNotFound() *StartRouterMaintenanceNotFound {
+
+	return &StartRouterMaintenanceNotFound{}
+}
+
+// WithPayload adds the payload to the start router maintenance not found response
+func (o *StartRouterMaintenanceNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *StartRouterMaintenanceNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the start router maintenance not found response
+func (o *StartRouterMaintenanceNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StartRouterMaintenanceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// CancelTerminatorDrainOKCode is the HTTP code returned for type CancelTerminatorDrainOK
+const CancelTerminatorDrainOKCode int = 200
+
+/*
+CancelTerminatorDrainOK Base empty response
+
+swagger:response cancelTerminatorDrainOK
+*/
+type CancelTerminatorDrainOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewCancelTerminatorDrainOK creates CancelTerminatorDrainOK with default headers values
+func NewCancelTerminatorDrainOK() *CancelTerminatorDrainOK {
+
+	return &CancelTerminatorDrainOK{}
+}
+
+// WithPayload adds the payload to the cancel terminator drain o k response
+func (o *CancelTerminatorDrainOK) WithPayload(payload *rest_model.Empty) *CancelTerminatorDrainOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel terminator drain o k response
+func (o *CancelTerminatorDrainOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelTerminatorDrainOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelTerminatorDrainUnauthorizedCode is the HTTP code returned for type CancelTerminatorDrainUnauthorized
+const CancelTerminatorDrainUnauthorizedCode int = 401
+
+/*
+CancelTerminatorDrainUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response cancelTerminatorDrainUnauthorized
+*/
+type CancelTerminatorDrainUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCancelTerminatorDrainUnauthorized creates CancelTerminatorDrainUnauthorized with default headers values
+func NewCancelTerminatorDrainUnauthorized() *CancelTerminatorDrainUnauthorized {
+
+	return &CancelTerminatorDrainUnauthorized{}
+}
+
+// WithPayload adds the payload to the cancel terminator drain unauthorized response
+func (o *CancelTerminatorDrainUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *CancelTerminatorDrainUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel terminator drain unauthorized response
+func (o *CancelTerminatorDrainUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelTerminatorDrainUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelTerminatorDrainNotFoundCode is the HTTP code returned for type CancelTerminatorDrainNotFound
+const CancelTerminatorDrainNotFoundCode int = 404
+
+/*
+CancelTerminatorDrainNotFound The requested resource does not exist
+
+swagger:response cancelTerminatorDrainNotFound
+*/
+type CancelTerminatorDrainNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCancelTerminatorDrainNotFound creates CancelTerminatorDrainNotFound with default headers values
+func NewCancelTerminatorDrainNotFound() *CancelTerminatorDrainNotFound {
+
+	return &CancelTerminatorDrainNotFound{}
+}
+
+// WithPayload adds the payload to the cancel terminator drain not found response
+func (o *CancelTerminatorDrainNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *CancelTerminatorDrainNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel terminator drain not found response
+func (o *CancelTerminatorDrainNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelTerminatorDrainNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
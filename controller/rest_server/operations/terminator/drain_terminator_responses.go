@@ -0,0 +1,218 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package terminator
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/fabric/controller/rest_model"
+)
+
+// DrainTerminatorOKCode is the HTTP code returned for type DrainTerminatorOK
+const DrainTerminatorOKCode int = 200
+
+/*
+DrainTerminatorOK Base empty response
+
+swagger:response drainTerminatorOK
+*/
+type DrainTerminatorOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewDrainTerminatorOK creates DrainTerminatorOK with default headers values
+func NewDrainTerminatorOK() *DrainTerminatorOK {
+
+	return &DrainTerminatorOK{}
+}
+
+// WithPayload adds the payload to the drain terminator o k response
+func (o *DrainTerminatorOK) WithPayload(payload *rest_model.Empty) *DrainTerminatorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the drain terminator o k response
+func (o *DrainTerminatorOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DrainTerminatorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DrainTerminatorBadRequestCode is the HTTP code returned for type DrainTerminatorBadRequest
+const DrainTerminatorBadRequestCode int = 400
+
+/*
+DrainTerminatorBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response drainTerminatorBadRequest
+*/
+type DrainTerminatorBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDrainTerminatorBadRequest creates DrainTerminatorBadRequest with default headers values
+func NewDrainTerminatorBadRequest() *DrainTerminatorBadRequest {
+
+	return &DrainTerminatorBadRequest{}
+}
+
+// WithPayload adds the payload to the drain terminator bad request response
+func (o *DrainTerminatorBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *DrainTerminatorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the drain terminator bad request response
+func (o *DrainTerminatorBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DrainTerminatorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DrainTerminatorUnauthorizedCode is the HTTP code returned for type DrainTerminatorUnauthorized
+const DrainTerminatorUnauthorizedCode int = 401
+
+/*
+DrainTerminatorUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response drainTerminatorUnauthorized
+*/
+type DrainTerminatorUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDrainTerminatorUnauthorized creates DrainTerminatorUnauthorized with default headers values
+func NewDrainTerminatorUnauthorized() *DrainTerminatorUnauthorized {
+
+	return &DrainTerminatorUnauthorized{}
+}
+
+// WithPayload adds the payload to the drain terminator unauthorized response
+func (o *DrainTerminatorUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DrainTerminatorUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the drain terminator unauthorized response
+func (o *DrainTerminatorUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DrainTerminatorUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DrainTerminatorNotFoundCode is the HTTP code returned for type DrainTerminatorNotFound
+const DrainTerminatorNotFoundCode int = 404
+
+/*
+DrainTerminatorNotFound The requested resource does not exist
+
+swagger:response drainTerminatorNotFound
+*/
+type DrainTerminatorNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDrainTerminatorNotFound creates DrainTerminatorNotFound with default headers values
+func NewDrainTerminatorNotFound() *DrainTerminatorNotFound {
+
+	return &DrainTerminatorNotFound{}
+}
+
+// WithPayload adds the payload to the drain terminator not found response
+func (o *DrainTerminatorNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *DrainTerminatorNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the drain terminator not found response
+func (o *DrainTerminatorNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DrainTerminatorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
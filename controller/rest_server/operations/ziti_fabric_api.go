@@ -46,6 +46,7 @@ import (
 	"github.com/openziti/fabric/controller/rest_server/operations/circuit"
 	"github.com/openziti/fabric/controller/rest_server/operations/database"
 	"github.com/openziti/fabric/controller/rest_server/operations/inspect"
+	"github.com/openziti/fabric/controller/rest_server/operations/journal"
 	"github.com/openziti/fabric/controller/rest_server/operations/link"
 	"github.com/openziti/fabric/controller/rest_server/operations/raft"
 	"github.com/openziti/fabric/controller/rest_server/operations/router"
@@ -75,6 +76,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		TerminatorCancelTerminatorDrainHandler: terminator.CancelTerminatorDrainHandlerFunc(func(params terminator.CancelTerminatorDrainParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.CancelTerminatorDrain has not yet been implemented")
+		}),
 		DatabaseCheckDataIntegrityHandler: database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
 		}),
@@ -84,6 +88,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		DatabaseCreateDatabaseSnapshotWithPathHandler: database.CreateDatabaseSnapshotWithPathHandlerFunc(func(params database.CreateDatabaseSnapshotWithPathParams) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshotWithPath has not yet been implemented")
 		}),
+		LinkCreateLinkCostTagHandler: link.CreateLinkCostTagHandlerFunc(func(params link.CreateLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.CreateLinkCostTag has not yet been implemented")
+		}),
 		RouterCreateRouterHandler: router.CreateRouterHandlerFunc(func(params router.CreateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.CreateRouter has not yet been implemented")
 		}),
@@ -102,6 +109,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		LinkDeleteLinkHandler: link.DeleteLinkHandlerFunc(func(params link.DeleteLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DeleteLink has not yet been implemented")
 		}),
+		LinkDeleteLinkCostTagHandler: link.DeleteLinkCostTagHandlerFunc(func(params link.DeleteLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.DeleteLinkCostTag has not yet been implemented")
+		}),
 		RouterDeleteRouterHandler: router.DeleteRouterHandlerFunc(func(params router.DeleteRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DeleteRouter has not yet been implemented")
 		}),
@@ -117,6 +127,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		LinkDetailLinkHandler: link.DetailLinkHandlerFunc(func(params link.DetailLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.DetailLink has not yet been implemented")
 		}),
+		LinkDetailLinkCostTagHandler: link.DetailLinkCostTagHandlerFunc(func(params link.DetailLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.DetailLinkCostTag has not yet been implemented")
+		}),
 		RouterDetailRouterHandler: router.DetailRouterHandlerFunc(func(params router.DetailRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.DetailRouter has not yet been implemented")
 		}),
@@ -126,6 +139,12 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorDetailTerminatorHandler: terminator.DetailTerminatorHandlerFunc(func(params terminator.DetailTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		}),
+		TerminatorDrainTerminatorHandler: terminator.DrainTerminatorHandlerFunc(func(params terminator.DrainTerminatorParams) middleware.Responder {
+			return middleware.NotImplemented("operation terminator.DrainTerminator has not yet been implemented")
+		}),
+		RouterEndRouterMaintenanceHandler: router.EndRouterMaintenanceHandlerFunc(func(params router.EndRouterMaintenanceParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.EndRouterMaintenance has not yet been implemented")
+		}),
 		DatabaseFixDataIntegrityHandler: database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
 		}),
@@ -135,6 +154,12 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		CircuitListCircuitsHandler: circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		}),
+		JournalListJournalEventsHandler: journal.ListJournalEventsHandlerFunc(func(params journal.ListJournalEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation journal.ListJournalEvents has not yet been implemented")
+		}),
+		LinkListLinkCostTagsHandler: link.ListLinkCostTagsHandlerFunc(func(params link.ListLinkCostTagsParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.ListLinkCostTags has not yet been implemented")
+		}),
 		LinkListLinksHandler: link.ListLinksHandlerFunc(func(params link.ListLinksParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.ListLinks has not yet been implemented")
 		}),
@@ -156,6 +181,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		LinkPatchLinkHandler: link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		}),
+		LinkPatchLinkCostTagHandler: link.PatchLinkCostTagHandlerFunc(func(params link.PatchLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.PatchLinkCostTag has not yet been implemented")
+		}),
 		RouterPatchRouterHandler: router.PatchRouterHandlerFunc(func(params router.PatchRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.PatchRouter has not yet been implemented")
 		}),
@@ -168,6 +196,12 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		RaftRaftListMembersHandler: raft.RaftListMembersHandlerFunc(func(params raft.RaftListMembersParams) middleware.Responder {
 			return middleware.NotImplemented("operation raft.RaftListMembers has not yet been implemented")
 		}),
+		RouterStartRouterMaintenanceHandler: router.StartRouterMaintenanceHandlerFunc(func(params router.StartRouterMaintenanceParams) middleware.Responder {
+			return middleware.NotImplemented("operation router.StartRouterMaintenance has not yet been implemented")
+		}),
+		LinkUpdateLinkCostTagHandler: link.UpdateLinkCostTagHandlerFunc(func(params link.UpdateLinkCostTagParams) middleware.Responder {
+			return middleware.NotImplemented("operation link.UpdateLinkCostTag has not yet been implemented")
+		}),
 		RouterUpdateRouterHandler: router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
 		}),
@@ -213,12 +247,16 @@ type ZitiFabricAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// TerminatorCancelTerminatorDrainHandler sets the operation handler for the cancel terminator drain operation
+	TerminatorCancelTerminatorDrainHandler terminator.CancelTerminatorDrainHandler
 	// DatabaseCheckDataIntegrityHandler sets the operation handler for the check data integrity operation
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
 	// DatabaseCreateDatabaseSnapshotHandler sets the operation handler for the create database snapshot operation
 	DatabaseCreateDatabaseSnapshotHandler database.CreateDatabaseSnapshotHandler
 	// DatabaseCreateDatabaseSnapshotWithPathHandler sets the operation handler for the create database snapshot with path operation
 	DatabaseCreateDatabaseSnapshotWithPathHandler database.CreateDatabaseSnapshotWithPathHandler
+	// LinkCreateLinkCostTagHandler sets the operation handler for the create link cost tag operation
+	LinkCreateLinkCostTagHandler link.CreateLinkCostTagHandler
 	// RouterCreateRouterHandler sets the operation handler for the create router operation
 	RouterCreateRouterHandler router.CreateRouterHandler
 	// ServiceCreateServiceHandler sets the operation handler for the create service operation
@@ -231,6 +269,8 @@ type ZitiFabricAPI struct {
 	CircuitDeleteCircuitHandler circuit.DeleteCircuitHandler
 	// LinkDeleteLinkHandler sets the operation handler for the delete link operation
 	LinkDeleteLinkHandler link.DeleteLinkHandler
+	// LinkDeleteLinkCostTagHandler sets the operation handler for the delete link cost tag operation
+	LinkDeleteLinkCostTagHandler link.DeleteLinkCostTagHandler
 	// RouterDeleteRouterHandler sets the operation handler for the delete router operation
 	RouterDeleteRouterHandler router.DeleteRouterHandler
 	// ServiceDeleteServiceHandler sets the operation handler for the delete service operation
@@ -241,18 +281,28 @@ type ZitiFabricAPI struct {
 	CircuitDetailCircuitHandler circuit.DetailCircuitHandler
 	// LinkDetailLinkHandler sets the operation handler for the detail link operation
 	LinkDetailLinkHandler link.DetailLinkHandler
+	// LinkDetailLinkCostTagHandler sets the operation handler for the detail link cost tag operation
+	LinkDetailLinkCostTagHandler link.DetailLinkCostTagHandler
 	// RouterDetailRouterHandler sets the operation handler for the detail router operation
 	RouterDetailRouterHandler router.DetailRouterHandler
 	// ServiceDetailServiceHandler sets the operation handler for the detail service operation
 	ServiceDetailServiceHandler service.DetailServiceHandler
 	// TerminatorDetailTerminatorHandler sets the operation handler for the detail terminator operation
 	TerminatorDetailTerminatorHandler terminator.DetailTerminatorHandler
+	// TerminatorDrainTerminatorHandler sets the operation handler for the drain terminator operation
+	TerminatorDrainTerminatorHandler terminator.DrainTerminatorHandler
+	// RouterEndRouterMaintenanceHandler sets the operation handler for the end router maintenance operation
+	RouterEndRouterMaintenanceHandler router.EndRouterMaintenanceHandler
 	// DatabaseFixDataIntegrityHandler sets the operation handler for the fix data integrity operation
 	DatabaseFixDataIntegrityHandler database.FixDataIntegrityHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
 	// CircuitListCircuitsHandler sets the operation handler for the list circuits operation
 	CircuitListCircuitsHandler circuit.ListCircuitsHandler
+	// JournalListJournalEventsHandler sets the operation handler for the list journal events operation
+	JournalListJournalEventsHandler journal.ListJournalEventsHandler
+	// LinkListLinkCostTagsHandler sets the operation handler for the list link cost tags operation
+	LinkListLinkCostTagsHandler link.ListLinkCostTagsHandler
 	// LinkListLinksHandler sets the operation handler for the list links operation
 	LinkListLinksHandler link.ListLinksHandler
 	// RouterListRouterTerminatorsHandler sets the operation handler for the list router terminators operation
@@ -267,6 +317,8 @@ type ZitiFabricAPI struct {
 	TerminatorListTerminatorsHandler terminator.ListTerminatorsHandler
 	// LinkPatchLinkHandler sets the operation handler for the patch link operation
 	LinkPatchLinkHandler link.PatchLinkHandler
+	// LinkPatchLinkCostTagHandler sets the operation handler for the patch link cost tag operation
+	LinkPatchLinkCostTagHandler link.PatchLinkCostTagHandler
 	// RouterPatchRouterHandler sets the operation handler for the patch router operation
 	RouterPatchRouterHandler router.PatchRouterHandler
 	// ServicePatchServiceHandler sets the operation handler for the patch service operation
@@ -275,6 +327,10 @@ type ZitiFabricAPI struct {
 	TerminatorPatchTerminatorHandler terminator.PatchTerminatorHandler
 	// RaftRaftListMembersHandler sets the operation handler for the raft list members operation
 	RaftRaftListMembersHandler raft.RaftListMembersHandler
+	// RouterStartRouterMaintenanceHandler sets the operation handler for the start router maintenance operation
+	RouterStartRouterMaintenanceHandler router.StartRouterMaintenanceHandler
+	// LinkUpdateLinkCostTagHandler sets the operation handler for the update link cost tag operation
+	LinkUpdateLinkCostTagHandler link.UpdateLinkCostTagHandler
 	// RouterUpdateRouterHandler sets the operation handler for the update router operation
 	RouterUpdateRouterHandler router.UpdateRouterHandler
 	// ServiceUpdateServiceHandler sets the operation handler for the update service operation
@@ -358,6 +414,9 @@ func (o *ZitiFabricAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.TerminatorCancelTerminatorDrainHandler == nil {
+		unregistered = append(unregistered, "terminator.CancelTerminatorDrainHandler")
+	}
 	if o.DatabaseCheckDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.CheckDataIntegrityHandler")
 	}
@@ -367,6 +426,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.DatabaseCreateDatabaseSnapshotWithPathHandler == nil {
 		unregistered = append(unregistered, "database.CreateDatabaseSnapshotWithPathHandler")
 	}
+	if o.LinkCreateLinkCostTagHandler == nil {
+		unregistered = append(unregistered, "link.CreateLinkCostTagHandler")
+	}
 	if o.RouterCreateRouterHandler == nil {
 		unregistered = append(unregistered, "router.CreateRouterHandler")
 	}
@@ -385,6 +447,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.LinkDeleteLinkHandler == nil {
 		unregistered = append(unregistered, "link.DeleteLinkHandler")
 	}
+	if o.LinkDeleteLinkCostTagHandler == nil {
+		unregistered = append(unregistered, "link.DeleteLinkCostTagHandler")
+	}
 	if o.RouterDeleteRouterHandler == nil {
 		unregistered = append(unregistered, "router.DeleteRouterHandler")
 	}
@@ -400,6 +465,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.LinkDetailLinkHandler == nil {
 		unregistered = append(unregistered, "link.DetailLinkHandler")
 	}
+	if o.LinkDetailLinkCostTagHandler == nil {
+		unregistered = append(unregistered, "link.DetailLinkCostTagHandler")
+	}
 	if o.RouterDetailRouterHandler == nil {
 		unregistered = append(unregistered, "router.DetailRouterHandler")
 	}
@@ -409,6 +477,12 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorDetailTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.DetailTerminatorHandler")
 	}
+	if o.TerminatorDrainTerminatorHandler == nil {
+		unregistered = append(unregistered, "terminator.DrainTerminatorHandler")
+	}
+	if o.RouterEndRouterMaintenanceHandler == nil {
+		unregistered = append(unregistered, "router.EndRouterMaintenanceHandler")
+	}
 	if o.DatabaseFixDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.FixDataIntegrityHandler")
 	}
@@ -418,6 +492,12 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.CircuitListCircuitsHandler == nil {
 		unregistered = append(unregistered, "circuit.ListCircuitsHandler")
 	}
+	if o.JournalListJournalEventsHandler == nil {
+		unregistered = append(unregistered, "journal.ListJournalEventsHandler")
+	}
+	if o.LinkListLinkCostTagsHandler == nil {
+		unregistered = append(unregistered, "link.ListLinkCostTagsHandler")
+	}
 	if o.LinkListLinksHandler == nil {
 		unregistered = append(unregistered, "link.ListLinksHandler")
 	}
@@ -439,6 +519,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.LinkPatchLinkHandler == nil {
 		unregistered = append(unregistered, "link.PatchLinkHandler")
 	}
+	if o.LinkPatchLinkCostTagHandler == nil {
+		unregistered = append(unregistered, "link.PatchLinkCostTagHandler")
+	}
 	if o.RouterPatchRouterHandler == nil {
 		unregistered = append(unregistered, "router.PatchRouterHandler")
 	}
@@ -451,6 +534,12 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.RaftRaftListMembersHandler == nil {
 		unregistered = append(unregistered, "raft.RaftListMembersHandler")
 	}
+	if o.RouterStartRouterMaintenanceHandler == nil {
+		unregistered = append(unregistered, "router.StartRouterMaintenanceHandler")
+	}
+	if o.LinkUpdateLinkCostTagHandler == nil {
+		unregistered = append(unregistered, "link.UpdateLinkCostTagHandler")
+	}
 	if o.RouterUpdateRouterHandler == nil {
 		unregistered = append(unregistered, "router.UpdateRouterHandler")
 	}
@@ -548,6 +637,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/terminators/{id}/drain"] = terminator.NewCancelTerminatorDrain(o.context, o.TerminatorCancelTerminatorDrainHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -563,6 +656,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/link-cost-tags"] = link.NewCreateLinkCostTag(o.context, o.LinkCreateLinkCostTagHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/routers"] = router.NewCreateRouter(o.context, o.RouterCreateRouterHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -587,6 +684,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/link-cost-tags/{id}"] = link.NewDeleteLinkCostTag(o.context, o.LinkDeleteLinkCostTagHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/routers/{id}"] = router.NewDeleteRouter(o.context, o.RouterDeleteRouterHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -607,6 +708,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/link-cost-tags/{id}"] = link.NewDetailLinkCostTag(o.context, o.LinkDetailLinkCostTagHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/routers/{id}"] = router.NewDetailRouter(o.context, o.RouterDetailRouterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -619,6 +724,14 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/terminators/{id}/drain"] = terminator.NewDrainTerminator(o.context, o.TerminatorDrainTerminatorHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/routers/{id}/maintenance"] = router.NewEndRouterMaintenance(o.context, o.RouterEndRouterMaintenanceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/database/fix-data-integrity"] = database.NewFixDataIntegrity(o.context, o.DatabaseFixDataIntegrityHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -631,6 +744,14 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/events/journal"] = journal.NewListJournalEvents(o.context, o.JournalListJournalEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/link-cost-tags"] = link.NewListLinkCostTags(o.context, o.LinkListLinkCostTagsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/links"] = link.NewListLinks(o.context, o.LinkListLinksHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -659,6 +780,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
+	o.handlers["PATCH"]["/link-cost-tags/{id}"] = link.NewPatchLinkCostTag(o.context, o.LinkPatchLinkCostTagHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
 	o.handlers["PATCH"]["/routers/{id}"] = router.NewPatchRouter(o.context, o.RouterPatchRouterHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
@@ -672,6 +797,14 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/raft/list-members"] = raft.NewRaftListMembers(o.context, o.RaftRaftListMembersHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/routers/{id}/maintenance"] = router.NewStartRouterMaintenance(o.context, o.RouterStartRouterMaintenanceHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/link-cost-tags/{id}"] = link.NewUpdateLinkCostTag(o.context, o.LinkUpdateLinkCostTagHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PathConstraints restrict the paths which may be used to reach a terminator. Router selectors are either a tag
+// name, which matches routers which have that tag, or name=value, which matches routers where the tag has the
+// given value. The region, zone and provider names match the router attributes of the same name, when set.
+type PathConstraints struct {
+	// AvoidRouterTags excludes routers matching any of the selectors
+	AvoidRouterTags []string
+	// TraverseRouterTags requires that, for each selector, at least one router on the path matches it
+	TraverseRouterTags []string
+	// MaxHops limits the number of links in the path. Zero means no limit
+	MaxHops int
+	// MaxLinkLatency excludes links with a latency, in either direction, above the given value. Zero means no limit
+	MaxLinkLatency time.Duration
+	// AllowedRouters, if set, requires every router on the path to match at least one of the entries. Entries are
+	// router ids or router selectors
+	AllowedRouters []string
+	// DeniedRouters excludes routers matching any of the entries. Entries are router ids or router selectors
+	DeniedRouters []string
+}
+
+func (self *PathConstraints) IsEmpty() bool {
+	return self == nil || (len(self.AvoidRouterTags) == 0 && len(self.TraverseRouterTags) == 0 &&
+		self.MaxHops <= 0 && self.MaxLinkLatency <= 0 && len(self.AllowedRouters) == 0 && len(self.DeniedRouters) == 0)
+}
+
+func (self *PathConstraints) String() string {
+	if self.IsEmpty() {
+		return "{}"
+	}
+	var parts []string
+	if len(self.AvoidRouterTags) > 0 {
+		parts = append(parts, fmt.Sprintf("avoid=%v", self.AvoidRouterTags))
+	}
+	if len(self.TraverseRouterTags) > 0 {
+		parts = append(parts, fmt.Sprintf("traverse=%v", self.TraverseRouterTags))
+	}
+	if self.MaxHops > 0 {
+		parts = append(parts, fmt.Sprintf("maxHops=%v", self.MaxHops))
+	}
+	if self.MaxLinkLatency > 0 {
+		parts = append(parts, fmt.Sprintf("maxLinkLatency=%v", self.MaxLinkLatency))
+	}
+	if len(self.AllowedRouters) > 0 {
+		parts = append(parts, fmt.Sprintf("allowed=%v", self.AllowedRouters))
+	}
+	if len(self.DeniedRouters) > 0 {
+		parts = append(parts, fmt.Sprintf("denied=%v", self.DeniedRouters))
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// MatchesRouterSelector returns true if the given router tags match the selector
+func MatchesRouterSelector(tags map[string]interface{}, selector string) bool {
+	name, value, hasValue := strings.Cut(selector, "=")
+	tagValue, found := tags[name]
+	if !found {
+		return false
+	}
+	return !hasValue || fmt.Sprintf("%v", tagValue) == value
+}
+
+// PathConstrainedStrategy may be implemented by strategies which need to restrict the paths used to reach the
+// terminators of a service. Paths to terminators which can't be reached within the constraints are not considered
+// when selecting a terminator.
+type PathConstrainedStrategy interface {
+	Strategy
+	GetPathConstraints(serviceId string) *PathConstraints
+}
@@ -77,6 +77,11 @@ func (registry *defaultRegistry) GetStrategy(name string) (Strategy, error) {
 	return result, nil
 }
 
+// GetStrategies returns the strategies which have been instantiated
+func (registry *defaultRegistry) GetStrategies() []Strategy {
+	return registry.strategies.values()
+}
+
 type copyOnWriteFactoryMap struct {
 	value *atomic.Value
 	lock  *sync.Mutex
@@ -122,3 +127,14 @@ func (m *copyOnWriteStrategyMap) get(key string) Strategy {
 	var current = m.value.Load().(map[string]Strategy)
 	return current[key]
 }
+
+func (m *copyOnWriteStrategyMap) values() []Strategy {
+	var current = m.value.Load().(map[string]Strategy)
+	var result []Strategy
+	for _, v := range current {
+		result = append(result, v)
+	}
+	return result
+}
+
+var _ StrategyLister = (*defaultRegistry)(nil)
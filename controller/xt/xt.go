@@ -26,6 +26,11 @@ type Registry interface {
 	GetStrategy(name string) (Strategy, error)
 }
 
+// StrategyLister may be implemented by registries which can list the strategies they've instantiated
+type StrategyLister interface {
+	GetStrategies() []Strategy
+}
+
 type Factory interface {
 	GetStrategyName() string
 	NewStrategy() Strategy
@@ -66,6 +71,33 @@ type Strategy interface {
 	NotifyEvent(event TerminatorEvent)
 }
 
+// AffinityStrategy is implemented by strategies which select terminators based on a key identifying the caller, so
+// that a given caller is consistently sent to the same terminator. If no affinity key is available, Select is used.
+type AffinityStrategy interface {
+	Strategy
+	SelectWithAffinity(affinityKey string, terminators []CostedTerminator) (CostedTerminator, error)
+}
+
+// CircuitCountingStrategy is implemented by strategies which track the number of active circuits per terminator.
+// Strategies maintain the counts from terminator events, but they're periodically replaced with counts taken from the
+// circuits known to the controller. This corrects any drift and restores the counts after a restart or leadership
+// change. Circuits which routers still have but the controller doesn't know about are unrouted when the routers
+// confirm their circuits, so the circuits known to the controller are the ones to count.
+type CircuitCountingStrategy interface {
+	Strategy
+	// ReconcileActiveCircuitCounts replaces the tracked counts with those returned by getCounts. getCounts is called
+	// while the strategy holds back count changes from terminator events, so that none are lost by the replacement.
+	ReconcileActiveCircuitCounts(getCounts func() map[string]uint32)
+}
+
+// MeasuringStrategy is implemented by strategies which make use of timing measurements. Measurements are taken when
+// a circuit to a terminator is created. The dial time is the time taken to create the circuit, which includes the
+// latency of the circuit path as well as the time taken to dial the terminator.
+type MeasuringStrategy interface {
+	Strategy
+	NotifyMeasurement(terminator Terminator, dialTime time.Duration)
+}
+
 type Precedence interface {
 	fmt.Stringer
 	getMinCost() uint32
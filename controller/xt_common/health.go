@@ -0,0 +1,60 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_common
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"math"
+	"time"
+)
+
+// UnhealthyCost is the dynamic cost at which a terminator is considered unhealthy. With the failure costs used by
+// NewCostVisitor, a terminator reaches it after a few consecutive dial failures, and drops back below it as failures
+// are credited back over time or dials succeed.
+const UnhealthyCost = 100
+
+// NewCostVisitor returns a CostVisitor which increases a terminator's dynamic cost on dial failures, decays the
+// failure costs over time and charges circuitCost for each active circuit, as the smartrouting strategy does
+func NewCostVisitor(circuitCost uint16) CostVisitor {
+	result := CostVisitor{
+		FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		CircuitCost:  circuitCost,
+	}
+	result.FailureCosts.CreditOverTime(5, time.Minute)
+	return result
+}
+
+// IsHealthy returns false if the terminator's dynamic cost has reached UnhealthyCost. Strategies which don't select
+// terminators by cost can use this to avoid terminators which are failing dials.
+func IsHealthy(terminator xt.Terminator) bool {
+	return xt.GlobalCosts().GetDynamicCost(terminator.GetId()) < UnhealthyCost
+}
+
+// FilterHealthy returns the healthy terminators from the given list. If none are healthy, the list is returned as is,
+// as a failing terminator is a better choice than none.
+func FilterHealthy(terminators []xt.CostedTerminator) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for _, t := range terminators {
+		if IsHealthy(t) {
+			result = append(result, t)
+		}
+	}
+	if len(result) == 0 {
+		return terminators
+	}
+	return result
+}
@@ -0,0 +1,222 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	Name = "latency"
+
+	// latencyWeight is the weight given to new dial time samples
+	latencyWeight = 0.125
+
+	// jitterWeight is the weight given to the deviation of new samples, when updating the jitter
+	jitterWeight = 0.25
+
+	// jitterFactor controls how much jitter counts against a terminator, relative to dial time
+	jitterFactor = 2
+
+	// staleAfter is how long measurements are used for. Once they're stale, the terminator is treated as unmeasured,
+	// so that it will be measured again.
+	staleAfter = 5 * time.Minute
+)
+
+/**
+The latency strategy selects the terminator with the lowest measured latency. Each time a circuit is created, the
+time taken to create the circuit is recorded against the terminator. This covers both the latency of the circuit path
+and the time taken to dial the terminator. It's smoothed using an exponentially weighted moving average, as is the
+jitter, the deviation between samples. A terminator's score is the smoothed dial time, plus a multiple of the jitter,
+plus its dynamic cost, counted in milliseconds, so that recent dial failures and a large number of active circuits push
+a terminator down the list.
+
+Terminators which haven't been measured, or whose measurements are stale, are selected first, so that they get
+measured. If there are several, one is picked at random, so that dials arriving before the first measurements are
+spread between them. Terminators which have only failed dials are selected last, as they have no latency to compare. Only
+terminators with the best available precedence are considered.
+
+The strategy can also be given path constraints, such as a maximum link latency, which are applied to the paths used
+to reach the terminators of every service using the strategy. Terminators which can't be reached within the
+constraints aren't considered.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+// NewFactoryWithPathConstraints returns a factory for latency strategies which restrict the paths used to reach
+// terminators to those satisfying the given constraints
+func NewFactoryWithPathConstraints(constraints *xt.PathConstraints) xt.Factory {
+	return &factory{pathConstraints: constraints}
+}
+
+type factory struct {
+	pathConstraints *xt.PathConstraints
+}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor:     xt_common.NewCostVisitor(2),
+		stats:           cmap.New[*terminatorStats](),
+		pathConstraints: self.pathConstraints,
+	}
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	stats           cmap.ConcurrentMap[string, *terminatorStats]
+	pathConstraints *xt.PathConstraints
+}
+
+func (self *strategy) GetPathConstraints(string) *xt.PathConstraints {
+	return self.pathConstraints
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	now := time.Now()
+	var selected xt.CostedTerminator
+	var unmeasured []xt.CostedTerminator
+	bestScore := math.Inf(1)
+
+	for _, t := range terminators {
+		stats, found := self.stats.Get(t.GetId())
+		if !found {
+			unmeasured = append(unmeasured, t)
+			continue
+		}
+
+		score, measured := stats.getScore(now)
+		if !measured {
+			unmeasured = append(unmeasured, t)
+			continue
+		}
+
+		score += float64(xt.GlobalCosts().GetDynamicCost(t.GetId())) * float64(time.Millisecond)
+		if selected == nil || score < bestScore {
+			selected = t
+			bestScore = score
+		}
+	}
+
+	if len(unmeasured) > 0 {
+		return unmeasured[rand.Intn(len(unmeasured))], nil
+	}
+
+	return selected, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) VisitDialFailed(event xt.TerminatorEvent) {
+	self.getStats(event.GetTerminator().GetId()).failed(time.Now())
+	self.CostVisitor.VisitDialFailed(event)
+}
+
+func (self *strategy) NotifyMeasurement(terminator xt.Terminator, dialTime time.Duration) {
+	self.getStats(terminator.GetId()).update(dialTime, time.Now())
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.stats.Remove(t.GetId())
+	}
+	return nil
+}
+
+func (self *strategy) getStats(terminatorId string) *terminatorStats {
+	return self.stats.Upsert(terminatorId, nil, func(exist bool, valueInMap *terminatorStats, _ *terminatorStats) *terminatorStats {
+		if exist {
+			return valueInMap
+		}
+		return &terminatorStats{}
+	})
+}
+
+type terminatorStats struct {
+	sync.Mutex
+	dialTime    float64
+	jitter      float64
+	samples     uint64
+	lastUpdated time.Time
+}
+
+func (self *terminatorStats) update(dialTime time.Duration, now time.Time) {
+	self.Lock()
+	defer self.Unlock()
+
+	if self.samples == 0 || now.Sub(self.lastUpdated) > staleAfter {
+		self.dialTime = float64(dialTime)
+		self.jitter = 0
+		self.samples = 0
+	} else {
+		deviation := math.Abs(float64(dialTime) - self.dialTime)
+		self.jitter += jitterWeight * (deviation - self.jitter)
+		self.dialTime += latencyWeight * (float64(dialTime) - self.dialTime)
+	}
+
+	self.samples++
+	self.lastUpdated = now
+}
+
+// failed records a dial failure. If the terminator has no current measurements, it's treated as having only failed
+// dials, rather than being unmeasured.
+func (self *terminatorStats) failed(now time.Time) {
+	self.Lock()
+	defer self.Unlock()
+	if self.samples == 0 || now.Sub(self.lastUpdated) > staleAfter {
+		self.samples = 0
+		self.lastUpdated = now
+	}
+}
+
+// getScore returns the terminator's score and true, if it has current measurements. If the terminator has only
+// failed dials, the score will be positive infinity. If the terminator hasn't been measured, or the measurements
+// are stale, false is returned.
+func (self *terminatorStats) getScore(now time.Time) (float64, bool) {
+	self.Lock()
+	defer self.Unlock()
+
+	if self.lastUpdated.IsZero() || now.Sub(self.lastUpdated) > staleAfter {
+		return 0, false
+	}
+
+	if self.samples == 0 {
+		return math.Inf(1), true
+	}
+
+	return self.dialTime + jitterFactor*self.jitter, true
+}
@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_latency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id string
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return xt.Precedences.Default
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+func TestLatencySelection(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	t1 := &testTerminator{id: "xt-latency-t1"}
+	t2 := &testTerminator{id: "xt-latency-t2"}
+	t3 := &testTerminator{id: "xt-latency-t3"}
+	terminators := []xt.CostedTerminator{t1, t2, t3}
+
+	// unmeasured terminators are selected first, spread between them until they've been measured
+	selections := map[xt.CostedTerminator]int{}
+	for i := 0; i < 100; i++ {
+		selected, err := s.Select(terminators)
+		req.NoError(err)
+		selections[selected]++
+	}
+	req.Len(selections, 3)
+
+	s.NotifyMeasurement(t1, 90*time.Millisecond)
+	for i := 0; i < 20; i++ {
+		selected, err := s.Select(terminators)
+		req.NoError(err)
+		req.NotEqual(t1, selected)
+	}
+
+	s.NotifyMeasurement(t2, 30*time.Millisecond)
+	s.NotifyEvent(xt.NewDialFailedEvent(t3))
+
+	// t3 has only failed, so the lowest latency terminator is selected
+	selected, err := s.Select(terminators)
+	req.NoError(err)
+	req.Equal(t2, selected)
+
+	// a jittery terminator loses out to a steady one with similar latency
+	for i := 0; i < 20; i++ {
+		s.NotifyMeasurement(t1, 30*time.Millisecond)
+		if i%2 == 0 {
+			s.NotifyMeasurement(t2, 10*time.Millisecond)
+		} else {
+			s.NotifyMeasurement(t2, 50*time.Millisecond)
+		}
+	}
+
+	selected, err = s.Select(terminators)
+	req.NoError(err)
+	req.Equal(t1, selected)
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(t2))))
+	_, found := s.stats.Get(t2.id)
+	req.False(found)
+
+	// stale failures are treated as unmeasured, so the terminator gets retried
+	selected, err = s.Select([]xt.CostedTerminator{t1, t3})
+	req.NoError(err)
+	req.Equal(t1, selected)
+
+	stats, _ := s.stats.Get(t3.id)
+	stats.lastUpdated = time.Now().Add(-2 * staleAfter)
+
+	selected, err = s.Select([]xt.CostedTerminator{t1, t3})
+	req.NoError(err)
+	req.Equal(t3, selected)
+}
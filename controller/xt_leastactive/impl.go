@@ -0,0 +1,141 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastactive
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"sync"
+)
+
+const (
+	Name = "leastactive"
+)
+
+/**
+The leastactive strategy selects the terminator with the fewest active circuits. Counts are incremented when a dial
+succeeds and decremented when a circuit is removed. The network periodically replaces the counts with the number of
+circuits it actually has for each terminator, which corrects for circuits which failed after the dial succeeded and
+restores the counts after a controller restart or leadership change.
+
+A terminator which fails every dial would never gain circuits, so unhealthy terminators are only selected when no
+healthy ones are available. Ties go to the terminator with the lower route cost.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.NewCostVisitor(0),
+		counts:      map[string]int64{},
+	}
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	lock   sync.Mutex
+	counts map[string]int64
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	var selected xt.CostedTerminator
+	var selectedCount int64
+	selectedHealthy := false
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, t := range terminators {
+		healthy := xt_common.IsHealthy(t)
+		count := self.counts[t.GetId()]
+
+		if selected == nil || (healthy && !selectedHealthy) || (healthy == selectedHealthy && count < selectedCount) {
+			selected = t
+			selectedCount = count
+			selectedHealthy = healthy
+		}
+	}
+
+	return selected, nil
+}
+
+// GetActiveCircuits returns the number of active circuits the strategy is tracking for the given terminator
+func (self *strategy) GetActiveCircuits(terminatorId string) int64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.counts[terminatorId]
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(self)
+}
+
+func (self *strategy) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	self.counts[event.GetTerminator().GetId()]++
+	self.lock.Unlock()
+
+	self.CostVisitor.VisitDialSucceeded(event)
+}
+
+func (self *strategy) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	if terminatorId := event.GetTerminator().GetId(); self.counts[terminatorId] > 0 {
+		self.counts[terminatorId]--
+	}
+	self.lock.Unlock()
+
+	self.CostVisitor.VisitCircuitRemoved(event)
+}
+
+// ReconcileActiveCircuitCounts replaces the tracked counts. The counts are gathered and stored under the same lock
+// used when circuits are added and removed, so changes made while reconciling aren't overwritten.
+func (self *strategy) ReconcileActiveCircuitCounts(getCounts func() map[string]uint32) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	counts := map[string]int64{}
+	for terminatorId, count := range getCounts() {
+		counts[terminatorId] = int64(count)
+	}
+	self.counts = counts
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		delete(self.counts, t.GetId())
+	}
+	return nil
+}
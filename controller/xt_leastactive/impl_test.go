@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_leastactive
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id string
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return xt.Precedences.Default
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+func TestLeastActiveSelection(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+	t1 := &testTerminator{id: "xt-leastactive-t1"}
+	t2 := &testTerminator{id: "xt-leastactive-t2"}
+	t3 := &testTerminator{id: "xt-leastactive-t3"}
+	terminators := []xt.CostedTerminator{t1, t2, t3}
+
+	// circuits spread evenly across terminators
+	for i := 0; i < 9; i++ {
+		selected, err := s.Select(terminators)
+		req.NoError(err)
+		s.NotifyEvent(xt.NewDialSucceeded(selected))
+	}
+	req.Equal(int64(3), s.GetActiveCircuits(t1.id))
+	req.Equal(int64(3), s.GetActiveCircuits(t2.id))
+	req.Equal(int64(3), s.GetActiveCircuits(t3.id))
+
+	s.NotifyEvent(xt.NewCircuitRemoved(t2))
+	selected, err := s.Select(terminators)
+	req.NoError(err)
+	req.Equal(t2, selected)
+
+	// counts don't go negative
+	for i := 0; i < 5; i++ {
+		s.NotifyEvent(xt.NewCircuitRemoved(t3))
+	}
+	req.Equal(int64(0), s.GetActiveCircuits(t3.id))
+
+	// reconciled counts replace the tracked counts, terminators without circuits are reset
+	s.ReconcileActiveCircuitCounts(func() map[string]uint32 {
+		return map[string]uint32{t2.id: 1, t3.id: 4}
+	})
+	req.Equal(int64(0), s.GetActiveCircuits(t1.id))
+	req.Equal(int64(1), s.GetActiveCircuits(t2.id))
+	req.Equal(int64(4), s.GetActiveCircuits(t3.id))
+
+	// circuits added while reconciling aren't overwritten by the reconciled counts
+	added := make(chan struct{})
+	s.ReconcileActiveCircuitCounts(func() map[string]uint32 {
+		go func() {
+			s.NotifyEvent(xt.NewDialSucceeded(t3))
+			close(added)
+		}()
+		return map[string]uint32{t2.id: 1, t3.id: 4}
+	})
+	<-added
+	req.Equal(int64(5), s.GetActiveCircuits(t3.id))
+	s.NotifyEvent(xt.NewCircuitRemoved(t3))
+
+	// terminators which keep failing are skipped, even though they have the fewest circuits
+	for i := 0; i < 10; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(t1))
+	}
+	selected, err = s.Select(terminators)
+	req.NoError(err)
+	req.Equal(t2, selected)
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(t1))))
+	xt.GlobalCosts().ClearCost(t1.id)
+	_, found := s.counts[t1.id]
+	req.False(found)
+}
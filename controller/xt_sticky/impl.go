@@ -0,0 +1,116 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/controller/xt_common"
+	"hash/fnv"
+)
+
+const (
+	Name = "sticky"
+)
+
+/**
+The sticky strategy consistently maps callers to the same terminator, using rendezvous hashing on the caller's
+affinity key. Each terminator is scored by hashing the affinity key together with the terminator id, and the terminator
+with the highest score is selected. When a terminator is added, it only takes over the keys for which it has the
+highest score, and when a terminator is removed, only the keys which mapped to it are moved. No per-key state is
+kept, so mappings are the same across controller restarts.
+
+Unhealthy terminators are left out of the hashing, so callers mapped to a terminator which keeps failing dials are
+sent elsewhere until it recovers, and then return to it. If no affinity key is available, the lowest cost terminator is
+selected.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	return &strategy{
+		CostVisitor: xt_common.NewCostVisitor(0),
+	}
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return terminators[0], nil
+}
+
+func (self *strategy) SelectWithAffinity(affinityKey string, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	terminators = xt_common.FilterHealthy(terminators)
+
+	var selected xt.CostedTerminator
+	var maxScore uint64
+	for _, t := range terminators {
+		score := rendezvousScore(affinityKey, t.GetId())
+		if selected == nil || score > maxScore {
+			selected = t
+			maxScore = score
+		}
+	}
+
+	return selected, nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+// HandleTerminatorChange only needs to clean up failure tracking. Since rendezvous hashing doesn't keep any
+// state about key assignments, added and removed terminators only affect the keys which map to them.
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+	return nil
+}
+
+func rendezvousScore(affinityKey, terminatorId string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(affinityKey))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(terminatorId))
+	return mix64(h.Sum64())
+}
+
+// mix64 is the splitmix64 finalizer, used to spread fnv's output, which doesn't vary enough in the high bits for
+// similar inputs
+func mix64(v uint64) uint64 {
+	v ^= v >> 30
+	v *= 0xbf58476d1ce4e5b9
+	v ^= v >> 27
+	v *= 0x94d049bb133111eb
+	v ^= v >> 31
+	return v
+}
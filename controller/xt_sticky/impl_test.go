@@ -0,0 +1,124 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_sticky
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminator struct {
+	xt.Terminator
+	id string
+}
+
+func (self *testTerminator) GetId() string {
+	return self.id
+}
+
+func (self *testTerminator) GetPrecedence() xt.Precedence {
+	return xt.Precedences.Default
+}
+
+func (self *testTerminator) GetRouteCost() uint32 {
+	return 0
+}
+
+func TestStickySelection(t *testing.T) {
+	req := require.New(t)
+
+	s := NewFactory().NewStrategy().(*strategy)
+
+	var terminators []xt.CostedTerminator
+	for i := 0; i < 5; i++ {
+		terminators = append(terminators, &testTerminator{id: fmt.Sprintf("xt-sticky-%d", i)})
+	}
+
+	var keys []string
+	for i := 0; i < 1000; i++ {
+		keys = append(keys, fmt.Sprintf("client-%d", i))
+	}
+
+	selectAll := func(list []xt.CostedTerminator) map[string]string {
+		result := map[string]string{}
+		for _, key := range keys {
+			selected, err := s.SelectWithAffinity(key, list)
+			req.NoError(err)
+			result[key] = selected.GetId()
+		}
+		return result
+	}
+
+	initial := selectAll(terminators)
+
+	// selection is stable, regardless of terminator order
+	reversed := append([]xt.CostedTerminator{}, terminators...)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	req.Equal(initial, selectAll(reversed))
+
+	counts := map[string]int{}
+	for _, id := range initial {
+		counts[id]++
+	}
+	req.Equal(5, len(counts))
+	for _, count := range counts {
+		req.True(count > 100, "expected keys to be spread across terminators: %v", counts)
+	}
+
+	// removing a terminator only moves the keys which mapped to it
+	removedId := terminators[2].GetId()
+	afterRemove := selectAll(append(append([]xt.CostedTerminator{}, terminators[:2]...), terminators[3:]...))
+	for key, id := range initial {
+		if id != removedId {
+			req.Equal(id, afterRemove[key])
+		}
+	}
+
+	// adding a terminator only moves keys to the new terminator
+	added := &testTerminator{id: "xt-sticky-added"}
+	afterAdd := selectAll(append(append([]xt.CostedTerminator{}, terminators...), added))
+	moved := 0
+	for key, id := range initial {
+		if afterAdd[key] != id {
+			req.Equal(added.id, afterAdd[key])
+			moved++
+		}
+	}
+	req.True(moved > 0 && moved < 400, "moved: %v", moved)
+
+	// keys mapped to a failing terminator are moved while it's unhealthy
+	for i := 0; i < 10; i++ {
+		s.NotifyEvent(xt.NewDialFailedEvent(terminators[0]))
+	}
+	afterFailures := selectAll(terminators)
+	for key, id := range initial {
+		if id == terminators[0].GetId() {
+			req.NotEqual(id, afterFailures[key])
+		} else {
+			req.Equal(id, afterFailures[key])
+		}
+	}
+
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("svc", nil, nil, nil, xt.TList(terminators[0]))))
+	xt.GlobalCosts().ClearCost(terminators[0].GetId())
+	req.Equal(initial, selectAll(terminators))
+}
@@ -31,6 +31,8 @@ func (self *Router) RegisterDefaultAgentOps(debugEnabled bool) {
 		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterDebugDumpLinksRequestType), self.agentOpsDumpLinks)
 		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterQuiesce), self.agentOpQuiesceRouter)
 		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterDequiesce), self.agentOpDequiesceRouter)
+		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterStartMaintenance), self.agentOpStartMaintenance)
+		binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterEndMaintenance), self.agentOpEndMaintenance)
 
 		if debugEnabled {
 			binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RouterDebugUpdateRouteRequestType), self.agentOpUpdateRoute)
@@ -177,6 +179,34 @@ func (self *Router) agentOpDequiesceRouter(m *channel.Message, ch channel.Channe
 	handler_common.SendOpResult(m, ch, "dequiesce", result.Message+"\n", result.Success)
 }
 
+func (self *Router) agentOpStartMaintenance(m *channel.Message, ch channel.Channel) {
+	self.forwardMaintenanceRequest(m, ch, "maintenance.start", ctrl_pb.ContentType_StartRouterMaintenanceRequestType)
+}
+
+func (self *Router) agentOpEndMaintenance(m *channel.Message, ch channel.Channel) {
+	self.forwardMaintenanceRequest(m, ch, "maintenance.end", ctrl_pb.ContentType_EndRouterMaintenanceRequestType)
+}
+
+// forwardMaintenanceRequest sends the maintenance request to a controller. Maintenance state is shared by all
+// controllers, and each controller reroutes the circuits it owns
+func (self *Router) forwardMaintenanceRequest(m *channel.Message, ch channel.Channel, op string, contentType ctrl_pb.ContentType) {
+	ctrlCh := self.ctrls.AnyValidCtrlChannel()
+	if ctrlCh == nil {
+		handler_common.SendOpResult(m, ch, op, "unable to reach controller", false)
+		return
+	}
+
+	msg := channel.NewMessage(int32(contentType), nil)
+	resp, err := msg.WithTimeout(5 * time.Second).SendForReply(ctrlCh)
+	if err != nil {
+		handler_common.SendOpResult(m, ch, op, fmt.Sprintf("error in controller communications: %v", err.Error()), false)
+		return
+	}
+
+	result := channel.UnmarshalResult(resp)
+	handler_common.SendOpResult(m, ch, op, result.Message+"\n", result.Success)
+}
+
 func (self *Router) agentOpDumpForwarderTables(m *channel.Message, ch channel.Channel) {
 	tables := self.forwarder.Debug()
 	handler_common.SendOpResult(m, ch, "dump.forwarder_tables", tables, true)
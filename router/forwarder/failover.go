@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+)
+
+// failoverLink switches single path circuits which were forwarding over the given link to their backup next hop, if
+// the controller installed one and it's available. The switched circuits are returned, grouped by the id of the
+// controller which owns them, so the switch can be reported back.
+func (forwarder *Forwarder) failoverLink(linkAddr xgress.Address) map[string][]string {
+	result := map[string][]string{}
+	for entry := range forwarder.circuits.circuits.IterBuffered() {
+		ft := entry.Val
+		if ft.getMultipathMode() != ctrl_pb.MultipathMode_SinglePath {
+			continue
+		}
+		if ft.promoteSecondaries(linkAddr, forwarder.HasDestination) {
+			result[ft.ctrlId] = append(result[ft.ctrlId], entry.Key)
+		}
+	}
+
+	for ctrlId, circuitIds := range result {
+		pfxlog.Logger().WithField("linkId", linkAddr).
+			WithField("ctrlId", ctrlId).
+			WithField("circuitCount", len(circuitIds)).
+			Info("link closed, switched circuits to backup next hop")
+	}
+
+	return result
+}
+
+// promoteSecondaries makes the secondary destination the primary destination for every source address which was
+// forwarding to dst. Returns true if any source addresses were switched.
+func (ft *forwardTable) promoteSecondaries(dst xgress.Address, isAvailable func(xgress.Address) bool) bool {
+	switched := false
+	for entry := range ft.destinations.IterBuffered() {
+		if entry.Val != string(dst) {
+			continue
+		}
+		if secondary, found := ft.secondaries.Get(entry.Key); found && isAvailable(xgress.Address(secondary)) {
+			ft.destinations.Set(entry.Key, secondary)
+			ft.secondaries.Remove(entry.Key)
+			switched = true
+		}
+	}
+	return switched
+}
@@ -69,6 +69,24 @@ func (self *Faulter) notifyInvalidLink(linkId string) {
 	})
 }
 
+// notifyCircuitFailover lets controllers know which of their circuits were switched to a backup next hop when the
+// given link closed
+func (self *Faulter) notifyCircuitFailover(linkId string, circuitIdsByCtrl map[string][]string) {
+	for ctrlId, circuitIds := range circuitIdsByCtrl {
+		log := pfxlog.Logger().WithField("ctrlId", ctrlId).WithField("linkId", linkId)
+		ch := self.ctrls.GetCtrlChannel(ctrlId)
+		if ch == nil {
+			log.Error("no control channel for controller, unable to report circuit failover")
+			continue
+		}
+
+		failover := &ctrl_pb.CircuitFailover{LinkId: linkId, CircuitIds: circuitIds}
+		if err := protobufs.MarshalTyped(failover).WithTimeout(self.ctrls.DefaultRequestTimeout()).Send(ch); err != nil {
+			log.WithError(err).Error("failed to report circuit failover")
+		}
+	}
+}
+
 func (self *Faulter) run() {
 	logrus.Infof("started")
 	defer logrus.Errorf("exited")
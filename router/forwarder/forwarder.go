@@ -34,6 +34,7 @@ import (
 type Forwarder struct {
 	circuits        *circuitTable
 	destinations    *destinationTable
+	linkQuality     *linkQualityTable
 	faulter         *Faulter
 	scanner         *Scanner
 	metricsRegistry metrics.UsageRegistry
@@ -62,6 +63,7 @@ func NewForwarder(metricsRegistry metrics.UsageRegistry, faulter *Faulter, scann
 	f := &Forwarder{
 		circuits:        newCircuitTable(),
 		destinations:    newDestinationTable(),
+		linkQuality:     newLinkQualityTable(metricsRegistry),
 		faulter:         faulter,
 		scanner:         scanner,
 		metricsRegistry: metricsRegistry,
@@ -113,11 +115,16 @@ func (forwarder *Forwarder) RegisterLink(link xlink.LinkDestination) error {
 	if !forwarder.destinations.addDestinationIfAbsent(xgress.Address(link.Id()), link) {
 		return errors.Errorf("unable to register link %v as it is already registered", link.Id())
 	}
+	forwarder.linkQuality.linkRegistered(link.Id())
 	return nil
 }
 
 func (forwarder *Forwarder) UnregisterLink(link xlink.LinkDestination) {
 	forwarder.destinations.removeDestination(xgress.Address(link.Id()))
+	forwarder.linkQuality.linkUnregistered(link.Id())
+	if failedOver := forwarder.failoverLink(xgress.Address(link.Id())); len(failedOver) > 0 {
+		forwarder.faulter.notifyCircuitFailover(link.Id(), failedOver)
+	}
 }
 
 func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
@@ -128,6 +135,9 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 	} else {
 		circuitFt = newForwardTable(ctrlId)
 	}
+	if route.Backup {
+		circuitFt.clearSecondaries()
+	}
 	for _, forward := range route.Forwards {
 		if !forwarder.HasDestination(xgress.Address(forward.DstAddress)) {
 			if forward.DstType == ctrl_pb.DestType_Link {
@@ -139,7 +149,20 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 			}
 			// It's an ingress destination, which isn't established until after routing has completed
 		}
-		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		if forward.Secondary {
+			circuitFt.setSecondaryForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		} else {
+			circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		}
+	}
+	if route.MultipathMode != ctrl_pb.MultipathMode_SinglePath {
+		circuitFt.setMultipathMode(route.MultipathMode)
+	}
+	if route.QosClass != ctrl_pb.QosClass_QosDefault {
+		circuitFt.setQosClass(route.QosClass)
+	}
+	if route.Datagram {
+		circuitFt.setDatagram(true)
 	}
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
@@ -171,16 +194,18 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, markActive); found {
+		// payloads arriving over a link already carry the class, but payloads from local xgress don't
+		if qosClass := forwardTable.getQosClass(); qosClass != ctrl_pb.QosClass_QosDefault {
+			payload.QosClass = xgress.QosClass(qosClass)
+		}
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendPayload(payload); err != nil {
-					return err
-				}
-				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
+			err := forwarder.sendPayload(forwardTable, srcAddr, dstAddr, payload, markActive)
+			if err != nil && forwardTable.isDatagram() && payload.IsDatagramFlagSet() {
+				// datagrams may be lost, so failing to deliver one isn't treated as a forwarding fault
+				log.WithFields(payload.GetLoggerFields()).WithError(err).Debug("dropped datagram")
 				return nil
-			} else {
-				return errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
 			}
+			return err
 		} else {
 			return errors.Errorf("cannot forward payload, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
@@ -189,13 +214,95 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	}
 }
 
+func (forwarder *Forwarder) sendPayload(forwardTable *forwardTable, srcAddr, dstAddr xgress.Address, payload *xgress.Payload, markActive bool) error {
+	if secondaryAddr, found := forwardTable.getSecondaryForwardAddress(srcAddr); found && secondaryAddr != dstAddr {
+		return forwarder.forwardMultipathPayload(forwardTable.getMultipathMode(), dstAddr, secondaryAddr, payload, markActive)
+	}
+	if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+		if err := dst.SendPayload(payload); err != nil {
+			return err
+		}
+		if !markActive {
+			forwarder.linkQuality.markRetransmit(dst)
+		}
+		pfxlog.ContextLogger(string(srcAddr)).WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
+		return nil
+	}
+	return errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", payload.GetCircuitId(), srcAddr, dstAddr)
+}
+
+// forwardMultipathPayload sends a payload over one or both of the paths of a multipath circuit. When striping,
+// payloads alternate between paths by sequence number and retransmits are sent over the path not used for the
+// original transmission. If one of the paths is unavailable, the other is used. Single path circuits with a backup next
+// hop always use the primary destination while it's available.
+func (forwarder *Forwarder) forwardMultipathPayload(mode ctrl_pb.MultipathMode, dstAddr, secondaryAddr xgress.Address, payload *xgress.Payload, markActive bool) error {
+	circuitId := payload.GetCircuitId()
+	primary, primaryFound := forwarder.destinations.getDestination(dstAddr)
+	secondary, secondaryFound := forwarder.destinations.getDestination(secondaryAddr)
+
+	if !primaryFound && !secondaryFound {
+		return errors.Errorf("cannot forward payload, no destination for circuit=%v dst=%v secondary=%v", circuitId, dstAddr, secondaryAddr)
+	}
+
+	if !secondaryFound {
+		return primary.SendPayload(payload)
+	}
+
+	if !primaryFound {
+		return secondary.SendPayload(payload)
+	}
+
+	if mode == ctrl_pb.MultipathMode_Duplicate {
+		primaryErr := primary.SendPayload(payload)
+		secondaryErr := secondary.SendPayload(payload)
+		if primaryErr != nil && secondaryErr != nil {
+			return primaryErr
+		}
+		return nil
+	}
+
+	useSecondary := false
+	if mode == ctrl_pb.MultipathMode_Stripe {
+		useSecondary = payload.GetSequence()%2 != 0
+		if !markActive {
+			useSecondary = !useSecondary
+		}
+	}
+
+	if useSecondary {
+		primary, secondary = secondary, primary
+	}
+
+	if err := primary.SendPayload(payload); err != nil {
+		return secondary.SendPayload(payload)
+	}
+	if !markActive {
+		forwarder.linkQuality.markRetransmit(primary)
+	}
+	return nil
+}
+
+// getAvailableDestination returns the destination for the given destination address. If it's not available and the
+// circuit has a secondary destination for the source address, the secondary destination is returned instead.
+func (forwarder *Forwarder) getAvailableDestination(ft *forwardTable, srcAddr, dstAddr xgress.Address) (xgress.Address, Destination, bool) {
+	if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+		return dstAddr, dst, true
+	}
+	if secondaryAddr, found := ft.getSecondaryForwardAddress(srcAddr); found {
+		if dst, found := forwarder.destinations.getDestination(secondaryAddr); found {
+			return secondaryAddr, dst, true
+		}
+	}
+	return dstAddr, nil, false
+}
+
 func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, acknowledgement *xgress.Acknowledgement) error {
 	log := pfxlog.ContextLogger(string(srcAddr))
 
 	circuitId := acknowledgement.CircuitId
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if dstAddr, dst, found := forwarder.getAvailableDestination(forwardTable, srcAddr, dstAddr); found {
 				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
 					return err
 				}
@@ -223,7 +330,7 @@ func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgre
 
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if dstAddr, dst, found := forwarder.getAvailableDestination(forwardTable, srcAddr, dstAddr); found {
 				if control.IsTypeTraceRoute() {
 					hops := control.DecrementAndGetHop()
 					if hops == 0 {
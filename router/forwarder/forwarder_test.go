@@ -0,0 +1,303 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/common/inspect"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+type testDestination struct {
+	payloads []*xgress.Payload
+	acks     []*xgress.Acknowledgement
+}
+
+func (self *testDestination) SendPayload(payload *xgress.Payload) error {
+	self.payloads = append(self.payloads, payload)
+	return nil
+}
+
+func (self *testDestination) SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error {
+	self.acks = append(self.acks, acknowledgement)
+	return nil
+}
+
+func (self *testDestination) SendControl(*xgress.Control) error {
+	return nil
+}
+
+func (self *testDestination) InspectCircuit(*inspect.CircuitInspectDetail) {
+}
+
+func newMultipathTestForwarder(t *testing.T, mode ctrl_pb.MultipathMode) (*Forwarder, *testDestination, *testDestination) {
+	forwarder := &Forwarder{
+		circuits:     newCircuitTable(),
+		destinations: newDestinationTable(),
+		Options:      DefaultOptions(),
+	}
+
+	primary := &testDestination{}
+	secondary := &testDestination{}
+	forwarder.destinations.addDestination("l0", primary)
+	forwarder.destinations.addDestination("l1", secondary)
+
+	err := forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l0", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+		MultipathMode: mode,
+	})
+	require.NoError(t, err)
+
+	err = forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link, Secondary: true},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+		MultipathMode: mode,
+	})
+	require.NoError(t, err)
+
+	return forwarder, primary, secondary
+}
+
+func newTestPayload(seq int32) *xgress.Payload {
+	return &xgress.Payload{
+		Header: xgress.Header{
+			CircuitId: "c0",
+		},
+		Sequence: seq,
+	}
+}
+
+func TestMultipathStripe(t *testing.T) {
+	req := require.New(t)
+	forwarder, primary, secondary := newMultipathTestForwarder(t, ctrl_pb.MultipathMode_Stripe)
+
+	for i := int32(0); i < 10; i++ {
+		req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(i)))
+	}
+	req.Equal(5, len(primary.payloads))
+	req.Equal(5, len(secondary.payloads))
+
+	// retransmits go over the path not used for the original transmission
+	req.NoError(forwarder.RetransmitPayload("ingress", newTestPayload(0)))
+	req.Equal(6, len(secondary.payloads))
+
+	// acks always use the primary path while it's available
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c0", xgress.Initiator)))
+	req.Equal(1, len(primary.acks))
+	req.Equal(0, len(secondary.acks))
+
+	// if the primary path goes away, everything uses the secondary path
+	forwarder.destinations.removeDestination("l0")
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(10)))
+	req.Equal(7, len(secondary.payloads))
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c0", xgress.Initiator)))
+	req.Equal(1, len(secondary.acks))
+}
+
+func TestMultipathDuplicate(t *testing.T) {
+	req := require.New(t)
+	forwarder, primary, secondary := newMultipathTestForwarder(t, ctrl_pb.MultipathMode_Duplicate)
+
+	for i := int32(0); i < 10; i++ {
+		req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(i)))
+	}
+	req.Equal(10, len(primary.payloads))
+	req.Equal(10, len(secondary.payloads))
+
+	// return traffic from either link goes to the same place
+	ingress := &testDestination{}
+	forwarder.destinations.addDestination("ingress", ingress)
+	req.NoError(forwarder.ForwardPayload("l0", newTestPayload(0)))
+	req.NoError(forwarder.ForwardPayload("l1", newTestPayload(0)))
+	req.Equal(2, len(ingress.payloads))
+}
+
+type testLinkDestination struct {
+	testDestination
+	id string
+}
+
+func (self *testLinkDestination) Id() string {
+	return self.id
+}
+
+func TestLinkQualityAttribution(t *testing.T) {
+	req := require.New(t)
+
+	registry := metrics.NewRegistry("test", nil)
+	forwarder := &Forwarder{
+		circuits:     newCircuitTable(),
+		destinations: newDestinationTable(),
+		linkQuality:  newLinkQualityTable(registry),
+		Options:      DefaultOptions(),
+	}
+
+	link := &testLinkDestination{id: "l0"}
+	req.NoError(forwarder.RegisterLink(link))
+
+	err := forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l0", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	})
+	req.NoError(err)
+
+	meterCount := func(name string) int64 {
+		if msg := registry.Poll(); msg != nil {
+			if meter, found := msg.Meters[name]; found {
+				return meter.Count
+			}
+		}
+		return -1
+	}
+
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(0)))
+	req.Equal(int64(0), meterCount("link.l0.retransmits"))
+
+	req.NoError(forwarder.RetransmitPayload("ingress", newTestPayload(0)))
+	req.NoError(forwarder.RetransmitPayload("ingress", newTestPayload(0)))
+	req.Equal(int64(2), meterCount("link.l0.retransmits"))
+	req.Equal(3, len(link.payloads))
+
+	forwarder.ReportDuplicateAck("ingress", "c0")
+	req.Equal(int64(1), meterCount("link.l0.dup_acks"))
+
+	// unknown circuits are ignored
+	forwarder.ReportDuplicateAck("ingress", "c1")
+	req.Equal(int64(1), meterCount("link.l0.dup_acks"))
+
+	forwarder.UnregisterLink(link)
+	req.Equal(int64(-1), meterCount("link.l0.retransmits"))
+}
+
+func TestBackupFailover(t *testing.T) {
+	req := require.New(t)
+
+	forwarder := &Forwarder{
+		circuits:     newCircuitTable(),
+		destinations: newDestinationTable(),
+		Options:      DefaultOptions(),
+	}
+
+	primary := &testDestination{}
+	backup := &testDestination{}
+	forwarder.destinations.addDestination("l0", primary)
+	forwarder.destinations.addDestination("l1", backup)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l0", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	backupRoute := &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link, Secondary: true},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+		Backup: true,
+	}
+	req.NoError(forwarder.Route("ctrl", backupRoute))
+
+	// payloads and retransmits use the primary next hop while it's available
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(0)))
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(1)))
+	req.NoError(forwarder.RetransmitPayload("ingress", newTestPayload(1)))
+	req.Equal(3, len(primary.payloads))
+	req.Equal(0, len(backup.payloads))
+
+	// an empty backup route removes the installed backup next hops
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{CircuitId: "c0", Backup: true}))
+	ft, _ := forwarder.circuits.getForwardTable("c0", false)
+	_, found := ft.getSecondaryForwardAddress("ingress")
+	req.False(found)
+
+	// circuits without a backup next hop aren't switched
+	req.Empty(forwarder.failoverLink("l0"))
+
+	req.NoError(forwarder.Route("ctrl", backupRoute))
+	forwarder.destinations.removeDestination("l0")
+	req.Equal(map[string][]string{"ctrl": {"c0"}}, forwarder.failoverLink("l0"))
+
+	dstAddr, _ := ft.getForwardAddress("ingress")
+	req.Equal(xgress.Address("l1"), dstAddr)
+	_, found = ft.getSecondaryForwardAddress("ingress")
+	req.False(found)
+
+	req.NoError(forwarder.ForwardPayload("ingress", newTestPayload(2)))
+	req.Equal(1, len(backup.payloads))
+}
+
+func TestDatagramForwarding(t *testing.T) {
+	req := require.New(t)
+
+	forwarder := &Forwarder{
+		circuits:     newCircuitTable(),
+		destinations: newDestinationTable(),
+		Options:      DefaultOptions(),
+	}
+
+	link := &testDestination{}
+	forwarder.destinations.addDestination("l0", link)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c0",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l0", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l0", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+		Datagram: true,
+	}))
+
+	// a reroute which doesn't carry the mode leaves the circuit in datagram mode
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{CircuitId: "c0"}))
+	ft, _ := forwarder.circuits.getForwardTable("c0", false)
+	req.True(ft.isDatagram())
+
+	datagram := newTestPayload(0)
+	datagram.Flags = uint32(xgress.PayloadFlagDatagram)
+	req.NoError(forwarder.ForwardPayload("ingress", datagram))
+	req.Equal(1, len(link.payloads))
+
+	// datagrams which can't be delivered are dropped, rather than reported as forwarding faults
+	req.NoError(forwarder.ForwardPayload("l0", datagram))
+
+	// reliable payloads of the circuit, such as circuit end, still report failures
+	req.Error(forwarder.ForwardPayload("l0", newTestPayload(1)))
+
+	// as do datagrams for unknown circuits
+	unknown := newTestPayload(0)
+	unknown.CircuitId = "c1"
+	unknown.Flags = uint32(xgress.PayloadFlagDatagram)
+	req.Error(forwarder.ForwardPayload("ingress", unknown))
+}
@@ -0,0 +1,108 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/fabric/router/xlink"
+	"github.com/openziti/metrics"
+	"github.com/orcaman/concurrent-map/v2"
+)
+
+// linkQualityMetrics tracks the retransmits and duplicate acks attributed to a link. They're reported to the
+// controller along with the other link metrics, where they feed into the link cost.
+type linkQualityMetrics struct {
+	retransmits   metrics.Meter
+	duplicateAcks metrics.Meter
+}
+
+func (self *linkQualityMetrics) dispose() {
+	self.retransmits.Dispose()
+	self.duplicateAcks.Dispose()
+}
+
+// linkQualityTable holds the quality metrics for registered links. Retransmits and duplicate acks are detected by
+// the xgress instances at the ends of a circuit, so they're attributed to the first hop link of the circuit.
+type linkQualityTable struct {
+	registry metrics.Registry
+	links    cmap.ConcurrentMap[string, *linkQualityMetrics]
+}
+
+func newLinkQualityTable(registry metrics.Registry) *linkQualityTable {
+	return &linkQualityTable{
+		registry: registry,
+		links:    cmap.New[*linkQualityMetrics](),
+	}
+}
+
+func (self *linkQualityTable) linkRegistered(linkId string) {
+	if self == nil {
+		return
+	}
+	self.links.Upsert(linkId, nil, func(exist bool, valueInMap *linkQualityMetrics, _ *linkQualityMetrics) *linkQualityMetrics {
+		if exist {
+			return valueInMap
+		}
+		return &linkQualityMetrics{
+			retransmits:   self.registry.Meter("link." + linkId + ".retransmits"),
+			duplicateAcks: self.registry.Meter("link." + linkId + ".dup_acks"),
+		}
+	})
+}
+
+func (self *linkQualityTable) linkUnregistered(linkId string) {
+	if self == nil {
+		return
+	}
+	if linkMetrics, found := self.links.Pop(linkId); found {
+		linkMetrics.dispose()
+	}
+}
+
+func (self *linkQualityTable) get(dst Destination) (*linkQualityMetrics, bool) {
+	if self == nil {
+		return nil, false
+	}
+	if link, ok := dst.(xlink.LinkDestination); ok {
+		return self.links.Get(link.Id())
+	}
+	return nil, false
+}
+
+func (self *linkQualityTable) markRetransmit(dst Destination) {
+	if linkMetrics, found := self.get(dst); found {
+		linkMetrics.retransmits.Mark(1)
+	}
+}
+
+func (self *linkQualityTable) markDuplicateAck(dst Destination) {
+	if linkMetrics, found := self.get(dst); found {
+		linkMetrics.duplicateAcks.Mark(1)
+	}
+}
+
+// ReportDuplicateAck attributes a duplicate ack, received by the xgress with the given address, to the link the
+// xgress sends its payloads over
+func (forwarder *Forwarder) ReportDuplicateAck(srcAddr xgress.Address, circuitId string) {
+	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, false); found {
+		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+				forwarder.linkQuality.markDuplicateAck(dst)
+			}
+		}
+	}
+}
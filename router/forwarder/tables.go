@@ -18,6 +18,7 @@ package forwarder
 
 import (
 	"fmt"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/orcaman/concurrent-map/v2"
 	"reflect"
@@ -65,17 +66,23 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. Multipath circuits may also have
+// a secondary destination for a source address.
 type forwardTable struct {
-	ctrlId       string
-	last         int64
-	destinations cmap.ConcurrentMap[string, string]
+	ctrlId        string
+	last          int64
+	multipathMode int32
+	qosClass      int32
+	datagram      atomic.Bool
+	destinations  cmap.ConcurrentMap[string, string]
+	secondaries   cmap.ConcurrentMap[string, string]
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
 	return &forwardTable{
 		ctrlId:       ctrlId,
 		destinations: cmap.New[string](),
+		secondaries:  cmap.New[string](),
 	}
 }
 
@@ -90,11 +97,53 @@ func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, b
 	return "", false
 }
 
+func (ft *forwardTable) setSecondaryForwardAddress(src, dst xgress.Address) {
+	ft.secondaries.Set(string(src), string(dst))
+}
+
+func (ft *forwardTable) getSecondaryForwardAddress(src xgress.Address) (xgress.Address, bool) {
+	if dst, found := ft.secondaries.Get(string(src)); found {
+		return xgress.Address(dst), true
+	}
+	return "", false
+}
+
+func (ft *forwardTable) clearSecondaries() {
+	ft.secondaries.Clear()
+}
+
+func (ft *forwardTable) setMultipathMode(mode ctrl_pb.MultipathMode) {
+	atomic.StoreInt32(&ft.multipathMode, int32(mode))
+}
+
+func (ft *forwardTable) getMultipathMode() ctrl_pb.MultipathMode {
+	return ctrl_pb.MultipathMode(atomic.LoadInt32(&ft.multipathMode))
+}
+
+func (ft *forwardTable) setQosClass(class ctrl_pb.QosClass) {
+	atomic.StoreInt32(&ft.qosClass, int32(class))
+}
+
+func (ft *forwardTable) getQosClass() ctrl_pb.QosClass {
+	return ctrl_pb.QosClass(atomic.LoadInt32(&ft.qosClass))
+}
+
+func (ft *forwardTable) setDatagram(datagram bool) {
+	ft.datagram.Store(datagram)
+}
+
+func (ft *forwardTable) isDatagram() bool {
+	return ft.datagram.Load()
+}
+
 func (ft *forwardTable) debug() string {
 	out := ""
 	for i := range ft.destinations.IterBuffered() {
 		out += fmt.Sprintf("\t\t@/%s -> @/%s\n", i.Key, i.Val)
 	}
+	for i := range ft.secondaries.IterBuffered() {
+		out += fmt.Sprintf("\t\t@/%s -> @/%s (secondary)\n", i.Key, i.Val)
+	}
 	return out
 }
 
@@ -36,6 +36,7 @@ type bindHandler struct {
 	forwarder          *forwarder.Forwarder
 	xgDialerPool       goroutines.Pool
 	ctrlAddressUpdater CtrlAddressUpdater
+	maintenance        *maintenanceTracker
 }
 
 func NewBindHandler(routerEnv env.RouterEnv, forwarder *forwarder.Forwarder, ctrlAddressUpdater CtrlAddressUpdater) (channel.BindHandler, error) {
@@ -62,6 +63,7 @@ func NewBindHandler(routerEnv env.RouterEnv, forwarder *forwarder.Forwarder, ctr
 		forwarder:          forwarder,
 		xgDialerPool:       xgDialerPool,
 		ctrlAddressUpdater: ctrlAddressUpdater,
+		maintenance:        newMaintenanceTracker(),
 	}, nil
 }
 
@@ -70,12 +72,14 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newDialHandler(self.env))
 	binding.AddTypedReceiveHandler(newRouteHandler(binding.GetChannel(), self.env, self.forwarder, self.xgDialerPool))
 	binding.AddTypedReceiveHandler(newValidateTerminatorsHandler(self.env))
+	binding.AddTypedReceiveHandler(newTerminatorHealthCheckHandler(self.env))
 	binding.AddTypedReceiveHandler(newUnrouteHandler(self.forwarder))
 	binding.AddTypedReceiveHandler(newTraceHandler(self.env.GetRouterId(), self.forwarder.TraceController(), binding.GetChannel()))
 	binding.AddTypedReceiveHandler(newInspectHandler(self.env, self.forwarder))
 	binding.AddTypedReceiveHandler(newSettingsHandler(self.ctrlAddressUpdater))
 	binding.AddTypedReceiveHandler(newFaultHandler(self.env.GetXlinkRegistry()))
 	binding.AddTypedReceiveHandler(newUpdateCtrlAddressesHandler(self.ctrlAddressUpdater))
+	binding.AddTypedReceiveHandler(newMaintenanceStatusHandler(self.maintenance))
 
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(self.env.GetRouterId().Token, binding.GetChannel(), self.forwarder.TraceController()))
 
@@ -0,0 +1,92 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"sync"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// maintenanceTracker records the maintenance status reported by each controller. Each controller reroutes the
+// circuits it owns, so the router is only safe to stop once every controller reporting on it says so.
+type maintenanceTracker struct {
+	statuses map[string]*ctrl_pb.RouterMaintenanceStatus
+	lock     sync.Mutex
+}
+
+func newMaintenanceTracker() *maintenanceTracker {
+	return &maintenanceTracker{
+		statuses: map[string]*ctrl_pb.RouterMaintenanceStatus{},
+	}
+}
+
+// update records the controller's status and returns true if all controllers in maintenance report it's safe to stop
+func (self *maintenanceTracker) update(ctrlId string, status *ctrl_pb.RouterMaintenanceStatus) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if status.Maintenance {
+		self.statuses[ctrlId] = status
+	} else {
+		delete(self.statuses, ctrlId)
+	}
+
+	for _, current := range self.statuses {
+		if !current.SafeToStop {
+			return false
+		}
+	}
+	return len(self.statuses) > 0
+}
+
+type maintenanceStatusHandler struct {
+	tracker *maintenanceTracker
+}
+
+func newMaintenanceStatusHandler(tracker *maintenanceTracker) *maintenanceStatusHandler {
+	return &maintenanceStatusHandler{
+		tracker: tracker,
+	}
+}
+
+func (handler *maintenanceStatusHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_RouterMaintenanceStatusType)
+}
+
+func (handler *maintenanceStatusHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label()).WithField("ctrlId", ch.Id())
+
+	status := &ctrl_pb.RouterMaintenanceStatus{}
+	if err := proto.Unmarshal(msg.Body, status); err != nil {
+		log.WithError(err).Error("error unmarshalling router maintenance status")
+		return
+	}
+
+	safeToStop := handler.tracker.update(ch.Id(), status)
+
+	if !status.Maintenance {
+		log.Info("router maintenance ended")
+	} else if safeToStop {
+		log.Info("router maintenance complete, no circuits transit this router, it is safe to stop")
+	} else {
+		log.WithField("transitCircuits", status.TransitCircuits).Info("router maintenance in progress, waiting for transit circuits to be rerouted")
+	}
+}
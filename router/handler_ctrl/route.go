@@ -158,11 +158,29 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 
 	if factory, err := xgress.GlobalRegistry().Factory(route.Egress.Binding); err == nil {
 		if dialer, err := factory.CreateDialer(rh.dialerCfg[route.Egress.Binding]); err == nil {
-			bindHandler := handler_xgress.NewBindHandler(
+			var bindHandler xgress.BindHandler = handler_xgress.NewBindHandler(
 				handler_xgress.NewReceiveHandler(rh.forwarder),
 				handler_xgress.NewCloseHandler(rh.env.GetNetworkControllers(), rh.forwarder),
 				rh.forwarder)
 
+			if route.RateLimit != nil {
+				bindHandler = &rateLimitingBindHandler{
+					BindHandler: bindHandler,
+					rateLimit: xgress.RateLimit{
+						BytesPerSecond: route.RateLimit.BytesPerSecond,
+						BurstBytes:     route.RateLimit.BurstBytes,
+					},
+				}
+			}
+
+			if route.Datagram {
+				bindHandler = &datagramBindHandler{BindHandler: bindHandler}
+			}
+
+			if route.HalfClose {
+				bindHandler = &halfCloseBindHandler{BindHandler: bindHandler}
+			}
+
 			if rh.forwarder.Options.XgressDialDwellTime > 0 {
 				log.Infof("dwelling [%s] on dial", rh.forwarder.Options.XgressDialDwellTime)
 				time.Sleep(rh.forwarder.Options.XgressDialDwellTime)
@@ -199,6 +217,40 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 	}
 }
 
+// rateLimitingBindHandler applies the rate limit delivered with the route to the egress xgress. Since the egress
+// xgress both reads from and writes to the terminator, this limits both directions of the circuit.
+type rateLimitingBindHandler struct {
+	xgress.BindHandler
+	rateLimit xgress.RateLimit
+}
+
+func (self *rateLimitingBindHandler) HandleXgressBind(x *xgress.Xgress) {
+	x.SetRateLimit(self.rateLimit)
+	self.BindHandler.HandleXgressBind(x)
+}
+
+// datagramBindHandler puts the egress xgress of a datagram circuit into datagram mode, so payloads read from the
+// terminator aren't buffered for retransmission
+type datagramBindHandler struct {
+	xgress.BindHandler
+}
+
+func (self *datagramBindHandler) HandleXgressBind(x *xgress.Xgress) {
+	x.SetDatagram(true)
+	self.BindHandler.HandleXgressBind(x)
+}
+
+// halfCloseBindHandler lets the egress xgress of a circuit, whose routers all support half close, send the end of the
+// terminator's input to the other end of the circuit rather than closing it
+type halfCloseBindHandler struct {
+	xgress.BindHandler
+}
+
+func (self *halfCloseBindHandler) HandleXgressBind(x *xgress.Xgress) {
+	x.SetHalfClose(true)
+	self.BindHandler.HandleXgressBind(x)
+}
+
 func newDialParams(ctrlId string, route *ctrl_pb.Route, bindHandler xgress.BindHandler, logContext logcontext.Context, deadline time.Time) *dialParams {
 	return &dialParams{
 		ctrlId:      ctrlId,
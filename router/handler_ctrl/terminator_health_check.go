@@ -0,0 +1,159 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+type terminatorHealthCheckHandler struct {
+	env        env.RouterEnv
+	httpClient *http.Client
+}
+
+func newTerminatorHealthCheckHandler(env env.RouterEnv) *terminatorHealthCheckHandler {
+	return &terminatorHealthCheckHandler{
+		env: env,
+		httpClient: &http.Client{
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (handler *terminatorHealthCheckHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_TerminatorHealthCheckRequestType)
+}
+
+func (handler *terminatorHealthCheckHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := pfxlog.ContextLogger(ch.Label())
+
+	req := &ctrl_pb.TerminatorHealthCheckRequest{}
+	if err := proto.Unmarshal(msg.Body, req); err != nil {
+		log.Errorf("error unmarshaling terminator health check msg (%v)", err)
+		return
+	}
+
+	// checks may take up to the request timeout, so run them async to avoid blocking other control messages
+	go handler.checkHealth(msg, ch, req)
+}
+
+func (handler *terminatorHealthCheckHandler) checkHealth(msg *channel.Message, ch channel.Channel, req *ctrl_pb.TerminatorHealthCheckRequest) {
+	log := pfxlog.ContextLogger(ch.Label()).WithField("terminatorId", req.TerminatorId).WithField("binding", req.Binding)
+
+	response := &ctrl_pb.TerminatorHealthCheckResponse{
+		TerminatorId: req.TerminatorId,
+		Healthy:      true,
+	}
+
+	if err := handler.runCheck(req); err != nil {
+		log.WithError(err).Debug("terminator health check failed")
+		response.Healthy = false
+		response.Error = err.Error()
+	}
+
+	body, err := proto.Marshal(response)
+	if err != nil {
+		log.WithError(err).Error("unexpected error serializing TerminatorHealthCheckResponse")
+		return
+	}
+
+	responseMsg := channel.NewMessage(int32(ctrl_pb.ContentType_TerminatorHealthCheckResponseType), body)
+	responseMsg.ReplyTo(msg)
+	if err := ch.Send(responseMsg); err != nil {
+		log.WithError(err).Error("unable to send terminator health check response")
+	}
+}
+
+func (handler *terminatorHealthCheckHandler) runCheck(req *ctrl_pb.TerminatorHealthCheckRequest) error {
+	timeout := time.Duration(req.Timeout)
+	switch req.Type {
+	case "tcp":
+		return checkTcp(req.Address, timeout)
+	case "http":
+		return handler.checkHttp(req.Address, timeout)
+	}
+
+	factory, err := xgress.GlobalRegistry().Factory(req.Binding)
+	if err != nil {
+		return err
+	}
+
+	dialer, err := factory.CreateDialer(handler.env.GetDialerCfg()[req.Binding])
+	if err != nil {
+		return err
+	}
+
+	healthCheckingDialer, ok := dialer.(xgress.HealthCheckingDialer)
+	if !ok {
+		return errors.Errorf("binding %v does not support health checks", req.Binding)
+	}
+
+	return healthCheckingDialer.HealthCheck(req.TerminatorId, req.Address, timeout)
+}
+
+func checkTcp(address string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", stripTransportProtocol(address), timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (handler *terminatorHealthCheckHandler) checkHttp(address string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := handler.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return errors.Errorf("unexpected http status %v", resp.StatusCode)
+	}
+	return nil
+}
+
+// stripTransportProtocol turns a transport address, such as tcp:localhost:8080, into a host:port
+func stripTransportProtocol(address string) string {
+	for _, prefix := range []string{"tcp:", "tls:"} {
+		if strings.HasPrefix(address, prefix) {
+			return strings.TrimPrefix(address, prefix)
+		}
+	}
+	return address
+}
@@ -0,0 +1,60 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/common/pb/ctrl_pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminatorHealthCheckProbes(t *testing.T) {
+	req := require.New(t)
+
+	handler := newTerminatorHealthCheckHandler(nil)
+	newRequest := func(checkType, address string) *ctrl_pb.TerminatorHealthCheckRequest {
+		return &ctrl_pb.TerminatorHealthCheckRequest{
+			TerminatorId: "t1",
+			Type:         checkType,
+			Address:      address,
+			Timeout:      int64(time.Second),
+		}
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	address := "tcp:" + listener.Addr().String()
+
+	req.NoError(handler.runCheck(newRequest("tcp", address)))
+	req.NoError(listener.Close())
+	req.Error(handler.runCheck(newRequest("tcp", address)))
+
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	req.NoError(handler.runCheck(newRequest("http", server.URL)))
+	status = http.StatusServiceUnavailable
+	req.ErrorContains(handler.runCheck(newRequest("http", server.URL)), "unexpected http status 503")
+}
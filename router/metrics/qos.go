@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/metrics"
+	"time"
+)
+
+// QosClassMetrics tracks link payload traffic for a single QoS class, across all links
+type QosClassMetrics struct {
+	TxMsgMeter         metrics.Meter
+	TxBytesMeter       metrics.Meter
+	DroppedMsgMeter    metrics.Meter
+	QueueTimeHistogram metrics.Histogram
+}
+
+// QosMetrics holds the router wide per-class metrics used to verify link scheduling. The metrics are shared by all
+// links and are reference counted, so each link should dispose of its QosMetrics when it closes.
+type QosMetrics struct {
+	classes map[xgress.QosClass]*QosClassMetrics
+}
+
+func NewQosMetrics(registry metrics.Registry) *QosMetrics {
+	result := &QosMetrics{
+		classes: map[xgress.QosClass]*QosClassMetrics{},
+	}
+	for _, class := range xgress.QosClasses {
+		prefix := "xlink.qos." + class.String()
+		result.classes[class] = &QosClassMetrics{
+			TxMsgMeter:         registry.Meter(prefix + ".tx.msgrate"),
+			TxBytesMeter:       registry.Meter(prefix + ".tx.bytesrate"),
+			DroppedMsgMeter:    registry.Meter(prefix + ".dropped_msgs"),
+			QueueTimeHistogram: registry.Histogram(prefix + ".queue_time"),
+		}
+	}
+	return result
+}
+
+// GetClass returns the metrics for the given class. Unknown classes are reported as default.
+func (self *QosMetrics) GetClass(class xgress.QosClass) *QosClassMetrics {
+	if result, found := self.classes[class]; found {
+		return result
+	}
+	return self.classes[xgress.QosClassDefault]
+}
+
+func (self *QosMetrics) MarkTx(class xgress.QosClass, size int) {
+	classMetrics := self.GetClass(class)
+	classMetrics.TxMsgMeter.Mark(1)
+	classMetrics.TxBytesMeter.Mark(int64(size))
+}
+
+func (self *QosMetrics) MarkDropped(class xgress.QosClass) {
+	self.GetClass(class).DroppedMsgMeter.Mark(1)
+}
+
+func (self *QosMetrics) UpdateQueueTime(class xgress.QosClass, d time.Duration) {
+	self.GetClass(class).QueueTimeHistogram.Update(d.Nanoseconds())
+}
+
+func (self *QosMetrics) Dispose() {
+	for _, classMetrics := range self.classes {
+		classMetrics.TxMsgMeter.Dispose()
+		classMetrics.TxBytesMeter.Dispose()
+		classMetrics.DroppedMsgMeter.Dispose()
+		classMetrics.QueueTimeHistogram.Dispose()
+	}
+}
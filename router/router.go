@@ -585,6 +585,8 @@ func (self *Router) connectToController(addr transport.Address, bindHandler chan
 	routerMeta := &ctrl_pb.RouterMetadata{
 		Capabilities: []ctrl_pb.RouterCapability{
 			ctrl_pb.RouterCapability_LinkManagement,
+			ctrl_pb.RouterCapability_DatagramCircuits,
+			ctrl_pb.RouterCapability_HalfClose,
 		},
 	}
 
@@ -0,0 +1,261 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openziti/fabric/common/inspect"
+	"github.com/pkg/errors"
+)
+
+const (
+	CongestionControlPortal = "portal"
+	CongestionControlCubic  = "cubic"
+)
+
+// CongestionController decides how many unacknowledged bytes a LinkSendBuffer may have in flight. A controller
+// belongs to a single send buffer and is only called from the send buffer's goroutine, so implementations don't
+// need to be thread safe. The current time is passed in, so that controllers can be driven by simulations.
+type CongestionController interface {
+	// WindowSize returns the number of unacknowledged bytes which may be in flight
+	WindowSize() uint32
+	// OnAck is called for each newly acknowledged payload, with the current smoothed round trip time
+	OnAck(now time.Time, payloadSize uint32, rtt time.Duration)
+	// OnDuplicateAck is called when an ack is received for a payload which is no longer buffered
+	OnDuplicateAck(now time.Time)
+	// OnRetransmit is called each time a payload is queued for retransmission, with the time the payload was last sent
+	OnRetransmit(now time.Time, sentAt time.Time)
+	// Inspect adds controller specific state to the send buffer inspect detail
+	Inspect(detail *inspect.XgressSendBufferDetail)
+}
+
+type CongestionControllerFactory func(options *Options) CongestionController
+
+var congestionControllers = map[string]CongestionControllerFactory{
+	CongestionControlPortal: func(options *Options) CongestionController {
+		return NewPortalCongestionController(options)
+	},
+	CongestionControlCubic: func(options *Options) CongestionController {
+		return NewCubicCongestionController(options)
+	},
+}
+var congestionControllersLock sync.RWMutex
+
+// RegisterCongestionController makes a congestion control algorithm available for use in xgress options
+func RegisterCongestionController(name string, factory CongestionControllerFactory) {
+	congestionControllersLock.Lock()
+	defer congestionControllersLock.Unlock()
+	congestionControllers[name] = factory
+}
+
+func GetCongestionControllerNames() []string {
+	congestionControllersLock.RLock()
+	defer congestionControllersLock.RUnlock()
+
+	var result []string
+	for name := range congestionControllers {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func NewCongestionController(options *Options) (CongestionController, error) {
+	name := options.CongestionControl
+	if name == "" {
+		name = CongestionControlPortal
+	}
+
+	congestionControllersLock.RLock()
+	factory, found := congestionControllers[name]
+	congestionControllersLock.RUnlock()
+
+	if !found {
+		return nil, errors.Errorf("unknown congestion control algorithm '%v', valid values: %v", name, GetCongestionControllerNames())
+	}
+	return factory(options), nil
+}
+
+// PortalCongestionController is the original xgress algorithm. The window grows by the number of bytes acked since
+// the last window reduction, every TxPortalIncreaseThresh acks. After TxPortalRetxThresh retransmits the window is
+// scaled by TxPortalRetxScale.
+type PortalCongestionController struct {
+	options        *Options
+	windowSize     uint32
+	accumulator    uint32
+	successfulAcks uint32
+	retransmits    uint32
+}
+
+func NewPortalCongestionController(options *Options) *PortalCongestionController {
+	return &PortalCongestionController{
+		options:    options,
+		windowSize: options.TxPortalStartSize,
+	}
+}
+
+func (self *PortalCongestionController) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *PortalCongestionController) OnAck(_ time.Time, payloadSize uint32, _ time.Duration) {
+	self.accumulator += payloadSize
+	self.successfulAcks++
+	if self.successfulAcks >= self.options.TxPortalIncreaseThresh {
+		self.successfulAcks = 0
+		delta := uint32(float64(self.accumulator) * self.options.TxPortalIncreaseScale)
+		self.windowSize += delta
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	}
+}
+
+func (self *PortalCongestionController) OnDuplicateAck(time.Time) {}
+
+func (self *PortalCongestionController) OnRetransmit(time.Time, time.Time) {
+	self.retransmits++
+	if self.retransmits >= self.options.TxPortalRetxThresh {
+		self.accumulator = 0
+		self.retransmits = 0
+		self.windowSize = uint32(float64(self.windowSize) * self.options.TxPortalRetxScale)
+		if self.windowSize < self.options.TxPortalMinSize {
+			self.windowSize = self.options.TxPortalMinSize
+		}
+	}
+}
+
+func (self *PortalCongestionController) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.CongestionControl = CongestionControlPortal
+	detail.Accumulator = self.accumulator
+	detail.Retransmits = self.retransmits
+}
+
+const (
+	cubicC    = 0.4
+	cubicBeta = 0.7
+)
+
+// CubicCongestionController is modelled on TCP CUBIC (RFC 8312). The window grows exponentially during slow start
+// and afterwards follows a cubic function of the time since the last loss, centred on the window size at which
+// that loss happened. Growth depends on elapsed time rather than on ack counts, so the window opens quickly on
+// high-bandwidth, high-latency paths. The window is reduced by a factor of 0.7 when a payload has to be
+// retransmitted. Retransmits of payloads sent before the last reduction belong to the same loss episode and don't
+// reduce the window again. The cubic function is scaled in units of TxPortalMinSize.
+type CubicCongestionController struct {
+	options       *Options
+	segmentSize   float64
+	windowSize    float64
+	ssthresh      float64
+	wMax          float64
+	k             float64
+	epochStart    time.Time
+	minRtt        time.Duration
+	lastReduction time.Time
+	reductions    uint32
+}
+
+func NewCubicCongestionController(options *Options) *CubicCongestionController {
+	segmentSize := float64(options.TxPortalMinSize)
+	if segmentSize == 0 {
+		segmentSize = 16 * 1024
+	}
+	return &CubicCongestionController{
+		options:     options,
+		segmentSize: segmentSize,
+		windowSize:  float64(options.TxPortalStartSize),
+		ssthresh:    float64(options.TxPortalMaxSize),
+	}
+}
+
+func (self *CubicCongestionController) WindowSize() uint32 {
+	return uint32(self.windowSize)
+}
+
+func (self *CubicCongestionController) OnAck(now time.Time, payloadSize uint32, rtt time.Duration) {
+	if rtt > 0 && (self.minRtt == 0 || rtt < self.minRtt) {
+		self.minRtt = rtt
+	}
+
+	if self.windowSize < self.ssthresh {
+		self.windowSize += float64(payloadSize)
+		self.clamp()
+		return
+	}
+
+	if self.epochStart.IsZero() {
+		self.epochStart = now
+		if self.windowSize < self.wMax {
+			self.k = math.Cbrt((self.wMax - self.windowSize) / self.segmentSize / cubicC)
+		} else {
+			self.k = 0
+			self.wMax = self.windowSize
+		}
+	}
+
+	t := now.Sub(self.epochStart).Seconds() + self.minRtt.Seconds()
+	target := self.wMax + cubicC*math.Pow(t-self.k, 3)*self.segmentSize
+
+	if target > self.windowSize {
+		// spread the growth towards the target over the acks for one window worth of data
+		self.windowSize += (target - self.windowSize) * float64(payloadSize) / self.windowSize
+	} else {
+		// close to the plateau, probe slowly
+		self.windowSize += self.segmentSize * float64(payloadSize) / (100 * self.windowSize)
+	}
+	self.clamp()
+}
+
+func (self *CubicCongestionController) OnDuplicateAck(time.Time) {}
+
+func (self *CubicCongestionController) OnRetransmit(now time.Time, sentAt time.Time) {
+	if !sentAt.After(self.lastReduction) {
+		return
+	}
+	self.lastReduction = now
+	self.reductions++
+
+	// fast convergence, release bandwidth to newer flows
+	if self.windowSize < self.wMax {
+		self.wMax = self.windowSize * (1 + cubicBeta) / 2
+	} else {
+		self.wMax = self.windowSize
+	}
+	self.windowSize *= cubicBeta
+	self.clamp()
+	self.ssthresh = self.windowSize
+	self.epochStart = time.Time{}
+}
+
+func (self *CubicCongestionController) clamp() {
+	if self.windowSize > float64(self.options.TxPortalMaxSize) {
+		self.windowSize = float64(self.options.TxPortalMaxSize)
+	}
+	if self.windowSize < float64(self.options.TxPortalMinSize) {
+		self.windowSize = float64(self.options.TxPortalMinSize)
+	}
+}
+
+func (self *CubicCongestionController) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.CongestionControl = CongestionControlCubic
+	detail.SlowStartThreshold = uint32(self.ssthresh)
+	detail.WindowReductions = self.reductions
+}
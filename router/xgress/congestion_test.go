@@ -0,0 +1,228 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"container/heap"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// simPath models a bottleneck link with a fixed bandwidth, propagation delay, drop tail queue and random loss
+type simPath struct {
+	bytesPerSecond float64
+	rtt            time.Duration
+	maxQueueDelay  time.Duration
+	lossRate       float64
+}
+
+type simEvent struct {
+	at      time.Time
+	sentAt  time.Time
+	seq     int
+	isAck   bool
+	isRetx  bool
+	dropped bool
+}
+
+type simEvents []*simEvent
+
+func (self simEvents) Len() int            { return len(self) }
+func (self simEvents) Less(i, j int) bool  { return self[i].at.Before(self[j].at) }
+func (self simEvents) Swap(i, j int)       { self[i], self[j] = self[j], self[i] }
+func (self *simEvents) Push(x interface{}) { *self = append(*self, x.(*simEvent)) }
+func (self *simEvents) Pop() interface{} {
+	old := *self
+	result := old[len(old)-1]
+	*self = old[:len(old)-1]
+	return result
+}
+
+type simResult struct {
+	throughput    float64
+	maxWindow     uint32
+	retransmits   int
+	finalWindow   uint32
+	utilization   float64
+	duration      time.Duration
+	deliveredData int64
+}
+
+// simulateCongestionControl sends fixed size payloads over the path for the given duration, using the controller
+// to limit the bytes in flight. Lost payloads are detected after a retransmit timeout of 1.5x the round trip time,
+// like the LinkSendBuffer retransmit threshold, and are resent.
+func simulateCongestionControl(cc CongestionController, path simPath, duration time.Duration, seed int64) *simResult {
+	const payloadSize = 16 * 1024
+
+	rnd := rand.New(rand.NewSource(seed))
+	start := time.Unix(0, 0)
+	now := start
+	end := start.Add(duration)
+
+	events := &simEvents{}
+	inFlight := uint32(0)
+	linkFreeAt := start
+	seq := 0
+	result := &simResult{duration: duration}
+	retxTimeout := path.rtt * 3 / 2
+
+	send := func(isRetx bool) {
+		inFlight += payloadSize
+		if linkFreeAt.Before(now) {
+			linkFreeAt = now
+		}
+		dropped := linkFreeAt.Sub(now) > path.maxQueueDelay || rnd.Float64() < path.lossRate
+		if !dropped {
+			linkFreeAt = linkFreeAt.Add(time.Duration(payloadSize / path.bytesPerSecond * float64(time.Second)))
+			heap.Push(events, &simEvent{at: linkFreeAt.Add(path.rtt), seq: seq, isAck: true, isRetx: isRetx})
+		} else {
+			heap.Push(events, &simEvent{at: now.Add(retxTimeout), sentAt: now, seq: seq, dropped: true})
+		}
+		seq++
+	}
+
+	for now.Before(end) {
+		for inFlight+payloadSize <= cc.WindowSize() {
+			send(false)
+		}
+		if cc.WindowSize() > result.maxWindow {
+			result.maxWindow = cc.WindowSize()
+		}
+
+		event := heap.Pop(events).(*simEvent)
+		now = event.at
+		if event.dropped {
+			cc.OnRetransmit(now, event.sentAt)
+			result.retransmits++
+			inFlight -= payloadSize
+			send(true)
+		} else {
+			inFlight -= payloadSize
+			result.deliveredData += payloadSize
+			cc.OnAck(now, payloadSize, path.rtt)
+		}
+	}
+
+	result.finalWindow = cc.WindowSize()
+	result.throughput = float64(result.deliveredData) / duration.Seconds()
+	result.utilization = result.throughput / path.bytesPerSecond
+	return result
+}
+
+func newHighBdpTestOptions() *Options {
+	options := DefaultOptions()
+	options.TxPortalMaxSize = 64 * 1024 * 1024
+	return options
+}
+
+func TestCongestionControllerSelection(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	cc, err := NewCongestionController(options)
+	req.NoError(err)
+	req.IsType(&PortalCongestionController{}, cc)
+
+	options.CongestionControl = CongestionControlCubic
+	cc, err = NewCongestionController(options)
+	req.NoError(err)
+	req.IsType(&CubicCongestionController{}, cc)
+
+	_, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "reno"}})
+	req.Error(err)
+
+	options, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "cubic"}})
+	req.NoError(err)
+	req.Equal(CongestionControlCubic, options.CongestionControl)
+}
+
+func TestCongestionControlHighBdp(t *testing.T) {
+	req := require.New(t)
+
+	// 100 MB/s with a 200ms round trip needs a 20 MB window to fill the pipe
+	path := simPath{
+		bytesPerSecond: 100 * 1024 * 1024,
+		rtt:            200 * time.Millisecond,
+		maxQueueDelay:  50 * time.Millisecond,
+		lossRate:       0.00001,
+	}
+
+	portal := simulateCongestionControl(NewPortalCongestionController(newHighBdpTestOptions()), path, 30*time.Second, 1)
+	cubic := simulateCongestionControl(NewCubicCongestionController(newHighBdpTestOptions()), path, 30*time.Second, 1)
+
+	t.Logf("portal: utilization=%.2f maxWindow=%v retransmits=%v", portal.utilization, portal.maxWindow, portal.retransmits)
+	t.Logf("cubic: utilization=%.2f maxWindow=%v retransmits=%v", cubic.utilization, cubic.maxWindow, cubic.retransmits)
+
+	req.Greater(cubic.utilization, 0.6)
+	req.Greater(cubic.utilization, portal.utilization)
+}
+
+func TestCongestionControlLossyPath(t *testing.T) {
+	// 2% random loss plus a shallow queue. Controllers must back off, without collapsing to the minimum window
+	path := simPath{
+		bytesPerSecond: 10 * 1024 * 1024,
+		rtt:            50 * time.Millisecond,
+		maxQueueDelay:  10 * time.Millisecond,
+		lossRate:       0.02,
+	}
+
+	for _, name := range []string{CongestionControlPortal, CongestionControlCubic} {
+		t.Run(name, func(t *testing.T) {
+			req := require.New(t)
+			options := DefaultOptions()
+			options.CongestionControl = name
+			cc, err := NewCongestionController(options)
+			req.NoError(err)
+
+			result := simulateCongestionControl(cc, path, 20*time.Second, 7)
+			t.Logf("%v: utilization=%.2f maxWindow=%v finalWindow=%v retransmits=%v", name, result.utilization, result.maxWindow, result.finalWindow, result.retransmits)
+
+			req.Greater(result.retransmits, 0)
+			req.Greater(result.utilization, 0.05)
+			req.LessOrEqual(result.maxWindow, options.TxPortalMaxSize)
+			req.GreaterOrEqual(result.finalWindow, options.TxPortalMinSize)
+		})
+	}
+}
+
+func TestCubicBacksOffOncePerLossEpisode(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	cc := NewCubicCongestionController(options)
+	now := time.Unix(0, 0)
+
+	for i := 0; i < 100; i++ {
+		cc.OnAck(now, 16*1024, 100*time.Millisecond)
+	}
+	windowBefore := cc.WindowSize()
+	req.Greater(windowBefore, options.TxPortalStartSize)
+
+	// a burst of retransmits from the same loss episode only reduces the window once
+	for i := 0; i < 10; i++ {
+		cc.OnRetransmit(now.Add(time.Second+time.Duration(i)*time.Millisecond), now.Add(500*time.Millisecond))
+	}
+	req.Equal(uint32(float64(windowBefore)*cubicBeta), cc.WindowSize())
+	req.Equal(uint32(1), cc.reductions)
+
+	// payloads sent after the reduction start a new episode
+	cc.OnRetransmit(now.Add(2*time.Second), now.Add(1500*time.Millisecond))
+	req.Equal(uint32(2), cc.reductions)
+}
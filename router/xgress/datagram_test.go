@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type packetTestConn struct {
+	testConn
+	packets chan []byte
+}
+
+func (conn *packetTestConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
+	if packet, ok := <-conn.packets; ok {
+		return packet, nil, nil
+	}
+	return nil, nil, io.EOF
+}
+
+type recordingReceiveHandler chan *Payload
+
+func (self recordingReceiveHandler) HandleXgressReceive(payload *Payload, _ *Xgress) {
+	self <- payload
+}
+
+func (self recordingReceiveHandler) HandleControlReceive(*Control, *Xgress) {}
+
+func newDatagramTestPayload(seq int32, flags uint32) *Payload {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(seq))
+	return &Payload{
+		Header: Header{
+			CircuitId: "datagram",
+			Flags:     SetOriginatorFlag(flags, Terminator),
+		},
+		Sequence: seq,
+		Data:     data,
+	}
+}
+
+func TestDatagramDelivery(t *testing.T) {
+	req := require.New(t)
+
+	initTestGlobals()
+
+	conn := &testConn{
+		ch:          make(chan uint64, 16),
+		closeNotify: make(chan struct{}),
+	}
+
+	x := NewXgress("datagram", "ctrl", "test", conn, Initiator, DefaultOptions(), nil)
+	x.receiveHandler = noopReceiveHandler{}
+	go x.tx()
+	defer x.Close()
+
+	// datagrams are written in the order they arrive, without waiting for earlier sequence numbers
+	for _, seq := range []int32{3, 1, 2} {
+		req.NoError(x.SendPayload(newDatagramTestPayload(seq, uint32(PayloadFlagDatagram))))
+	}
+	req.NoError(x.SendPayload(newDatagramTestPayload(0, 0)))
+
+	for _, expected := range []uint64{3, 1, 2, 0} {
+		select {
+		case next := <-conn.ch:
+			req.Equal(expected, next)
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for payload", "expected: %v", expected)
+		}
+	}
+
+	// only the reliable payload is acknowledged
+	select {
+	case ack := <-testAckForwarder.acks:
+		req.Equal([]int32{0}, ack.Sequence)
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for ack")
+	}
+
+	select {
+	case ack := <-testAckForwarder.acks:
+		req.Failf("unexpected ack", "sequence: %v", ack.Sequence)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDatagramRx(t *testing.T) {
+	req := require.New(t)
+
+	initTestGlobals()
+
+	conn := &packetTestConn{
+		testConn: testConn{
+			ch:          make(chan uint64, 1),
+			closeNotify: make(chan struct{}),
+		},
+		packets: make(chan []byte, 4),
+	}
+
+	receiveHandler := recordingReceiveHandler(make(chan *Payload, 16))
+	x := NewXgress("test", "ctrl", "test", conn, Initiator, DefaultOptions(), nil)
+	x.SetDatagram(true)
+	x.receiveHandler = receiveHandler
+	defer x.Close()
+
+	for i := 0; i < 3; i++ {
+		conn.packets <- []byte{byte(i)}
+	}
+	close(conn.packets)
+
+	go x.rx()
+
+	next := func() *Payload {
+		select {
+		case payload := <-receiveHandler:
+			return payload
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for payload")
+			return nil
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		payload := next()
+		req.True(payload.IsDatagramFlagSet())
+		req.Equal(int32(i), payload.Sequence)
+	}
+
+	// the circuit end is still delivered reliably and its sequence doesn't leave a gap for the datagrams
+	payload := next()
+	req.True(payload.IsCircuitEndFlagSet())
+	req.False(payload.IsDatagramFlagSet())
+	req.Equal(int32(0), payload.Sequence)
+}
@@ -0,0 +1,43 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"net"
+
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/pkg/errors"
+)
+
+// HalfCloseConnection is implemented by connections which can shut down their writing side while continuing to read,
+// like TCP's shutdown(SHUT_WR). When such a connection reaches the end of its input, the other end of the circuit is
+// told to close its peer for writing, rather than the circuit being closed.
+type HalfCloseConnection interface {
+	CloseWrite() error
+}
+
+// CloseWrite shuts down the writing side of the connection. Transport connections which embed the underlying
+// net.Conn are unwrapped. An error is returned if the connection doesn't support half close.
+func CloseWrite(conn net.Conn) error {
+	switch c := conn.(type) {
+	case HalfCloseConnection:
+		return c.CloseWrite()
+	case *tcp.Connection:
+		return CloseWrite(c.Conn)
+	}
+	return errors.Errorf("connection of type %T doesn't support half close", conn)
+}
@@ -0,0 +1,186 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+type halfCloseTestConn struct {
+	packetTestConn
+	writeClosed chan struct{}
+}
+
+func (conn *halfCloseTestConn) CloseWrite() error {
+	close(conn.writeClosed)
+	return nil
+}
+
+func TestHalfClose(t *testing.T) {
+	req := require.New(t)
+	initTestGlobals()
+
+	conn := &halfCloseTestConn{
+		packetTestConn: packetTestConn{
+			testConn: testConn{
+				ch:          make(chan uint64, 1),
+				closeNotify: make(chan struct{}),
+			},
+			packets: make(chan []byte, 4),
+		},
+		writeClosed: make(chan struct{}),
+	}
+
+	receiveHandler := recordingReceiveHandler(make(chan *Payload, 16))
+	x := NewXgress("half-close", "ctrl", "test", conn, Initiator, DefaultOptions(), nil)
+	x.SetHalfClose(true)
+	x.receiveHandler = receiveHandler
+	defer x.Close()
+	go x.tx()
+
+	// the other end finished writing, so the peer is closed for writing, but the xgress stays open
+	closeWrite := newDatagramTestPayload(0, uint32(PayloadFlagCloseWrite))
+	closeWrite.Data = nil
+	req.NoError(x.SendPayload(closeWrite))
+
+	select {
+	case <-conn.writeClosed:
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for close write")
+	}
+	req.False(x.Closed())
+
+	// data still flows in the other direction until the peer finishes writing
+	conn.packets <- []byte{1}
+	close(conn.packets)
+	go x.rx()
+
+	next := func() *Payload {
+		select {
+		case payload := <-receiveHandler:
+			return payload
+		case <-time.After(5 * time.Second):
+			req.FailNow("timed out waiting for payload")
+			return nil
+		}
+	}
+
+	payload := next()
+	req.Equal([]byte{1}, payload.Data)
+	req.Equal(int32(0), payload.Sequence)
+
+	payload = next()
+	req.True(payload.IsCloseWriteFlagSet())
+	req.Equal(int32(1), payload.Sequence)
+
+	// with both directions finished, the circuit is ended
+	payload = next()
+	req.True(payload.IsCircuitEndFlagSet())
+	req.Equal(int32(2), payload.Sequence)
+}
+
+func TestEOFWithoutHalfCloseCircuit(t *testing.T) {
+	req := require.New(t)
+	initTestGlobals()
+
+	conn := &halfCloseTestConn{
+		packetTestConn: packetTestConn{
+			testConn: testConn{
+				ch:          make(chan uint64, 1),
+				closeNotify: make(chan struct{}),
+			},
+			packets: make(chan []byte, 4),
+		},
+		writeClosed: make(chan struct{}),
+	}
+
+	receiveHandler := recordingReceiveHandler(make(chan *Payload, 16))
+	x := NewXgress("no-half-close", "ctrl", "test", conn, Initiator, DefaultOptions(), nil)
+	x.receiveHandler = receiveHandler
+	defer x.Close()
+
+	// the peer supports half close, but the circuit doesn't, so the circuit is ended on EOF
+	close(conn.packets)
+	go x.rx()
+
+	select {
+	case payload := <-receiveHandler:
+		req.False(payload.IsCloseWriteFlagSet())
+		req.True(payload.IsCircuitEndFlagSet())
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for circuit end")
+	}
+}
+
+func TestCloseWriteWithoutHalfCloseSupport(t *testing.T) {
+	req := require.New(t)
+	initTestGlobals()
+
+	conn := &testConn{
+		ch:          make(chan uint64, 1),
+		closeNotify: make(chan struct{}),
+	}
+
+	receiveHandler := recordingReceiveHandler(make(chan *Payload, 16))
+	x := NewXgress("close-write", "ctrl", "test", conn, Initiator, DefaultOptions(), nil)
+	x.receiveHandler = receiveHandler
+	defer x.Close()
+	go x.tx()
+
+	// peers which can't be closed for writing fall back to ending the circuit
+	req.NoError(x.SendPayload(newDatagramTestPayload(0, uint32(PayloadFlagCloseWrite))))
+
+	select {
+	case payload := <-receiveHandler:
+		req.True(payload.IsCircuitEndFlagSet())
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for circuit end")
+	}
+}
+
+func TestCloseWriteUnwrapsTransportConnections(t *testing.T) {
+	req := require.New(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = listener.Close() }()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	req.NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	accepted, err := listener.Accept()
+	req.NoError(err)
+	defer func() { _ = accepted.Close() }()
+
+	req.NoError(CloseWrite(&tcp.Connection{Conn: conn}))
+
+	// the other side sees the end of its input
+	n, err := accepted.Read(make([]byte, 1))
+	req.Equal(0, n)
+	req.Error(err)
+
+	client, server := net.Pipe()
+	defer func() { _ = client.Close() }()
+	defer func() { _ = server.Close() }()
+	req.Error(CloseWrite(client))
+}
@@ -28,6 +28,7 @@ import (
 
 type LinkReceiveBuffer struct {
 	tree               *btree.Tree
+	datagrams          []*Payload
 	sequence           int32
 	maxSequence        int32
 	size               uint32
@@ -68,7 +69,23 @@ func (buffer *LinkReceiveBuffer) ReceiveUnordered(payload *Payload, maxSize uint
 	return true
 }
 
+// ReceiveDatagram queues a datagram payload. Datagrams aren't reordered, so they're delivered ahead of any payloads
+// waiting for earlier sequence numbers. If the buffer is full, the datagram is dropped.
+func (buffer *LinkReceiveBuffer) ReceiveDatagram(payload *Payload, maxSize uint32) bool {
+	if atomic.LoadUint32(&buffer.size) > maxSize {
+		droppedDatagramsMeter.Mark(1)
+		return false
+	}
+
+	buffer.datagrams = append(buffer.datagrams, payload)
+	atomic.AddUint32(&buffer.size, uint32(len(payload.Data)))
+	return true
+}
+
 func (buffer *LinkReceiveBuffer) PeekHead() *Payload {
+	if len(buffer.datagrams) > 0 {
+		return buffer.datagrams[0]
+	}
 	if val := buffer.tree.LeftValue(); val != nil {
 		payload := val.(*Payload)
 		if payload.Sequence == buffer.sequence+1 {
@@ -79,6 +96,11 @@ func (buffer *LinkReceiveBuffer) PeekHead() *Payload {
 }
 
 func (buffer *LinkReceiveBuffer) Remove(payload *Payload) {
+	if payload.IsDatagramFlagSet() {
+		buffer.datagrams[0] = nil
+		buffer.datagrams = buffer.datagrams[1:]
+		return
+	}
 	buffer.tree.Remove(payload.Sequence)
 	buffer.sequence = payload.Sequence
 }
@@ -114,7 +136,7 @@ func (buffer *LinkReceiveBuffer) inspectComplete() *inspect.XgressRecvBufferDeta
 
 	return &inspect.XgressRecvBufferDetail{
 		Size:           buffer.Size(),
-		PayloadCount:   uint32(buffer.tree.Size()),
+		PayloadCount:   uint32(buffer.tree.Size() + len(buffer.datagrams)),
 		LastSizeSent:   buffer.getLastBufferSizeSent(),
 		Sequence:       buffer.sequence,
 		MaxSequence:    buffer.maxSequence,
@@ -42,13 +42,11 @@ type LinkSendBuffer struct {
 	buffer                map[int32]*txPayload
 	newlyBuffered         chan *txPayload
 	newlyReceivedAcks     chan *Acknowledgement
-	windowsSize           uint32
+	congestion            CongestionController
 	linkSendBufferSize    uint32
 	linkRecvBufferSize    uint32
-	accumulator           uint32
 	successfulAcks        uint32
 	duplicateAcks         uint32
-	retransmits           uint32
 	closeNotify           chan struct{}
 	closed                atomic.Bool
 	blockedByLocalWindow  bool
@@ -101,13 +99,19 @@ func (self *txPayload) isRetransmittable() bool {
 func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 	logrus.Debugf("txPortalStartSize = %d", x.Options.TxPortalStartSize)
 
+	congestion, err := NewCongestionController(x.Options)
+	if err != nil {
+		pfxlog.ContextLogger(x.Label()).WithError(err).Error("unable to create congestion controller, using portal")
+		congestion = NewPortalCongestionController(x.Options)
+	}
+
 	buffer := &LinkSendBuffer{
 		x:                 x,
 		buffer:            make(map[int32]*txPayload),
 		newlyBuffered:     make(chan *txPayload, x.Options.TxQueueSize),
 		newlyReceivedAcks: make(chan *Acknowledgement),
 		closeNotify:       make(chan struct{}),
-		windowsSize:       x.Options.TxPortalStartSize,
+		congestion:        congestion,
 		retxThreshold:     x.Options.RetxStartMs,
 		retxScale:         x.Options.RetxScale,
 		inspectRequests:   make(chan *sendBufferInspectEvent, 1),
@@ -152,8 +156,9 @@ func (buffer *LinkSendBuffer) Close() {
 
 func (buffer *LinkSendBuffer) isBlocked() bool {
 	blocked := false
+	windowSize := buffer.congestion.WindowSize()
 
-	if buffer.windowsSize < buffer.linkRecvBufferSize {
+	if windowSize < buffer.linkRecvBufferSize {
 		blocked = true
 		if !buffer.blockedByRemoteWindow {
 			buffer.blockedByRemoteWindow = true
@@ -164,7 +169,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 		atomic.AddInt64(&buffersBlockedByRemoteWindow, -1)
 	}
 
-	if buffer.windowsSize < buffer.linkSendBufferSize {
+	if windowSize < buffer.linkSendBufferSize {
 		blocked = true
 		if !buffer.blockedByLocalWindow {
 			buffer.blockedByLocalWindow = true
@@ -176,7 +181,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 	}
 
 	if blocked {
-		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, buffer.windowsSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
+		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, windowSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
 	}
 
 	return blocked
@@ -255,6 +260,8 @@ func (buffer *LinkSendBuffer) close() {
 
 func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 	log := pfxlog.ContextLogger(buffer.x.Label()).WithFields(ack.GetLoggerFields())
+	now := time.Now()
+	rtt := time.Duration(buffer.lastRtt) * time.Millisecond
 
 	for _, sequence := range ack.Sequence {
 		if txPayload, found := buffer.buffer[sequence]; found {
@@ -263,7 +270,7 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 			}
 
 			payloadSize := uint32(len(txPayload.payload.Data))
-			buffer.accumulator += payloadSize
+			buffer.congestion.OnAck(now, payloadSize, rtt)
 			buffer.successfulAcks++
 			delete(buffer.buffer, sequence)
 			atomic.AddInt64(&outstandingPayloads, -1)
@@ -274,11 +281,6 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 
 			if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
 				buffer.successfulAcks = 0
-				delta := uint32(float64(buffer.accumulator) * buffer.x.Options.TxPortalIncreaseScale)
-				buffer.windowsSize += delta
-				if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-					buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-				}
 				buffer.retxScale -= 0.02
 				if buffer.retxScale < buffer.x.Options.RetxScale {
 					buffer.retxScale = buffer.x.Options.RetxScale
@@ -286,6 +288,8 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 			}
 		} else { // duplicate ack
 			duplicateAcksMeter.Mark(1)
+			retransmitter.reportDuplicateAck(buffer.x)
+			buffer.congestion.OnDuplicateAck(now)
 			buffer.duplicateAcks++
 			if buffer.duplicateAcks >= buffer.x.Options.TxPortalDupAckThresh {
 				buffer.duplicateAcks = 0
@@ -316,12 +320,7 @@ func (buffer *LinkSendBuffer) retransmit() {
 				v.markQueued()
 				retransmitter.queue(v)
 				retransmitted++
-				buffer.retransmits++
-				if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
-					buffer.accumulator = 0
-					buffer.retransmits = 0
-					buffer.scale(buffer.x.Options.TxPortalRetxScale)
-				}
+				buffer.congestion.OnRetransmit(time.Now(), time.UnixMilli(v.getAge()))
 			}
 		}
 
@@ -332,27 +331,14 @@ func (buffer *LinkSendBuffer) retransmit() {
 	}
 }
 
-func (buffer *LinkSendBuffer) scale(factor float64) {
-	buffer.windowsSize = uint32(float64(buffer.windowsSize) * factor)
-	if factor > 1 {
-		if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-			buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-		}
-	} else if buffer.windowsSize < buffer.x.Options.TxPortalMinSize {
-		buffer.windowsSize = buffer.x.Options.TxPortalMinSize
-	}
-}
-
 func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 	timeSinceLastRetransmit := time.Duration(info.NowInMilliseconds()-buffer.lastRetransmitTime) * time.Millisecond
 	result := &inspect.XgressSendBufferDetail{
-		WindowSize:            buffer.windowsSize,
+		WindowSize:            buffer.congestion.WindowSize(),
 		LinkSendBufferSize:    buffer.linkSendBufferSize,
 		LinkRecvBufferSize:    buffer.linkRecvBufferSize,
-		Accumulator:           buffer.accumulator,
 		SuccessfulAcks:        buffer.successfulAcks,
 		DuplicateAcks:         buffer.duplicateAcks,
-		Retransmits:           buffer.retransmits,
 		Closed:                buffer.closed.Load(),
 		BlockedByLocalWindow:  buffer.blockedByLocalWindow,
 		BlockedByRemoteWindow: buffer.blockedByRemoteWindow,
@@ -361,6 +347,7 @@ func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 		TimeSinceLastRetx:     timeSinceLastRetransmit.String(),
 		CloseWhenEmpty:        buffer.closeWhenEmpty.Load(),
 	}
+	buffer.congestion.Inspect(result)
 	return result
 }
 
@@ -36,6 +36,7 @@ const (
 	HeaderKeyFlags          = 2258
 	HeaderKeyRecvBufferSize = 2259
 	HeaderKeyRTT            = 2260
+	HeaderKeyQosClass       = 2261
 
 	ContentTypePayloadType         = 1100
 	ContentTypeAcknowledgementType = 1101
@@ -68,6 +69,8 @@ const (
 	PayloadFlagCircuitEnd   PayloadFlag = 1
 	PayloadFlagOriginator   PayloadFlag = 2
 	PayloadFlagCircuitStart PayloadFlag = 4
+	PayloadFlagDatagram     PayloadFlag = 8
+	PayloadFlagCloseWrite   PayloadFlag = 16
 )
 
 type Header struct {
@@ -202,6 +205,7 @@ func (ack *Acknowledgement) GetLoggerFields() logrus.Fields {
 type Payload struct {
 	Header
 	Sequence int32
+	QosClass QosClass
 	Headers  map[uint8][]byte
 	Data     []byte
 }
@@ -219,6 +223,9 @@ func (payload *Payload) Marshall() *channel.Message {
 	payload.marshallHeader(msg)
 	msg.PutUint64Header(HeaderKeySequence, uint64(payload.Sequence))
 	msg.PutUint16Header(HeaderKeyRTT, uint16(info.NowInMilliseconds()))
+	if payload.QosClass != QosClassDefault {
+		msg.Headers[HeaderKeyQosClass] = []byte{byte(payload.QosClass)}
+	}
 
 	return msg
 }
@@ -250,6 +257,10 @@ func UnmarshallPayload(msg *channel.Message) (*Payload, error) {
 	}
 	payload.Sequence = int32(sequence)
 
+	if val, ok := msg.Headers[HeaderKeyQosClass]; ok && len(val) == 1 {
+		payload.QosClass = QosClass(val[0])
+	}
+
 	return payload, nil
 }
 
@@ -265,6 +276,18 @@ func (payload *Payload) IsCircuitStartFlagSet() bool {
 	return isPayloadFlagSet(payload.Flags, PayloadFlagCircuitStart)
 }
 
+// IsDatagramFlagSet returns true if the payload belongs to a datagram circuit, in which case it's delivered without
+// being acknowledged, retransmitted or reordered
+func (payload *Payload) IsDatagramFlagSet() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagDatagram)
+}
+
+// IsCloseWriteFlagSet returns true if the payload signals that the sender's peer has finished writing, while the
+// other direction of the circuit may continue
+func (payload *Payload) IsCloseWriteFlagSet() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagCloseWrite)
+}
+
 func SetOriginatorFlag(flags uint32, originator Originator) uint32 {
 	if originator == Initiator {
 		return ^uint32(PayloadFlagOriginator) & flags
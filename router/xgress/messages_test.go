@@ -18,6 +18,7 @@ package xgress
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"reflect"
 	"sync/atomic"
 	"testing"
@@ -121,3 +122,31 @@ func TestAcknowledgement_marshallSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestPayloadQosClassRoundTrip(t *testing.T) {
+	req := require.New(t)
+
+	payload := &Payload{
+		Header: Header{
+			CircuitId: "test",
+		},
+		Sequence: 5,
+		Data:     []byte("hello"),
+	}
+
+	msg := payload.Marshall()
+	_, found := msg.Headers[HeaderKeyQosClass]
+	req.False(found, "default class should not be sent")
+
+	payload.QosClass = QosClassInteractive
+	decoded, err := UnmarshallPayload(payload.Marshall())
+	req.NoError(err)
+	req.Equal(QosClassInteractive, decoded.QosClass)
+
+	class, err := ParseQosClass("bulk")
+	req.NoError(err)
+	req.Equal(QosClassBulk, class)
+
+	_, err = ParseQosClass("urgent")
+	req.Error(err)
+}
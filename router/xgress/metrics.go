@@ -8,12 +8,15 @@ import (
 var ackTxMeter metrics.Meter
 var ackRxMeter metrics.Meter
 var droppedPayloadsMeter metrics.Meter
+var droppedDatagramsMeter metrics.Meter
 var retransmissions metrics.Meter
 var retransmissionFailures metrics.Meter
 
 var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
+var rateLimitedMeter metrics.Meter
+var rateLimitWaitTimer metrics.Timer
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -22,6 +25,7 @@ var outstandingPayloadBytes int64
 
 func InitMetrics(registry metrics.UsageRegistry) {
 	droppedPayloadsMeter = registry.Meter("xgress.dropped_payloads")
+	droppedDatagramsMeter = registry.Meter("xgress.dropped_datagrams")
 	retransmissions = registry.Meter("xgress.retransmissions")
 	retransmissionFailures = registry.Meter("xgress.retransmission_failures")
 	ackRxMeter = registry.Meter("xgress.rx.acks")
@@ -29,6 +33,8 @@ func InitMetrics(registry metrics.UsageRegistry) {
 	ackFailures = registry.Meter("xgress.ack_failures")
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
+	rateLimitedMeter = registry.Meter("xgress.rate_limited")
+	rateLimitWaitTimer = registry.Timer("xgress.rate_limit_wait_time")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
@@ -18,6 +18,7 @@ package xgress
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"time"
 )
@@ -38,12 +39,16 @@ type Options struct {
 	TxPortalRetxScale      float64
 	TxPortalDupAckThresh   uint32
 	TxPortalDupAckScale    float64
+	CongestionControl      string
 
 	RxBufferSize uint32
 	RetxStartMs  uint32
 	RetxScale    float64
 	RetxAddMs    uint32
 
+	RateLimitBytesPerSecond uint64
+	RateLimitBurstBytes     uint64
+
 	MaxCloseWait        time.Duration
 	GetCircuitTimeout   time.Duration
 	CircuitStartTimeout time.Duration
@@ -96,6 +101,12 @@ func LoadOptions(data OptionsData) (*Options, error) {
 		if value, found := data["txPortalDupAckScale"]; found {
 			options.TxPortalDupAckScale = value.(float64)
 		}
+		if value, found := data["congestionControl"]; found {
+			options.CongestionControl = fmt.Sprint(value)
+			if _, err := NewCongestionController(options); err != nil {
+				return nil, errors.Wrap(err, "invalid 'congestionControl' value")
+			}
+		}
 
 		if value, found := data["rxBufferSize"]; found {
 			options.RxBufferSize = uint32(value.(int))
@@ -110,6 +121,13 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.RetxAddMs = uint32(value.(int))
 		}
 
+		if value, found := data["rateLimitBytesPerSecond"]; found {
+			options.RateLimitBytesPerSecond = uint64(value.(int))
+		}
+		if value, found := data["rateLimitBurstBytes"]; found {
+			options.RateLimitBurstBytes = uint64(value.(int))
+		}
+
 		if value, found := data["maxCloseWaitMs"]; found {
 			options.MaxCloseWait = time.Duration(value.(int)) * time.Millisecond
 		}
@@ -157,6 +175,7 @@ func DefaultOptions() *Options {
 		TxPortalRetxScale:      0.75,
 		TxPortalDupAckThresh:   64,
 		TxPortalDupAckScale:    0.9,
+		CongestionControl:      CongestionControlPortal,
 		RxBufferSize:           4 * 1024 * 1024,
 		RetxStartMs:            200,
 		RetxScale:              1.5,
@@ -168,6 +187,14 @@ func DefaultOptions() *Options {
 	}
 }
 
+// GetRateLimit returns the rate limit configured for the listener or dialer these options belong to
+func (options *Options) GetRateLimit() RateLimit {
+	return RateLimit{
+		BytesPerSecond: options.RateLimitBytesPerSecond,
+		BurstBytes:     options.RateLimitBurstBytes,
+	}
+}
+
 func (options Options) String() string {
 	data, err := json.Marshal(options)
 	if err != nil {
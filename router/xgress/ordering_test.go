@@ -6,6 +6,7 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/stretchr/testify/require"
 	"io"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -63,12 +64,40 @@ func (n noopReceiveHandler) HandleXgressReceive(*Payload, *Xgress) {}
 
 func (n noopReceiveHandler) HandleControlReceive(*Control, *Xgress) {}
 
+// ackRecordingForwarder records the acks sent for a single circuit
+type ackRecordingForwarder struct {
+	noopForwarder
+	circuitId string
+	acks      chan *Acknowledgement
+}
+
+func (self *ackRecordingForwarder) ReportForwardingFault(string, string) {}
+
+func (self *ackRecordingForwarder) ForwardAcknowledgement(_ Address, ack *Acknowledgement) error {
+	if ack.CircuitId == self.circuitId {
+		self.acks <- ack
+	}
+	return nil
+}
+
+var testAckForwarder = &ackRecordingForwarder{circuitId: "datagram", acks: make(chan *Acknowledgement, 16)}
+var initTestGlobalsOnce sync.Once
+
+// initTestGlobals starts the shared payload ingester and acker once, so tests don't replace them while goroutines
+// started by earlier tests are still using them
+func initTestGlobals() {
+	initTestGlobalsOnce.Do(func() {
+		closeNotify := make(chan struct{})
+		metricsRegistry := metrics.NewUsageRegistry("test", map[string]string{}, closeNotify)
+		InitPayloadIngester(closeNotify)
+		InitMetrics(metricsRegistry)
+		InitAcker(testAckForwarder, metricsRegistry, closeNotify)
+		InitRetransmitter(testAckForwarder, testAckForwarder, metricsRegistry, closeNotify)
+	})
+}
+
 func Test_Ordering(t *testing.T) {
-	closeNotify := make(chan struct{})
-	metricsRegistry := metrics.NewUsageRegistry("test", map[string]string{}, closeNotify)
-	InitPayloadIngester(closeNotify)
-	InitMetrics(metricsRegistry)
-	InitAcker(&noopForwarder{}, metricsRegistry, closeNotify)
+	initTestGlobals()
 
 	conn := &testConn{
 		ch:          make(chan uint64, 1),
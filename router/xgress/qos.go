@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"fmt"
+)
+
+// QosClass identifies the quality-of-service class of a circuit. The class is carried in payload headers, so that
+// every router along the path can schedule the payload on its outbound link accordingly.
+type QosClass uint8
+
+const (
+	QosClassDefault     QosClass = 0
+	QosClassInteractive QosClass = 1
+	QosClassBulk        QosClass = 2
+)
+
+// QosClasses lists the QoS classes in priority order, highest priority first
+var QosClasses = []QosClass{QosClassInteractive, QosClassDefault, QosClassBulk}
+
+func (self QosClass) String() string {
+	switch self {
+	case QosClassInteractive:
+		return "interactive"
+	case QosClassDefault:
+		return "default"
+	case QosClassBulk:
+		return "bulk"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(self))
+	}
+}
+
+// Priority returns the scheduling rank of the class, where zero is the highest priority. Unknown classes are
+// scheduled as default.
+func (self QosClass) Priority() int {
+	switch self {
+	case QosClassInteractive:
+		return 0
+	case QosClassBulk:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func ParseQosClass(val string) (QosClass, error) {
+	for _, class := range QosClasses {
+		if class.String() == val {
+			return class, nil
+		}
+	}
+	return QosClassDefault, fmt.Errorf("invalid qos class '%v', must be one of interactive, default or bulk", val)
+}
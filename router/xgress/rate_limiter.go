@@ -0,0 +1,123 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimit defines a token bucket throughput limit. A zero BytesPerSecond means unlimited. If BurstBytes is zero,
+// the burst size defaults to one second worth of data.
+type RateLimit struct {
+	BytesPerSecond uint64
+	BurstBytes     uint64
+}
+
+func (self RateLimit) IsLimited() bool {
+	return self.BytesPerSecond > 0
+}
+
+// Min returns the more restrictive of the two limits
+func (self RateLimit) Min(other RateLimit) RateLimit {
+	if !self.IsLimited() {
+		return other
+	}
+	if !other.IsLimited() {
+		return self
+	}
+
+	result := self
+	if other.BytesPerSecond < result.BytesPerSecond {
+		result.BytesPerSecond = other.BytesPerSecond
+	}
+	if result.BurstBytes == 0 || (other.BurstBytes > 0 && other.BurstBytes < result.BurstBytes) {
+		result.BurstBytes = other.BurstBytes
+	}
+	return result
+}
+
+// RateLimiter is a token bucket. Tokens are bytes, and are replenished at the configured rate, up to the burst size.
+// Payloads larger than the available tokens are allowed through once the bucket is no longer in debt, so payloads
+// larger than the burst size don't block forever.
+type RateLimiter struct {
+	lock     sync.Mutex
+	limit    RateLimit
+	rate     float64
+	burst    float64
+	tokens   float64
+	lastFill time.Time
+}
+
+func NewRateLimiter(limit RateLimit) *RateLimiter {
+	burst := float64(limit.BurstBytes)
+	if burst == 0 {
+		burst = float64(limit.BytesPerSecond)
+	}
+
+	return &RateLimiter{
+		limit:    limit,
+		rate:     float64(limit.BytesPerSecond),
+		burst:    burst,
+		tokens:   burst,
+		lastFill: time.Now(),
+	}
+}
+
+func (self *RateLimiter) GetLimit() RateLimit {
+	return self.limit
+}
+
+// Reserve takes n bytes worth of tokens and returns how long the caller must wait before sending them
+func (self *RateLimiter) Reserve(n int) time.Duration {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	self.tokens += now.Sub(self.lastFill).Seconds() * self.rate
+	if self.tokens > self.burst {
+		self.tokens = self.burst
+	}
+	self.lastFill = now
+
+	self.tokens -= float64(n)
+	if self.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-self.tokens / self.rate * float64(time.Second))
+}
+
+// Wait blocks until n bytes may be sent. Returns false if closeNotify was signaled while waiting.
+func (self *RateLimiter) Wait(n int, closeNotify <-chan struct{}) bool {
+	delay := self.Reserve(n)
+	if delay <= 0 {
+		return true
+	}
+
+	rateLimitedMeter.Mark(1)
+	rateLimitWaitTimer.Update(delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-closeNotify:
+		return false
+	}
+}
@@ -0,0 +1,60 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	req := require.New(t)
+
+	limiter := NewRateLimiter(RateLimit{BytesPerSecond: 1000, BurstBytes: 500})
+
+	// the initial burst is available immediately
+	req.Equal(time.Duration(0), limiter.Reserve(500))
+
+	// once the bucket is empty, callers wait for the tokens to be replenished
+	delay := limiter.Reserve(250)
+	req.True(delay > 200*time.Millisecond && delay <= 250*time.Millisecond, "delay: %v", delay)
+
+	// payloads larger than the burst are allowed through, the bucket goes into debt
+	delay = limiter.Reserve(2000)
+	req.True(delay > 2*time.Second && delay <= 2250*time.Millisecond, "delay: %v", delay)
+
+	// burst defaults to one second of data
+	limiter = NewRateLimiter(RateLimit{BytesPerSecond: 1000})
+	req.Equal(time.Duration(0), limiter.Reserve(1000))
+	req.True(limiter.Reserve(1) > 0)
+}
+
+func TestRateLimitMin(t *testing.T) {
+	req := require.New(t)
+
+	unlimited := RateLimit{}
+	fast := RateLimit{BytesPerSecond: 10000, BurstBytes: 500}
+	slow := RateLimit{BytesPerSecond: 1000}
+
+	req.Equal(fast, unlimited.Min(fast))
+	req.Equal(fast, fast.Min(unlimited))
+	req.Equal(RateLimit{BytesPerSecond: 1000, BurstBytes: 500}, fast.Min(slow))
+	req.Equal(RateLimit{BytesPerSecond: 1000, BurstBytes: 500}, slow.Min(fast))
+	req.False(unlimited.Min(unlimited).IsLimited())
+}
@@ -196,7 +196,20 @@ func GetCircuit(ctrl networkControllers, ingressId string, service string, timeo
 }
 
 func CreateCircuit(ctrl networkControllers, peer Connection, request *Request, bindHandler BindHandler, options *Options) *Response {
-	circuitInfo, err := GetCircuit(ctrl, request.Id, request.ServiceId, options.GetCircuitTimeout, nil)
+	return createCircuit(ctrl, peer, request, bindHandler, options, nil)
+}
+
+// CreateDatagramCircuit creates a circuit like CreateCircuit, but requests a datagram circuit, which forwards payloads
+// without retransmission or reordering. If the controller doesn't grant the request, a regular circuit is created.
+func CreateDatagramCircuit(ctrl networkControllers, peer Connection, request *Request, bindHandler BindHandler, options *Options) *Response {
+	peerData := map[uint32][]byte{
+		ctrl_msg.DatagramModeHeader: {1},
+	}
+	return createCircuit(ctrl, peer, request, bindHandler, options, peerData)
+}
+
+func createCircuit(ctrl networkControllers, peer Connection, request *Request, bindHandler BindHandler, options *Options, peerData map[uint32][]byte) *Response {
+	circuitInfo, err := GetCircuit(ctrl, request.Id, request.ServiceId, options.GetCircuitTimeout, peerData)
 	if err != nil {
 		return &Response{Success: false, Message: err.Error()}
 	}
@@ -204,6 +217,12 @@ func CreateCircuit(ctrl networkControllers, peer Connection, request *Request, b
 	x := NewXgress(circuitInfo.CircuitId.Token, circuitInfo.CtrlId, circuitInfo.Address, peer, Initiator, options, map[string]string{
 		"serviceId": request.ServiceId,
 	})
+	if _, found := circuitInfo.CircuitId.Data[ctrl_msg.DatagramModeHeader]; found {
+		x.SetDatagram(true)
+	}
+	if _, found := circuitInfo.CircuitId.Data[ctrl_msg.HalfCloseHeader]; found {
+		x.SetHalfClose(true)
+	}
 	bindHandler.HandleXgressBind(x)
 	x.Start()
 
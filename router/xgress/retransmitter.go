@@ -16,6 +16,12 @@ type RetransmitterFaultReporter interface {
 	ReportForwardingFault(circuitId string, ctrlId string)
 }
 
+// DuplicateAckReporter may be implemented by the PayloadBufferForwarder, to attribute duplicate acks to the links
+// used by the affected circuits
+type DuplicateAckReporter interface {
+	ReportDuplicateAck(srcAddr Address, circuitId string)
+}
+
 type Retransmitter struct {
 	forwarder            PayloadBufferForwarder
 	faultReporter        RetransmitterFaultReporter
@@ -50,6 +56,12 @@ func (retransmitter *Retransmitter) queue(p *txPayload) {
 	retransmitter.retransmitIngest <- p
 }
 
+func (retransmitter *Retransmitter) reportDuplicateAck(x *Xgress) {
+	if reporter, ok := retransmitter.forwarder.(DuplicateAckReporter); ok {
+		reporter.ReportDuplicateAck(x.address, x.circuitId)
+	}
+}
+
 func (retransmitter *Retransmitter) popHead() *txPayload {
 	if retransmitter.retxHead == nil {
 		return nil
@@ -47,6 +47,10 @@ const (
 	rxerStartedFlag       = 1
 	endOfCircuitRecvdFlag = 2
 	endOfCircuitSentFlag  = 3
+	datagramFlag          = 4
+	closeWriteSentFlag    = 5
+	closeWriteRecvdFlag   = 6
+	halfCloseFlag         = 7
 )
 
 type Address string
@@ -72,6 +76,13 @@ type Dialer interface {
 	IsTerminatorValid(id string, destination string) bool
 }
 
+// HealthCheckingDialer is implemented by dialers which can check if a terminator's destination is reachable, without
+// establishing a circuit. It's used when the controller requests router side terminator health checks.
+type HealthCheckingDialer interface {
+	Dialer
+	HealthCheck(terminatorId string, destination string, timeout time.Duration) error
+}
+
 type Factory interface {
 	CreateListener(optionsData OptionsData) (Listener, error)
 	CreateDialer(optionsData OptionsData) (Dialer, error)
@@ -137,6 +148,7 @@ type Xgress struct {
 	closeNotify          chan struct{}
 	rxSequence           int32
 	rxSequenceLock       sync.Mutex
+	datagramSequence     atomic.Int32
 	receiveHandler       ReceiveHandler
 	payloadBuffer        *LinkSendBuffer
 	linkRxBuffer         *LinkReceiveBuffer
@@ -145,6 +157,8 @@ type Xgress struct {
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
 	tags                 map[string]string
+	rxRateLimiter        atomic.Pointer[RateLimiter]
+	txRateLimiter        atomic.Pointer[RateLimiter]
 }
 
 func (self *Xgress) GetIntervalId() string {
@@ -171,9 +185,54 @@ func NewXgress(circuitId string, ctrlId string, address Address, peer Connection
 		tags:                 tags,
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
+	result.SetRateLimit(RateLimit{})
 	return result
 }
 
+// SetRateLimit applies a per-circuit rate limit, such as one configured on the service or terminator. The limit
+// configured in the xgress options still applies, so the more restrictive of the two is used.
+func (self *Xgress) SetRateLimit(limit RateLimit) {
+	limit = limit.Min(self.Options.GetRateLimit())
+	if limit.IsLimited() {
+		self.rxRateLimiter.Store(NewRateLimiter(limit))
+		self.txRateLimiter.Store(NewRateLimiter(limit))
+	} else {
+		self.rxRateLimiter.Store(nil)
+		self.txRateLimiter.Store(nil)
+	}
+}
+
+// GetRateLimit returns the rate limit currently applied to this xgress
+func (self *Xgress) GetRateLimit() RateLimit {
+	if limiter := self.rxRateLimiter.Load(); limiter != nil {
+		return limiter.GetLimit()
+	}
+	return RateLimit{}
+}
+
+// SetDatagram puts the xgress into datagram mode. Payloads read from the peer are then forwarded without being
+// buffered for retransmission. It must be called before the xgress is started.
+func (self *Xgress) SetDatagram(datagram bool) {
+	self.flags.Set(datagramFlag, datagram)
+}
+
+// IsDatagram returns true if the xgress is in datagram mode
+func (self *Xgress) IsDatagram() bool {
+	return self.flags.IsSet(datagramFlag)
+}
+
+// SetHalfClose allows the xgress to send the end of the peer's input to the other end of the circuit, rather than
+// closing the circuit. It should only be enabled if every router on the circuit supports half close, as the circuit
+// otherwise may never be closed. It must be called before the xgress is started.
+func (self *Xgress) SetHalfClose(halfClose bool) {
+	self.flags.Set(halfCloseFlag, halfClose)
+}
+
+// IsHalfClose returns true if the xgress may send the end of the peer's input to the other end of the circuit
+func (self *Xgress) IsHalfClose() bool {
+	return self.flags.IsSet(halfCloseFlag)
+}
+
 func (self *Xgress) GetTimeOfLastRxFromLink() int64 {
 	return atomic.LoadInt64(&self.timeOfLastRxFromLink)
 }
@@ -276,6 +335,54 @@ func (self *Xgress) GetEndCircuit() *Payload {
 	return endCircuit
 }
 
+func (self *Xgress) GetCloseWrite() *Payload {
+	closeWrite := &Payload{
+		Header: Header{
+			CircuitId: self.circuitId,
+			Flags:     SetOriginatorFlag(uint32(PayloadFlagCloseWrite), self.originator),
+		},
+		Sequence: self.nextReceiveSequence(),
+		Data:     nil,
+	}
+	return closeWrite
+}
+
+// sendCloseWrite tells the other end of the circuit that the peer has finished writing. It returns false if the xgress
+// should be closed instead, because the circuit or the peer doesn't support half close or the other direction has
+// already finished.
+func (self *Xgress) sendCloseWrite() bool {
+	if !self.IsHalfClose() || self.Closed() {
+		return false
+	}
+	if _, ok := self.peer.(HalfCloseConnection); !ok {
+		return false
+	}
+
+	pfxlog.ContextLogger(self.Label()).Debug("sending close write payload")
+	if !self.forwardPayload(self.GetCloseWrite()) {
+		return false
+	}
+	self.flags.Set(closeWriteSentFlag, true)
+	return !self.flags.IsSet(closeWriteRecvdFlag)
+}
+
+// closeWrite closes the peer for writing, once the other end of the circuit has finished writing. It returns false if
+// the xgress should be closed instead, because the peer doesn't support half close or this direction has already
+// finished.
+func (self *Xgress) closeWrite() bool {
+	conn, ok := self.peer.(HalfCloseConnection)
+	if !ok {
+		return false
+	}
+
+	if err := conn.CloseWrite(); err != nil {
+		pfxlog.ContextLogger(self.Label()).WithError(err).Warn("unable to close peer for writing, closing xgress")
+		return false
+	}
+	self.flags.Set(closeWriteRecvdFlag, true)
+	return !self.flags.IsSet(closeWriteSentFlag)
+}
+
 func (self *Xgress) ForwardEndOfCircuit(sendF func(payload *Payload) bool) {
 	// for now always send end of circuit. too many is better than not enough
 	if !self.IsEndOfCircuitSent() {
@@ -457,6 +564,15 @@ func (self *Xgress) tx() {
 			return
 		}
 
+		if payload.IsCloseWriteFlagSet() {
+			if !self.closeWrite() {
+				log.Debug("close write payload received, exiting")
+				return
+			}
+			log.Debug("close write payload received, peer closed for writing")
+			continue
+		}
+
 		payloadLogger := log.WithFields(payload.GetLoggerFields())
 		payloadLogger.Debug("sending")
 
@@ -465,6 +581,10 @@ func (self *Xgress) tx() {
 		}
 
 		if !payload.IsCircuitStartFlagSet() {
+			if limiter := self.txRateLimiter.Load(); limiter != nil && !limiter.Wait(len(payload.Data), self.closeNotify) {
+				return
+			}
+
 			start := time.Now()
 			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
 			if err != nil {
@@ -513,7 +633,12 @@ func (self *Xgress) rx() {
 		}
 	}()
 
-	defer self.flushSendThenClose()
+	closeOnExit := true
+	defer func() {
+		if closeOnExit {
+			self.flushSendThenClose()
+		}
+	}()
 
 	for {
 		buffer, headers, err := self.peer.ReadPayload()
@@ -524,6 +649,8 @@ func (self *Xgress) rx() {
 		if err != nil && (n == 0 || err != io.EOF) {
 			if err == io.EOF {
 				log.Debugf("EOF, exiting xgress.rx loop")
+				// if the peer supports half close, the other direction stays open until it finishes as well
+				closeOnExit = !self.sendCloseWrite()
 			} else {
 				log.Warnf("read failed (%s)", err)
 			}
@@ -535,14 +662,25 @@ func (self *Xgress) rx() {
 			return
 		}
 
+		if limiter := self.rxRateLimiter.Load(); limiter != nil && !limiter.Wait(n, self.closeNotify) {
+			return
+		}
+
 		payload := &Payload{
 			Header: Header{
 				CircuitId: self.circuitId,
 				Flags:     SetOriginatorFlag(0, self.originator),
 			},
-			Sequence: self.nextReceiveSequence(),
-			Data:     buffer[0:n],
-			Headers:  headers,
+			Data:    buffer[0:n],
+			Headers: headers,
+		}
+
+		// datagrams are numbered separately, so the sequence of reliable payloads, such as circuit end, has no gaps
+		if self.IsDatagram() {
+			payload.Flags |= uint32(PayloadFlagDatagram)
+			payload.Sequence = self.datagramSequence.Add(1) - 1
+		} else {
+			payload.Sequence = self.nextReceiveSequence()
 		}
 
 		// if the payload buffer is closed, we can't forward any more data, so might as well exit the rx loop
@@ -558,6 +696,10 @@ func (self *Xgress) rx() {
 }
 
 func (self *Xgress) forwardPayload(payload *Payload) bool {
+	if payload.IsDatagramFlagSet() {
+		return self.forwardDatagram(payload)
+	}
+
 	sendCallback, err := self.payloadBuffer.BufferPayload(payload)
 
 	if err != nil {
@@ -574,6 +716,21 @@ func (self *Xgress) forwardPayload(payload *Payload) bool {
 	return true
 }
 
+// forwardDatagram forwards a payload without buffering it, so it's never retransmitted and doesn't take up space in
+// the send window
+func (self *Xgress) forwardDatagram(payload *Payload) bool {
+	if self.payloadBuffer.closed.Load() {
+		return false
+	}
+
+	for _, peekHandler := range self.peekHandlers {
+		peekHandler.Rx(self, payload)
+	}
+
+	self.receiveHandler.HandleXgressReceive(payload, self)
+	return true
+}
+
 func (self *Xgress) nextReceiveSequence() int32 {
 	self.rxSequenceLock.Lock()
 	defer self.rxSequenceLock.Unlock()
@@ -590,6 +747,11 @@ func (self *Xgress) PayloadReceived(payload *Payload) {
 	if self.originator == payload.GetOriginator() {
 		// a payload sent from this xgress has arrived back at this xgress, instead of the other end
 		log.Warn("ouroboros (circuit cycle) detected, dropping payload")
+	} else if payload.IsDatagramFlagSet() {
+		// datagrams are delivered as they arrive and aren't acknowledged
+		if !self.linkRxBuffer.ReceiveDatagram(payload, self.Options.RxBufferSize) {
+			log.Debug("rx buffer full, dropped datagram")
+		}
 	} else if self.linkRxBuffer.ReceiveUnordered(payload, self.Options.RxBufferSize) {
 		log.Debug("ready to acknowledge")
 
@@ -633,6 +795,13 @@ func (self *Xgress) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 		Flags:                 strconv.FormatUint(uint64(self.flags.Load()), 2),
 	}
 
+	if rateLimit := self.GetRateLimit(); rateLimit.IsLimited() {
+		xgressDetail.RateLimit = &inspect.XgressRateLimitDetail{
+			BytesPerSecond: rateLimit.BytesPerSecond,
+			BurstBytes:     rateLimit.BurstBytes,
+		}
+	}
+
 	detail.XgressDetails[string(self.address)] = xgressDetail
 
 	if detail.IncludeGoroutines() {
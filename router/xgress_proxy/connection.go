@@ -41,6 +41,12 @@ func (c *proxyXgressConnection) WritePayload(p []byte, headers map[uint8][]byte)
 	return c.Write(p)
 }
 
+// CloseWrite shuts down the writing side of the connection, so the peer sees the end of its input once the other
+// end of the circuit has finished writing
+func (c *proxyXgressConnection) CloseWrite() error {
+	return xgress.CloseWrite(c.Conn)
+}
+
 func (self *proxyXgressConnection) HandleControlMsg(controlType xgress.ControlType, headers channel.Headers, responder xgress.ControlReceiver) error {
 	if controlType == xgress.ControlTypeTraceRoute {
 		xgress.RespondToTraceRequest(headers, "xgress/proxy", "", responder)
@@ -48,6 +48,12 @@ func (c *transportXgressConn) WritePayload(p []byte, _ map[uint8][]byte) (n int,
 	return c.Write(p)
 }
 
+// CloseWrite shuts down the writing side of the connection, so the peer sees the end of its input once the other
+// end of the circuit has finished writing
+func (c *transportXgressConn) CloseWrite() error {
+	return xgress.CloseWrite(c.Conn)
+}
+
 func (self *transportXgressConn) HandleControlMsg(controlType xgress.ControlType, headers channel.Headers, responder xgress.ControlReceiver) error {
 	if controlType == xgress.ControlTypeTraceRoute {
 		xgress.RespondToTraceRequest(headers, "xgress/transport", "", responder)
@@ -41,6 +41,19 @@ func (txd *dialer) IsTerminatorValid(string, string) bool {
 	return true
 }
 
+func (txd *dialer) HealthCheck(terminatorId string, destination string, timeout time.Duration) error {
+	txDestination, err := transport.ParseAddress(destination)
+	if err != nil {
+		return errors.Wrapf(err, "cannot dial on invalid address [%s]", destination)
+	}
+
+	peer, err := txDestination.Dial("x/health/"+terminatorId, txd.id, timeout, txd.tcfg)
+	if err != nil {
+		return err
+	}
+	return peer.Close()
+}
+
 func newDialer(id *identity.TokenId, ctrl env.NetworkControllers, options *xgress.Options, tcfg transport.Configuration) (xgress.Dialer, error) {
 	txd := &dialer{
 		id:      id,
@@ -159,7 +159,7 @@ func (l *listener) handleConnect(initialRequest []byte, session xgress_udp.Sessi
 		log.Error(err)
 		response = &xgress.Response{Success: false, Message: "invalid request"}
 	} else {
-		response = xgress.CreateCircuit(l.ctrl, session, request, l.bindHandler, l.options)
+		response = xgress.CreateDatagramCircuit(l.ctrl, session, request, l.bindHandler, l.options)
 	}
 
 	l.eventChan <- &sessionResponse{addr: session.Address(), response: response}
@@ -110,6 +110,7 @@ func (self *dialer) dialSplit(linkId *identity.TokenId, address transport.Addres
 			linkProtocol:  dial.GetLinkProtocol(),
 			dialAddress:   dial.GetAddress(),
 			dialed:        true,
+			qos:           linkQos{config: self.config.qos},
 		},
 	}
 
@@ -157,6 +158,7 @@ func (self *dialer) dialSingle(linkId *identity.TokenId, address transport.Addre
 			routerVersion: dial.GetRouterVersion(),
 			dialAddress:   dial.GetAddress(),
 			dialed:        true,
+			qos:           linkQos{config: self.config.qos},
 		},
 	}
 
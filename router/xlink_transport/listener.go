@@ -204,6 +204,7 @@ func (self *listener) getOrCreateSplitLink(id string, linkMeta *linkMetadata, bi
 				linkProtocol:  self.GetLinkProtocol(),
 				dialAddress:   self.GetAdvertisement(),
 				dialed:        false,
+				qos:           linkQos{config: self.config.qos},
 			},
 			eventTime: time.Now(),
 		}
@@ -240,6 +241,7 @@ func (self *listener) bindNonSplitChannel(binding channel.Binding, linkMeta *lin
 		linkProtocol:  self.GetLinkProtocol(),
 		dialAddress:   self.GetAdvertisement(),
 		dialed:        false,
+		qos:           linkQos{config: self.config.qos},
 	}
 
 	bindHandler := self.bindHandlerFactory.NewBindHandler(xli, true, true)
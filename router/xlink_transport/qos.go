@@ -0,0 +1,345 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2"
+	linkmetrics "github.com/openziti/fabric/router/metrics"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/metrics"
+	"github.com/pkg/errors"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const (
+	QosModeNone     = "none"
+	QosModeStrict   = "strict"
+	QosModeWeighted = "weighted"
+
+	DefaultQosQueueSize = 256
+	MinQosQueueSize     = 1
+	MaxQosQueueSize     = 65536
+
+	qosQuantumBytes = 16 * 1024
+)
+
+type qosConfig struct {
+	mode      string
+	weights   map[xgress.QosClass]int
+	queueSize int
+}
+
+func defaultQosConfig() *qosConfig {
+	return &qosConfig{
+		mode: QosModeNone,
+		weights: map[xgress.QosClass]int{
+			xgress.QosClassInteractive: 8,
+			xgress.QosClassDefault:     4,
+			xgress.QosClassBulk:        1,
+		},
+		queueSize: DefaultQosQueueSize,
+	}
+}
+
+func (self *qosConfig) isEnabled() bool {
+	return self != nil && self.mode != QosModeNone
+}
+
+func (self *qosConfig) load(data map[interface{}]interface{}) error {
+	if value, found := data["mode"]; found {
+		if mode, ok := value.(string); ok && (mode == QosModeNone || mode == QosModeStrict || mode == QosModeWeighted) {
+			self.mode = mode
+		} else {
+			return errors.Errorf("invalid qos 'mode' value '%v', must be one of none, strict or weighted", value)
+		}
+	}
+
+	if value, found := data["queueSize"]; found {
+		if queueSize, ok := value.(int); ok && queueSize >= MinQosQueueSize && queueSize <= MaxQosQueueSize {
+			self.queueSize = queueSize
+		} else {
+			return errors.Errorf("invalid qos 'queueSize' value '%v', expected integer between %v and %v", value, MinQosQueueSize, MaxQosQueueSize)
+		}
+	}
+
+	if value, found := data["weights"]; found {
+		submap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return errors.Errorf("invalid qos 'weights' (%s)", reflect.TypeOf(value))
+		}
+		for k, v := range submap {
+			class, err := xgress.ParseQosClass(fmt.Sprint(k))
+			if err != nil {
+				return errors.Wrap(err, "invalid qos 'weights'")
+			}
+			weight, ok := v.(int)
+			if !ok || weight < 1 || weight > 100 {
+				return errors.Errorf("invalid qos weight '%v' for class %v, expected integer between 1 and 100", v, class)
+			}
+			self.weights[class] = weight
+		}
+	}
+
+	return nil
+}
+
+func loadQosConfig(data map[interface{}]interface{}) (*qosConfig, error) {
+	config := defaultQosConfig()
+	if value, found := data["qos"]; found {
+		submap, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid 'qos' config (%s)", reflect.TypeOf(value))
+		}
+		if err := config.load(submap); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+type qosEntry struct {
+	msg      *channel.Message
+	class    xgress.QosClass
+	size     int
+	enqueued time.Time
+}
+
+// qosScheduler orders outbound link payloads by QoS class. Payloads are queued per class and a single goroutine
+// feeds the channel, which has a small out queue, so that the scheduler decides which payload goes out next. In
+// strict mode higher priority classes are always sent first. In weighted mode classes share the link using deficit
+// round-robin, with each class getting a byte quantum proportional to its weight per round.
+type qosScheduler struct {
+	ch          channel.Channel
+	config      *qosConfig
+	metrics     *linkmetrics.QosMetrics
+	lock        sync.Mutex
+	queues      [3][]*qosEntry
+	deficits    [3]int
+	current     int
+	credited    bool
+	notify      chan struct{}
+	closed      bool
+	closeNotify chan struct{}
+	closeOnce   sync.Once
+}
+
+func newQosScheduler(ch channel.Channel, config *qosConfig, metrics *linkmetrics.QosMetrics) *qosScheduler {
+	return &qosScheduler{
+		ch:          ch,
+		config:      config,
+		metrics:     metrics,
+		notify:      make(chan struct{}, 1),
+		closeNotify: make(chan struct{}),
+	}
+}
+
+// enqueue queues the message for sending. Like channel.TrySend, it returns false if the queue for the message's class
+// is full and an error if the scheduler has been closed.
+func (self *qosScheduler) enqueue(msg *channel.Message, class xgress.QosClass) (bool, error) {
+	idx := class.Priority()
+
+	self.lock.Lock()
+	if self.closed {
+		self.lock.Unlock()
+		return false, errors.New("link closed")
+	}
+	if len(self.queues[idx]) >= self.config.queueSize {
+		self.lock.Unlock()
+		return false, nil
+	}
+	self.queues[idx] = append(self.queues[idx], &qosEntry{
+		msg:      msg,
+		class:    class,
+		size:     len(msg.Body),
+		enqueued: time.Now(),
+	})
+	self.lock.Unlock()
+
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+	return true, nil
+}
+
+func (self *qosScheduler) next() *qosEntry {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.config.mode == QosModeStrict {
+		for idx := range self.queues {
+			if len(self.queues[idx]) > 0 {
+				return self.pop(idx)
+			}
+		}
+		return nil
+	}
+
+	for {
+		empty := true
+		for idx := range self.queues {
+			if len(self.queues[idx]) > 0 {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			return nil
+		}
+
+		idx := self.current
+		if len(self.queues[idx]) == 0 {
+			self.deficits[idx] = 0
+			self.advance()
+			continue
+		}
+
+		if !self.credited {
+			self.deficits[idx] += self.config.weights[xgress.QosClasses[idx]] * qosQuantumBytes
+			self.credited = true
+		}
+
+		if head := self.queues[idx][0]; self.deficits[idx] >= head.size {
+			self.deficits[idx] -= head.size
+			return self.pop(idx)
+		}
+		self.advance()
+	}
+}
+
+func (self *qosScheduler) advance() {
+	self.current = (self.current + 1) % len(self.queues)
+	self.credited = false
+}
+
+func (self *qosScheduler) pop(idx int) *qosEntry {
+	entry := self.queues[idx][0]
+	self.queues[idx][0] = nil
+	self.queues[idx] = self.queues[idx][1:]
+	return entry
+}
+
+func (self *qosScheduler) run() {
+	log := pfxlog.ContextLogger(self.ch.Label())
+	defer log.Debug("qos scheduler exited")
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		entry := self.next()
+		if entry == nil {
+			select {
+			case <-self.notify:
+			case <-ticker.C:
+				if self.ch.IsClosed() {
+					self.close()
+					return
+				}
+			case <-self.closeNotify:
+				return
+			}
+			continue
+		}
+
+		self.metrics.UpdateQueueTime(entry.class, time.Since(entry.enqueued))
+		if err := self.ch.Send(entry.msg); err != nil {
+			log.WithError(err).Debug("error sending payload, stopping qos scheduler")
+			self.close()
+			return
+		}
+		self.metrics.MarkTx(entry.class, entry.size)
+	}
+}
+
+func (self *qosScheduler) close() {
+	self.closeOnce.Do(func() {
+		close(self.closeNotify)
+		self.lock.Lock()
+		self.closed = true
+		for idx := range self.queues {
+			self.queues[idx] = nil
+		}
+		self.lock.Unlock()
+	})
+}
+
+// linkQos holds the QoS state for a link. If QoS scheduling isn't enabled payloads are sent directly to the channel,
+// but are still counted in the per-class metrics.
+type linkQos struct {
+	config    *qosConfig
+	metrics   *linkmetrics.QosMetrics
+	scheduler *qosScheduler
+	once      sync.Once
+	closeOnce sync.Once
+}
+
+func (self *linkQos) init(registry metrics.Registry) {
+	if self.metrics == nil {
+		self.metrics = linkmetrics.NewQosMetrics(registry)
+	}
+}
+
+func (self *linkQos) sendPayload(ch channel.Channel, payload *xgress.Payload, droppedMsgMeter metrics.Meter) error {
+	msg := payload.Marshall()
+
+	if !self.config.isEnabled() {
+		sent, err := ch.TrySend(msg)
+		if err == nil {
+			if sent {
+				self.metrics.MarkTx(payload.QosClass, len(msg.Body))
+			} else {
+				droppedMsgMeter.Mark(1)
+				self.metrics.MarkDropped(payload.QosClass)
+			}
+		}
+		return err
+	}
+
+	self.once.Do(func() {
+		self.scheduler = newQosScheduler(ch, self.config, self.metrics)
+		go self.scheduler.run()
+	})
+
+	if self.scheduler == nil {
+		return errors.New("link closed")
+	}
+
+	sent, err := self.scheduler.enqueue(msg, payload.QosClass)
+	if err == nil && !sent {
+		droppedMsgMeter.Mark(1)
+		self.metrics.MarkDropped(payload.QosClass)
+	}
+	return err
+}
+
+func (self *linkQos) close() {
+	self.closeOnce.Do(func() {
+		// prevents the scheduler from being started after the link is closed
+		self.once.Do(func() {})
+		if self.scheduler != nil {
+			self.scheduler.close()
+		}
+		if self.metrics != nil {
+			self.metrics.Dispose()
+		}
+	})
+}
@@ -0,0 +1,120 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"testing"
+
+	"github.com/openziti/channel/v2"
+	linkmetrics "github.com/openziti/fabric/router/metrics"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQosScheduler(mode string) *qosScheduler {
+	config := defaultQosConfig()
+	config.mode = mode
+	config.queueSize = 1000
+	return newQosScheduler(nil, config, linkmetrics.NewQosMetrics(metrics.NewRegistry("test", nil)))
+}
+
+func enqueueTestPayloads(t *testing.T, scheduler *qosScheduler, class xgress.QosClass, count int, size int) {
+	for i := 0; i < count; i++ {
+		sent, err := scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, make([]byte, size)), class)
+		require.NoError(t, err)
+		require.True(t, sent)
+	}
+}
+
+func TestQosStrictPriority(t *testing.T) {
+	req := require.New(t)
+	scheduler := newTestQosScheduler(QosModeStrict)
+
+	enqueueTestPayloads(t, scheduler, xgress.QosClassBulk, 3, 1000)
+	enqueueTestPayloads(t, scheduler, xgress.QosClassDefault, 3, 1000)
+	enqueueTestPayloads(t, scheduler, xgress.QosClassInteractive, 3, 1000)
+
+	var order []xgress.QosClass
+	for entry := scheduler.next(); entry != nil; entry = scheduler.next() {
+		order = append(order, entry.class)
+	}
+
+	req.Equal([]xgress.QosClass{
+		xgress.QosClassInteractive, xgress.QosClassInteractive, xgress.QosClassInteractive,
+		xgress.QosClassDefault, xgress.QosClassDefault, xgress.QosClassDefault,
+		xgress.QosClassBulk, xgress.QosClassBulk, xgress.QosClassBulk,
+	}, order)
+}
+
+func TestQosWeightedShare(t *testing.T) {
+	req := require.New(t)
+	scheduler := newTestQosScheduler(QosModeWeighted)
+
+	enqueueTestPayloads(t, scheduler, xgress.QosClassBulk, 500, 1024)
+	enqueueTestPayloads(t, scheduler, xgress.QosClassInteractive, 500, 1024)
+
+	sentBytes := map[xgress.QosClass]int{}
+	for i := 0; i < 432; i++ {
+		entry := scheduler.next()
+		req.NotNil(entry)
+		sentBytes[entry.class] += entry.size
+	}
+
+	// three rounds, interactive has a weight of 8 vs 1 for bulk, but bulk still gets its share so it isn't starved
+	req.Equal(384*1024, sentBytes[xgress.QosClassInteractive])
+	req.Equal(48*1024, sentBytes[xgress.QosClassBulk])
+
+	// full queues report the payload as not sent
+	scheduler.config.queueSize = 1
+	sent, err := scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, nil), xgress.QosClassBulk)
+	req.NoError(err)
+	req.False(sent)
+
+	scheduler.close()
+	_, err = scheduler.enqueue(channel.NewMessage(xgress.ContentTypePayloadType, nil), xgress.QosClassBulk)
+	req.Error(err)
+	req.Nil(scheduler.next())
+}
+
+func TestQosConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := loadQosConfig(map[interface{}]interface{}{
+		"qos": map[interface{}]interface{}{
+			"mode":      "weighted",
+			"queueSize": 64,
+			"weights": map[interface{}]interface{}{
+				"bulk": 2,
+			},
+		},
+	})
+	req.NoError(err)
+	req.True(config.isEnabled())
+	req.Equal(64, config.queueSize)
+	req.Equal(2, config.weights[xgress.QosClassBulk])
+	req.Equal(8, config.weights[xgress.QosClassInteractive])
+
+	_, err = loadQosConfig(map[interface{}]interface{}{
+		"qos": map[interface{}]interface{}{"mode": "fastest"},
+	})
+	req.Error(err)
+
+	config, err = loadQosConfig(map[interface{}]interface{}{})
+	req.NoError(err)
+	req.False(config.isEnabled())
+}
@@ -35,6 +35,7 @@ type impl struct {
 	dialAddress     string
 	closeNotified   atomic.Bool
 	droppedMsgMeter metrics.Meter
+	qos             linkQos
 	dialed          bool
 }
 
@@ -50,15 +51,12 @@ func (self *impl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+	self.qos.init(metricsRegistry)
 	return nil
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
-	if err == nil && !sent {
-		self.droppedMsgMeter.Mark(1)
-	}
-	return err
+	return self.qos.sendPayload(self.ch, msg, self.droppedMsgMeter)
 }
 
 func (self *impl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -79,6 +77,7 @@ func (self *impl) SendControl(msg *xgress.Control) error {
 
 func (self *impl) Close() error {
 	self.droppedMsgMeter.Dispose()
+	self.qos.close()
 	return self.ch.Close()
 }
 
@@ -37,6 +37,7 @@ type splitImpl struct {
 	dialAddress     string
 	closeNotified   atomic.Bool
 	droppedMsgMeter metrics.Meter
+	qos             linkQos
 	dialed          bool
 }
 
@@ -52,15 +53,12 @@ func (self *splitImpl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
 	}
+	self.qos.init(metricsRegistry)
 	return nil
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
-	if err == nil && !sent {
-		self.droppedMsgMeter.Mark(1)
-	}
-	return err
+	return self.qos.sendPayload(self.payloadCh, msg, self.droppedMsgMeter)
 }
 
 func (self *splitImpl) SendAcknowledgement(msg *xgress.Acknowledgement) error {
@@ -88,6 +86,7 @@ func (self *splitImpl) Close() error {
 	if self.droppedMsgMeter != nil {
 		self.droppedMsgMeter.Dispose()
 	}
+	self.qos.close()
 	var err, err2 error
 	if self.payloadCh != nil {
 		err = self.payloadCh.Close()